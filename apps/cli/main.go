@@ -7,6 +7,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"syscall"
 
@@ -48,8 +49,8 @@ func init() {
 	accountCmd = flag.NewFlagSet("account", flag.ExitOnError)
 }
 
-func getWallet(nanoWallet *wallet.NanoWallet, id string) *ent.Wallet {
-	w, err := nanoWallet.GetWallet(id)
+func getWallet(ctx context.Context, nanoWallet *wallet.NanoWallet, id string) *ent.Wallet {
+	w, err := nanoWallet.GetWallet(ctx, id)
 	if errors.Is(err, wallet.ErrWalletNotFound) {
 		fmt.Println("Wallet not found")
 		os.Exit(1)
@@ -113,7 +114,7 @@ func PasswordPrompt() string {
 
 }
 
-func RequireUnlockedWallet(nanoWallet *wallet.NanoWallet, w *ent.Wallet, password *string) (alreadyUnlocked bool) {
+func RequireUnlockedWallet(ctx context.Context, nanoWallet *wallet.NanoWallet, w *ent.Wallet, password *string) (alreadyUnlocked bool) {
 	_, err := wallet.GetDecryptedKeyFromStorage(w, "seed")
 	if errors.Is(err, wallet.ErrWalletLocked) {
 		if *password == "" {
@@ -121,7 +122,7 @@ func RequireUnlockedWallet(nanoWallet *wallet.NanoWallet, w *ent.Wallet, passwor
 			os.Exit(1)
 		}
 		// Unlock wallet
-		ok, err := nanoWallet.UnlockWallet(w, *password)
+		ok, err := nanoWallet.UnlockWallet(ctx, w, *password)
 		if err != nil || !ok {
 			fmt.Printf("Failed to unlock wallet: %v\n", err)
 			os.Exit(1)
@@ -146,11 +147,13 @@ func main() {
 	walletViewSeed := walletCmd.Bool("view-seed", false, "View the seed of a wallet (unsafe)")
 	walletEncrypt := walletCmd.Bool("encrypt", false, "Encrypt a wallet with a password")
 	walletDecryt := walletCmd.Bool("decrypt", false, "Decrypt a wallet, remove password requirement")
+	walletSweep := walletCmd.Bool("sweep", false, "Receive pending and send the full balance of every account to --destination")
 	// Options that may apply to multiple commands
 	walletId := walletCmd.String("id", "", "Target wallet ID")
 	walletSeed := walletCmd.String("seed", "", "Specify a seed to use when creating/changing wallet (optional for create)")
 	walletPassword := walletCmd.String("password", "", "Specify a password to use if the wallet is locked")
 	walletAllKeys := walletCmd.Bool("all-keys", false, "Show all priv/pub keys for accounts on this wallet")
+	walletDestination := walletCmd.String("destination", "", "Destination account for --sweep")
 
 	// For accounts
 	accountCreate := accountCmd.Bool("create", false, "Create a new account")
@@ -161,6 +164,9 @@ func main() {
 	accountCount := accountCmd.Int("count", 0, "Specify how many accounts to create (optional, cannot be used with --index)")
 	accountKey := accountCmd.String("key", "", "Specify a private key to use when creating account (optional, cannot be used with --index or --count)")
 	repairAdhoc := accountCmd.Bool("repair-adhoc", false, "Repair adhoc accounts")
+	accountSweep := accountCmd.Bool("sweep", false, "Receive pending and send the full balance of --address to --destination")
+	accountAddress := accountCmd.String("address", "", "Target account for --sweep")
+	accountDestination := accountCmd.String("destination", "", "Destination account for --sweep")
 
 	if *showHelp {
 		usage()
@@ -210,15 +216,26 @@ func main() {
 	}
 
 	// Setup RPC handlers
-	rpcClient := rpc.NewRPCClient(conf.Server.NodeRpcUrl)
+	rpcClient := rpc.NewRPCClient(conf.Server.RpcUrls()...)
+	rpcClient.Quorum = conf.Server.NodeRpcQuorum
 
 	// Setup pow client
-	pow := pow.NewPippinPow(conf.Wallet.WorkPeers, utils.GetEnv("BPOW_KEY", ""), utils.GetEnv("BPOW_URL", ""), conf.Wallet.WorkTimeout)
+	pow := pow.NewPippinPow(conf.Wallet.WorkPeers, utils.GetEnv("BPOW_KEY", ""), utils.GetEnv("BPOW_URL", ""), conf.Wallet.WorkTimeout, *conf.Wallet.MaxWorkProcesses)
+	pow.PeerFanout = *conf.Wallet.WorkPeerFanout
+	pow.Strategy = conf.Wallet.WorkStrategy
+	if conf.Wallet.NodeWorkGenerate || slices.Contains(conf.Wallet.WorkProviders, "node") {
+		pow.EnableNodeWorkGenerate(rpcClient)
+	}
+	if len(conf.Wallet.WorkProviders) > 0 {
+		if err := pow.UseProviders(conf.Wallet.WorkProviders); err != nil {
+			log.Fatalf("Invalid work_providers: %v", err)
+			os.Exit(1)
+		}
+	}
 
 	// Setup nano wallet instance with DB, options, etc.
 	nanoWallet := wallet.NanoWallet{
 		DB:         entClient,
-		Ctx:        ctx,
 		Banano:     conf.Wallet.Banano,
 		RpcClient:  rpcClient,
 		WorkClient: pow,
@@ -232,7 +249,7 @@ func main() {
 		// ** wallet --list
 		if *walletList {
 			// Get all wallets
-			wallets, err := nanoWallet.GetWallets()
+			wallets, err := nanoWallet.GetWallets(ctx)
 			if err != nil {
 				fmt.Printf("Failed to get wallets: %v\n", err)
 				os.Exit(1)
@@ -270,13 +287,13 @@ func main() {
 				os.Exit(1)
 			}
 			// Create wallet
-			w, err := nanoWallet.WalletCreate(seed)
+			w, err := nanoWallet.WalletCreate(ctx, seed)
 			if err != nil {
 				fmt.Printf("Failed to create wallet: %v\n", err)
 				os.Exit(1)
 			}
 			// Retrieve wallet
-			w, err = nanoWallet.GetWallet(w.ID.String())
+			w, err = nanoWallet.GetWallet(ctx, w.ID.String())
 			acct, err := w.QueryAccounts().First(ctx)
 			if err != nil {
 				fmt.Printf("Failed to get account for wallet: %v\n", err)
@@ -289,11 +306,11 @@ func main() {
 		} else if *walletChangeSeed {
 			RequireSeed(walletSeed)
 			RequireID(walletId, "--id is required for --change-seed")
-			w := getWallet(&nanoWallet, *walletId)
-			RequireUnlockedWallet(&nanoWallet, w, walletPassword)
+			w := getWallet(ctx, &nanoWallet, *walletId)
+			RequireUnlockedWallet(ctx, &nanoWallet, w, walletPassword)
 
 			// Change seed
-			newest, err := nanoWallet.WalletChangeSeed(w, *walletSeed)
+			newest, err := nanoWallet.WalletChangeSeed(ctx, w, *walletSeed)
 			if err != nil {
 				fmt.Printf("Failed to change seed: %v\n", err)
 				os.Exit(1)
@@ -302,8 +319,8 @@ func main() {
 			// ** wallet --view-seed --id
 		} else if *walletViewSeed {
 			RequireID(walletId, "--id is required for --view-seed")
-			w := getWallet(&nanoWallet, *walletId)
-			alreadyUnlocked := RequireUnlockedWallet(&nanoWallet, w, walletPassword)
+			w := getWallet(ctx, &nanoWallet, *walletId)
+			alreadyUnlocked := RequireUnlockedWallet(ctx, &nanoWallet, w, walletPassword)
 			seed, err := wallet.GetDecryptedKeyFromStorage(w, "seed")
 			if err != nil {
 				fmt.Printf("Failed to get seed: %v\n", err)
@@ -311,7 +328,7 @@ func main() {
 			}
 			if !alreadyUnlocked {
 				// Re-lock the wallet
-				err = nanoWallet.LockWallet(w)
+				err = nanoWallet.LockWallet(ctx, w)
 				if err != nil {
 					fmt.Printf("Failed to re-lock wallet: %v\n", err)
 					os.Exit(1)
@@ -347,7 +364,7 @@ func main() {
 			// ** --encrypt --id
 		} else if *walletEncrypt {
 			RequireID(walletId, "--id is required for --encrypt")
-			w := getWallet(&nanoWallet, *walletId)
+			w := getWallet(ctx, &nanoWallet, *walletId)
 			if w.Encrypted {
 				fmt.Println("Wallet is already encrypted")
 				os.Exit(1)
@@ -359,7 +376,7 @@ func main() {
 				os.Exit(1)
 			}
 			// Encrypt wallet
-			ok, err := nanoWallet.EncryptWallet(w, password)
+			ok, err := nanoWallet.EncryptWallet(ctx, w, password)
 			if err != nil || !ok {
 				fmt.Printf("Failed to encrypt wallet: %v\n", err)
 				os.Exit(1)
@@ -367,7 +384,7 @@ func main() {
 			fmt.Println("Wallet encrypted")
 		} else if *walletDecryt {
 			RequireID(walletId, "--id is required for --decrypt")
-			w := getWallet(&nanoWallet, *walletId)
+			w := getWallet(ctx, &nanoWallet, *walletId)
 			if !w.Encrypted {
 				fmt.Println("Wallet is not encrypted")
 				os.Exit(1)
@@ -379,13 +396,34 @@ func main() {
 				os.Exit(1)
 			}
 			// Decrypt wallet
-			RequireUnlockedWallet(&nanoWallet, w, &password)
-			ok, err := nanoWallet.EncryptWallet(w, "")
+			RequireUnlockedWallet(ctx, &nanoWallet, w, &password)
+			ok, err := nanoWallet.EncryptWallet(ctx, w, "")
 			if err != nil || !ok {
 				fmt.Printf("Failed to decrypt wallet: %v\n", err)
 				os.Exit(1)
 			}
 			fmt.Println("Wallet decrypted")
+			// ** wallet --sweep --id --destination
+		} else if *walletSweep {
+			RequireID(walletId, "--id is required for --sweep")
+			RequireID(walletDestination, "--destination is required for --sweep")
+			w := getWallet(ctx, &nanoWallet, *walletId)
+			alreadyUnlocked := RequireUnlockedWallet(ctx, &nanoWallet, w, walletPassword)
+			if !alreadyUnlocked {
+				defer nanoWallet.LockWallet(ctx, w)
+			}
+			results, err := nanoWallet.WalletSweep(ctx, w, *walletDestination, nil)
+			if err != nil {
+				fmt.Printf("Failed to sweep wallet: %v\n", err)
+				os.Exit(1)
+			}
+			for _, result := range results {
+				if result.Error != nil {
+					fmt.Printf("Account: %s failed: %v\n", result.Account, result.Error)
+					continue
+				}
+				fmt.Printf("Account: %s received %d blocks, moved %s raw %s\n", result.Account, result.Received, result.Amount, result.Hash)
+			}
 		} else {
 			usage()
 		}
@@ -394,7 +432,7 @@ func main() {
 		// ** account --create (--id --index --count --key)
 		if *accountCreate {
 			RequireID(accountWalletId, "--id is required for --create")
-			w := getWallet(&nanoWallet, *accountWalletId)
+			w := getWallet(ctx, &nanoWallet, *accountWalletId)
 			if *accountIndex > 0 && *accountCount > 0 {
 				fmt.Println("Cannot specify both --index and --count")
 				os.Exit(1)
@@ -407,21 +445,21 @@ func main() {
 				fmt.Println("Cannot specify both --count and --key")
 				os.Exit(1)
 			}
-			alreadyUnlocked := RequireUnlockedWallet(&nanoWallet, w, accountWalletPassword)
+			alreadyUnlocked := RequireUnlockedWallet(ctx, &nanoWallet, w, accountWalletPassword)
 			if !alreadyUnlocked {
-				defer nanoWallet.LockWallet(w)
+				defer nanoWallet.LockWallet(ctx, w)
 			}
 			// Determine type
 			if *accountIndex > 0 {
 				// Create account at index
-				acc, err := nanoWallet.AccountCreate(w, accountIndex)
+				acc, err := nanoWallet.AccountCreate(ctx, w, accountIndex)
 				if err != nil {
 					fmt.Printf("Failed to create account: %v\n", err)
 					os.Exit(1)
 				}
 				fmt.Printf("Account created: %s\n", acc.Address)
 			} else if *accountCount > 0 {
-				accs, err := nanoWallet.AccountsCreate(w, *accountCount)
+				accs, err := nanoWallet.AccountsCreate(ctx, w, *accountCount)
 				if err != nil {
 					fmt.Printf("Failed to create accounts: %v\n", err)
 					os.Exit(1)
@@ -445,7 +483,7 @@ func main() {
 					os.Exit(1)
 				}
 				// Create adhoc account
-				acc, err := nanoWallet.AdhocAccountCreate(w, priv)
+				acc, err := nanoWallet.AdhocAccountCreate(ctx, w, priv)
 				if err != nil {
 					fmt.Printf("Failed to create adhoc account: %v\n", err)
 					os.Exit(1)
@@ -453,7 +491,7 @@ func main() {
 				fmt.Printf("Adhoc account created: %s\n", acc.Address)
 			} else {
 				// Create account
-				acc, err := nanoWallet.AccountCreate(w, nil)
+				acc, err := nanoWallet.AccountCreate(ctx, w, nil)
 				if err != nil {
 					fmt.Printf("Failed to create account: %v\n", err)
 					os.Exit(1)
@@ -490,6 +528,22 @@ func main() {
 				}
 				fmt.Printf("Account %s repaired\n", a.Address)
 			}
+			// ** account --sweep --id --address --destination
+		} else if *accountSweep {
+			RequireID(accountWalletId, "--id is required for --sweep")
+			RequireID(accountAddress, "--address is required for --sweep")
+			RequireID(accountDestination, "--destination is required for --sweep")
+			w := getWallet(ctx, &nanoWallet, *accountWalletId)
+			alreadyUnlocked := RequireUnlockedWallet(ctx, &nanoWallet, w, accountWalletPassword)
+			if !alreadyUnlocked {
+				defer nanoWallet.LockWallet(ctx, w)
+			}
+			result, err := nanoWallet.AccountSweep(ctx, w, *accountAddress, *accountDestination, nil)
+			if err != nil {
+				fmt.Printf("Failed to sweep account: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("Account: %s received %d blocks, moved %s raw %s\n", result.Account, result.Received, result.Amount, result.Hash)
 		}
 	default:
 		fmt.Println("expected 'foo' or 'bar' subcommands")
@@ -4,9 +4,12 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet"
 	"github.com/go-chi/render"
+	"github.com/mitchellh/mapstructure"
 )
 
 // Account handlers, reserved for the handlers that directly interact with the account_ actions
@@ -26,7 +29,7 @@ func (hc *HttpController) HandleAccountCreate(rawRequest *map[string]interface{}
 	}
 
 	// Create the account
-	newAccount, err := hc.Wallet.AccountCreate(dbWallet, idx)
+	newAccount, err := hc.Wallet.AccountCreate(r.Context(), dbWallet, idx)
 	if errors.Is(err, wallet.ErrWalletLocked) || errors.Is(err, wallet.ErrInvalidWallet) {
 		ErrWalletLocked(w, r)
 		return
@@ -64,7 +67,7 @@ func (hc *HttpController) HandleAccountsCreate(rawRequest *map[string]interface{
 	}
 
 	// Create the accounts
-	newAccounts, err := hc.Wallet.AccountsCreate(dbWallet, count)
+	newAccounts, err := hc.Wallet.AccountsCreate(r.Context(), dbWallet, count)
 	if errors.Is(err, wallet.ErrWalletLocked) || errors.Is(err, wallet.ErrInvalidWallet) {
 		ErrWalletLocked(w, r)
 		return
@@ -104,7 +107,7 @@ func (hc *HttpController) HandleAccountList(rawRequest *map[string]interface{},
 	}
 
 	// Accounts list
-	_, accounts, err := hc.Wallet.AccountsList(dbWallet, count)
+	_, accounts, err := hc.Wallet.AccountsList(r.Context(), dbWallet, count)
 	if errors.Is(err, wallet.ErrWalletLocked) {
 		ErrWalletLocked(w, r)
 		return
@@ -120,3 +123,49 @@ func (hc *HttpController) HandleAccountList(rawRequest *map[string]interface{},
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, &resp)
 }
+
+// Rebuild the local state of an account from the node, clears the out of sync flag
+func (hc *HttpController) HandleAccountResync(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var resyncRequest requests.AccountResyncRequest
+	if err := mapstructure.Decode(rawRequest, &resyncRequest); err != nil {
+		log.Errorf("Error unmarshalling account_resync request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if resyncRequest.Wallet == "" || resyncRequest.Action == "" || resyncRequest.Account == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(resyncRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	state, err := hc.Wallet.AccountResync(r.Context(), dbWallet, resyncRequest.Account)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, "Account not found")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.AccountResyncResponse{
+		Account:        resyncRequest.Account,
+		Frontier:       state.Frontier,
+		Balance:        state.Balance,
+		Representative: state.Representative,
+	}
+	if resp.Frontier == "" {
+		// Unopened
+		resp.Frontier = "0000000000000000000000000000000000000000000000000000000000000000"
+		resp.Balance = "0"
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
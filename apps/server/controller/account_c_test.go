@@ -2,14 +2,20 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
+	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/google/uuid"
+	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -152,9 +158,9 @@ func TestAccountsCreate(t *testing.T) {
 
 func TestAccountList(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("f39a07504c76978f47e6630bb97e6fc169dd734d25ddcb323609a5699789b104"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// Create some accounts
-	MockController.Wallet.AccountsCreate(wallet, 3)
+	MockController.Wallet.AccountsCreate(context.Background(), wallet, 3)
 
 	// Test API
 	reqBody := map[string]interface{}{
@@ -186,3 +192,79 @@ func TestAccountList(t *testing.T) {
 		assert.Nil(t, err)
 	}
 }
+
+func TestAccountResync(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+			return resp, err
+		},
+	)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("5C9E1A3B7D2F4C6E8A0B1D3F5C7E9A2B4D6F8C0E1A3B5D7F9C2E4A6B8D0F1C3E"))
+	wallet, err := MockController.Wallet.WalletCreate(context.Background(), newSeed)
+	assert.Nil(t, err)
+	acc, err := MockController.Wallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+
+	// Request JSON
+	reqBody := map[string]interface{}{
+		"action":  "account_resync",
+		"wallet":  wallet.ID.String(),
+		"account": acc.Address,
+	}
+	body, _ := json.Marshal(reqBody)
+	w := httptest.NewRecorder()
+	// Build request
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var respJson responses.AccountResyncResponse
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+
+	assert.Equal(t, acc.Address, respJson.Account)
+	assert.Equal(t, "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F", respJson.Frontier)
+	assert.Equal(t, "11999999999999999918751838129509869131", respJson.Balance)
+	assert.Equal(t, "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5", respJson.Representative)
+
+	// errors
+
+	// Request JSON
+	reqBody = map[string]interface{}{
+		"action":  "account_resync",
+		"wallet":  wallet.ID.String(),
+		"account": "nano_1111111111111111111111111111111111111111111111111117353trpda",
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	// Build request
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	var rawResp map[string]interface{}
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &rawResp)
+
+	assert.Equal(t, "Account not found", rawResp["error"])
+}
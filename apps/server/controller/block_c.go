@@ -3,6 +3,7 @@ package controller
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
@@ -10,6 +11,7 @@ import (
 	"github.com/appditto/pippin_nano_wallet/libs/log"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
 	"github.com/go-chi/render"
 	"github.com/mitchellh/mapstructure"
 )
@@ -39,8 +41,13 @@ func (hc *HttpController) HandleReceiveRequest(rawRequest *map[string]interface{
 		return
 	}
 
+	wait, ok := hc.DecodeWaitConfirmation(receiveRequest.WaitConfirmation, w, r)
+	if !ok {
+		return
+	}
+
 	// Accounts list
-	resp, err := hc.Wallet.CreateAndPublishReceiveBlock(dbWallet, receiveRequest.Account, receiveRequest.Block, receiveRequest.Work, receiveRequest.BpowKey)
+	resp, err := hc.Wallet.CreateAndPublishReceiveBlock(r.Context(), dbWallet, receiveRequest.Account, receiveRequest.Block, receiveRequest.Work, receiveRequest.BpowKey)
 	if err != nil {
 		ErrBadRequest(w, r, err.Error())
 		return
@@ -49,6 +56,9 @@ func (hc *HttpController) HandleReceiveRequest(rawRequest *map[string]interface{
 	blockResponse := responses.BlockResponse{
 		Block: resp,
 	}
+	if wait > 0 {
+		blockResponse.Confirmation = string(hc.Wallet.WaitForConfirmation(r.Context(), resp, wait))
+	}
 
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, &blockResponse)
@@ -68,7 +78,7 @@ func (hc *HttpController) HandleReceiveAllRequest(rawRequest *map[string]interfa
 	}
 
 	// Accounts list
-	_, accounts, err := hc.Wallet.AccountsList(dbWallet, 0)
+	_, accounts, err := hc.Wallet.AccountsList(r.Context(), dbWallet, 0)
 	if errors.Is(err, wallet.ErrWalletLocked) {
 		ErrWalletLocked(w, r)
 		return
@@ -79,7 +89,7 @@ func (hc *HttpController) HandleReceiveAllRequest(rawRequest *map[string]interfa
 
 	receivedCount := 0
 	for _, account := range accounts {
-		resp, err := hc.Wallet.ReceiveAllBlocks(dbWallet, account, request.BpowKey)
+		resp, err := hc.Wallet.ReceiveAllBlocks(r.Context(), dbWallet, account, request.BpowKey)
 		if err != nil {
 			ErrInternalServerError(w, r, err.Error())
 			return
@@ -125,8 +135,13 @@ func (hc *HttpController) HandleSendRequest(rawRequest *map[string]interface{},
 		return
 	}
 
+	wait, ok := hc.DecodeWaitConfirmation(sendRequest.WaitConfirmation, w, r)
+	if !ok {
+		return
+	}
+
 	// Do the send
-	resp, err := hc.Wallet.CreateAndPublishSendBlock(dbWallet, sendRequest.Amount, sendRequest.Source, sendRequest.Destination, sendRequest.ID, sendRequest.Work, sendRequest.BpowKey)
+	resp, err := hc.Wallet.CreateAndPublishSendBlock(r.Context(), dbWallet, sendRequest.Amount, sendRequest.Source, sendRequest.Destination, sendRequest.ID, sendRequest.Work, sendRequest.BpowKey)
 	if err != nil {
 		ErrBadRequest(w, r, err.Error())
 		return
@@ -135,11 +150,83 @@ func (hc *HttpController) HandleSendRequest(rawRequest *map[string]interface{},
 	blockResponse := responses.BlockResponse{
 		Block: resp,
 	}
+	if wait > 0 {
+		blockResponse.Confirmation = string(hc.Wallet.WaitForConfirmation(r.Context(), resp, wait))
+	}
 
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, &blockResponse)
 }
 
+// Handle a batch of sends from one account
+func (hc *HttpController) HandleSendManyRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var sendManyRequest requests.SendManyRequest
+	if err := mapstructure.Decode(rawRequest, &sendManyRequest); err != nil {
+		log.Errorf("Error unmarshalling send_many request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if sendManyRequest.Wallet == "" || sendManyRequest.Action == "" || len(sendManyRequest.Sends) == 0 {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(sendManyRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Validate accounts
+	_, err := utils.AddressToPub(sendManyRequest.Source, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, fmt.Sprintf("Invalid source account %s", sendManyRequest.Source))
+		return
+	}
+	sends := make([]models.SendManyItem, len(sendManyRequest.Sends))
+	for i, send := range sendManyRequest.Sends {
+		if send.Amount == "" || send.Destination == "" {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+		_, err = utils.AddressToPub(send.Destination, hc.Wallet.Config.Wallet.Banano)
+		if err != nil {
+			ErrBadRequest(w, r, fmt.Sprintf("Invalid destination account %s", send.Destination))
+			return
+		}
+		sends[i] = models.SendManyItem{
+			Destination: send.Destination,
+			Amount:      send.Amount,
+			ID:          send.ID,
+			Work:        send.Work,
+		}
+	}
+
+	// Do the sends
+	results, err := hc.Wallet.CreateAndPublishSendBlocks(r.Context(), dbWallet, sendManyRequest.Source, sends, sendManyRequest.BpowKey)
+	if err != nil {
+		ErrBadRequest(w, r, err.Error())
+		return
+	}
+
+	resp := responses.SendManyResponse{
+		Blocks: make([]responses.SendManyBlock, len(results)),
+	}
+	for i, result := range results {
+		resp.Blocks[i] = responses.SendManyBlock{
+			Destination: sends[i].Destination,
+			Amount:      sends[i].Amount,
+			ID:          sends[i].ID,
+			Block:       result.Hash,
+		}
+		if result.Error != nil {
+			resp.Blocks[i].Error = result.Error.Error()
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
 // Handle rep change
 func (hc *HttpController) HandleAccountRepresentativeSetRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
 	var changeRequest requests.AccountRepresentativeSetRequest
@@ -170,8 +257,13 @@ func (hc *HttpController) HandleAccountRepresentativeSetRequest(rawRequest *map[
 		return
 	}
 
+	wait, ok := hc.DecodeWaitConfirmation(changeRequest.WaitConfirmation, w, r)
+	if !ok {
+		return
+	}
+
 	// Do the send
-	resp, err := hc.Wallet.CreateAndPublishChangeBlock(dbWallet, changeRequest.Account, changeRequest.Representative, changeRequest.Work, changeRequest.BpowKey, false)
+	resp, err := hc.Wallet.CreateAndPublishChangeBlock(r.Context(), dbWallet, changeRequest.Account, changeRequest.Representative, changeRequest.Work, changeRequest.BpowKey, false)
 	if err != nil {
 		ErrBadRequest(w, r, err.Error())
 		return
@@ -180,7 +272,170 @@ func (hc *HttpController) HandleAccountRepresentativeSetRequest(rawRequest *map[
 	blockResponse := responses.BlockResponse{
 		Block: resp,
 	}
+	if wait > 0 {
+		blockResponse.Confirmation = string(hc.Wallet.WaitForConfirmation(r.Context(), resp, wait))
+	}
 
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, &blockResponse)
 }
+
+// Handle moving all funds out of an account
+func (hc *HttpController) HandleAccountSweepRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var sweepRequest requests.AccountSweepRequest
+	if err := mapstructure.Decode(rawRequest, &sweepRequest); err != nil {
+		log.Errorf("Error unmarshalling account_sweep request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if sweepRequest.Wallet == "" || sweepRequest.Action == "" || sweepRequest.Account == "" || sweepRequest.Destination == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(sweepRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Validate accounts
+	_, err := utils.AddressToPub(sweepRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+	_, err = utils.AddressToPub(sweepRequest.Destination, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, fmt.Sprintf("Invalid destination account %s", sweepRequest.Destination))
+		return
+	}
+
+	result, err := hc.Wallet.AccountSweep(r.Context(), dbWallet, sweepRequest.Account, sweepRequest.Destination, sweepRequest.BpowKey)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, "Account not found")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.SweepResponse{
+		Account:  result.Account,
+		Received: result.Received,
+		Amount:   result.Amount,
+		Block:    result.Hash,
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle moving all funds out of every account in a wallet
+func (hc *HttpController) HandleWalletSweepRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var sweepRequest requests.WalletSweepRequest
+	if err := mapstructure.Decode(rawRequest, &sweepRequest); err != nil {
+		log.Errorf("Error unmarshalling wallet_sweep request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if sweepRequest.Wallet == "" || sweepRequest.Action == "" || sweepRequest.Destination == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(sweepRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	_, err := utils.AddressToPub(sweepRequest.Destination, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, fmt.Sprintf("Invalid destination account %s", sweepRequest.Destination))
+		return
+	}
+
+	results, err := hc.Wallet.WalletSweep(r.Context(), dbWallet, sweepRequest.Destination, sweepRequest.BpowKey)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	total := big.NewInt(0)
+	resp := responses.WalletSweepResponse{
+		Sweeps: []responses.SweepResponse{},
+	}
+	for _, result := range results {
+		sweep := responses.SweepResponse{
+			Account:  result.Account,
+			Received: result.Received,
+			Amount:   result.Amount,
+			Block:    result.Hash,
+		}
+		if result.Error != nil {
+			sweep.Error = result.Error.Error()
+		}
+		if amount, ok := big.NewInt(0).SetString(result.Amount, 10); ok {
+			total.Add(total, amount)
+		}
+		resp.Sweeps = append(resp.Sweeps, sweep)
+	}
+	resp.Total = total.String()
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle building epoch v2 upgrade blocks for the accounts of a wallet
+// They're returned unsigned, only the network's epoch signer can sign and publish them
+// Without a wallet this is the node's epoch_upgrade, so it's forwarded
+func (hc *HttpController) HandleEpochUpgradeRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	if _, ok := (*rawRequest)["wallet"]; !ok {
+		hc.ForwardToNode(rawRequest, w, r)
+		return
+	}
+	epochRequest := hc.DecodeBaseRequest(rawRequest, w, r)
+	if epochRequest == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(epochRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	results, err := hc.Wallet.EpochUpgrade(r.Context(), dbWallet, epochRequest.BpowKey)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrBadRequest(w, r, err.Error())
+		return
+	}
+
+	resp := responses.EpochUpgradeResponse{
+		Blocks: []responses.EpochUpgradeBlock{},
+	}
+	for _, result := range results {
+		block := responses.EpochUpgradeBlock{
+			Account: result.Account,
+			Block:   result.Block,
+		}
+		if result.Block != nil {
+			block.Hash = result.Block.Hash
+		}
+		if result.Error != nil {
+			block.Error = result.Error.Error()
+		}
+		resp.Blocks = append(resp.Blocks, block)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -48,9 +49,9 @@ func TestReceive(t *testing.T) {
 		},
 	)
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("D8622D6D3754151F881D19CDE6E243C58AA05426ABB2BD5430455E653200ACC6"))
-	wallet, err := MockController.Wallet.WalletCreate(newSeed)
+	wallet, err := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	assert.Nil(t, err)
-	acc, err := MockController.Wallet.AccountCreate(wallet, nil)
+	acc, err := MockController.Wallet.AccountCreate(context.Background(), wallet, nil)
 	assert.Nil(t, err)
 	// Request JSON
 	reqBody := map[string]interface{}{
@@ -58,6 +59,7 @@ func TestReceive(t *testing.T) {
 		"wallet":  wallet.ID.String(),
 		"account": acc.Address,
 		"block":   "95D72CE5ECA6ABFDE45F77BD75F1C888223BCCA2D5178DF2A1D89533005C69DC",
+		"work":    "0000000000000000",
 	}
 	body, _ := json.Marshal(reqBody)
 	w := httptest.NewRecorder()
@@ -74,9 +76,59 @@ func TestReceive(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", respJson.Block)
+	assert.Equal(t, "", respJson.Confirmation)
+
+	// Wait for the confirmation
+	reqBody = map[string]interface{}{
+		"action":            "receive",
+		"wallet":            wallet.ID.String(),
+		"account":           acc.Address,
+		"block":             "95D72CE5ECA6ABFDE45F77BD75F1C888223BCCA2D5178DF2A1D89533005C69DC",
+		"wait_confirmation": 5,
+		"work":              "0000000000000000",
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	// Build request
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+
+	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", respJson.Block)
+	assert.Equal(t, "confirmed", respJson.Confirmation)
 
 	// errors
 
+	// Request JSON
+	reqBody = map[string]interface{}{
+		"action":            "receive",
+		"wallet":            wallet.ID.String(),
+		"account":           acc.Address,
+		"block":             "95D72CE5ECA6ABFDE45F77BD75F1C888223BCCA2D5178DF2A1D89533005C69DC",
+		"wait_confirmation": "soon",
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	// Build request
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	var waitResp map[string]interface{}
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &waitResp)
+
+	assert.Equal(t, "Invalid wait_confirmation", waitResp["error"])
+
 	// Request JSON
 	reqBody = map[string]interface{}{
 		"action":  "receive",
@@ -132,9 +184,9 @@ func TestSend(t *testing.T) {
 		},
 	)
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("95D72CE5ECA6ABFDE45F77BD75F1C888223BCCA2D5178DF2A1D89533005C69DC"))
-	wallet, err := MockController.Wallet.WalletCreate(newSeed)
+	wallet, err := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	assert.Nil(t, err)
-	acc, err := MockController.Wallet.AccountCreate(wallet, nil)
+	acc, err := MockController.Wallet.AccountCreate(context.Background(), wallet, nil)
 	assert.Nil(t, err)
 	// Request JSON
 	reqBody := map[string]interface{}{
@@ -212,6 +264,150 @@ func TestSend(t *testing.T) {
 	json.Unmarshal(respBody, &rawResp)
 
 	assert.Equal(t, "Invalid source account ban_1234", rawResp["error"])
+
+	// Nothing is published for a request that was cancelled
+	before := httpmock.GetTotalCallCount()
+	reqBody = map[string]interface{}{
+		"action":      "send",
+		"wallet":      wallet.ID.String(),
+		"source":      acc.Address,
+		"destination": acc.Address,
+		"amount":      "1000000000000000000000000000000",
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body)).WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.NotEqual(t, 200, resp.StatusCode)
+	assert.Equal(t, before, httpmock.GetTotalCallCount())
+}
+
+func TestSendMany(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			} else if pr.Action == "process" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+			return resp, err
+		},
+	)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("0E6C4A8E9D1B5F3A7C2D8E4F6A1B3C5D7E9F0A2B4C6D8E0F1A3B5C7D9E1F2A4B"))
+	wallet, err := MockController.Wallet.WalletCreate(context.Background(), newSeed)
+	assert.Nil(t, err)
+	acc, err := MockController.Wallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+	// Request JSON
+	reqBody := map[string]interface{}{
+		"action": "send_many",
+		"wallet": wallet.ID.String(),
+		"source": acc.Address,
+		"sends": []map[string]interface{}{
+			{
+				"destination": acc.Address,
+				"amount":      "1000000000000000000000000000000",
+				"id":          "payout-1",
+				"work":        "0000000000000000",
+			},
+			{
+				"destination": acc.Address,
+				"amount":      "100000000000000000000000000000000000000",
+				"work":        "0000000000000000",
+			},
+		},
+	}
+	body, _ := json.Marshal(reqBody)
+	w := httptest.NewRecorder()
+	// Build request
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var respJson responses.SendManyResponse
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+
+	assert.Len(t, respJson.Blocks, 2)
+	assert.Equal(t, "payout-1", *respJson.Blocks[0].ID)
+	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", respJson.Blocks[0].Block)
+	assert.Equal(t, "", respJson.Blocks[0].Error)
+	assert.Equal(t, "", respJson.Blocks[1].Block)
+	assert.Equal(t, "insufficient balance", respJson.Blocks[1].Error)
+
+	// errors
+
+	// Request JSON
+	reqBody = map[string]interface{}{
+		"action": "send_many",
+		"wallet": wallet.ID.String(),
+		"source": acc.Address,
+		"sends": []map[string]interface{}{
+			{
+				"destination": "ban_1234",
+				"amount":      "1000000000000000000000000000000",
+			},
+		},
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	// Build request
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	var rawResp map[string]interface{}
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &rawResp)
+
+	assert.Equal(t, "Invalid destination account ban_1234", rawResp["error"])
+
+	// Request JSON
+	reqBody = map[string]interface{}{
+		"action": "send_many",
+		"wallet": wallet.ID.String(),
+		"source": acc.Address,
+		"sends":  []map[string]interface{}{},
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	// Build request
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &rawResp)
+
+	assert.Equal(t, "Unable to parse json", rawResp["error"])
 }
 
 func TestAccountRepresentativeSet(t *testing.T) {
@@ -245,9 +441,9 @@ func TestAccountRepresentativeSet(t *testing.T) {
 		},
 	)
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("5DD64B08829F71953CE37435E3CAB4113691FDDCBD949004188C10CD15535DD6"))
-	wallet, err := MockController.Wallet.WalletCreate(newSeed)
+	wallet, err := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	assert.Nil(t, err)
-	acc, err := MockController.Wallet.AccountCreate(wallet, nil)
+	acc, err := MockController.Wallet.AccountCreate(context.Background(), wallet, nil)
 	assert.Nil(t, err)
 	// Request JSON
 	reqBody := map[string]interface{}{
@@ -322,3 +518,241 @@ func TestAccountRepresentativeSet(t *testing.T) {
 
 	assert.Equal(t, "Invalid representative account", rawResp["error"])
 }
+
+func TestSweep(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "receivable" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ReceivableResponseEmptyStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "Account not found",
+			})
+			return resp, err
+		},
+	)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("1F7D5B9E0C2A6F4B8D3E9A1C5F7B2D4E6A8C0E2F4B6D8A1C3E5F7B9D0A2C4E6F"))
+	wallet, err := MockController.Wallet.WalletCreate(context.Background(), newSeed)
+	assert.Nil(t, err)
+	acc, err := MockController.Wallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+	destination := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+
+	// Request JSON
+	reqBody := map[string]interface{}{
+		"action":      "account_sweep",
+		"wallet":      wallet.ID.String(),
+		"account":     acc.Address,
+		"destination": destination,
+	}
+	body, _ := json.Marshal(reqBody)
+	w := httptest.NewRecorder()
+	// Build request
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var sweepResp responses.SweepResponse
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &sweepResp)
+
+	assert.Equal(t, acc.Address, sweepResp.Account)
+	assert.Equal(t, 0, sweepResp.Received)
+	assert.Equal(t, "0", sweepResp.Amount)
+	assert.Equal(t, "", sweepResp.Block)
+
+	// Request JSON
+	reqBody = map[string]interface{}{
+		"action":      "wallet_sweep",
+		"wallet":      wallet.ID.String(),
+		"destination": destination,
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	// Build request
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var walletSweepResp responses.WalletSweepResponse
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &walletSweepResp)
+
+	assert.Equal(t, "0", walletSweepResp.Total)
+	// Index 0 from wallet creation plus the one we created
+	assert.Len(t, walletSweepResp.Sweeps, 2)
+	for _, sweep := range walletSweepResp.Sweeps {
+		assert.Equal(t, "0", sweep.Amount)
+		assert.Equal(t, "", sweep.Error)
+	}
+
+	// errors
+
+	// Request JSON
+	reqBody = map[string]interface{}{
+		"action":      "account_sweep",
+		"wallet":      wallet.ID.String(),
+		"account":     acc.Address,
+		"destination": "ban_1234",
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	// Build request
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	var rawResp map[string]interface{}
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &rawResp)
+
+	assert.Equal(t, "Invalid destination account ban_1234", rawResp["error"])
+
+	// Accounts of other wallets are a bad request, node errors aren't
+	reqBody["account"] = destination
+	reqBody["destination"] = acc.Address
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	assert.Equal(t, 400, w.Result().StatusCode)
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456", httpmock.NewStringResponder(200, `{"error":"Node is down"}`))
+	reqBody["account"] = acc.Address
+	reqBody["destination"] = destination
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	assert.Equal(t, 500, w.Result().StatusCode)
+
+	// Request JSON
+	reqBody = map[string]interface{}{
+		"action": "wallet_sweep",
+		"wallet": wallet.ID.String(),
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	// Build request
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &rawResp)
+
+	assert.Equal(t, "Unable to parse json", rawResp["error"])
+}
+
+func TestEpochUpgrade(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "epoch_upgrade" {
+				resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+					"started": "1",
+				})
+				return resp, err
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "Account not found",
+			})
+			return resp, err
+		},
+	)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("2A4C6E8B0D1F3A5C7E9B2D4F6A8C0E1B3D5F7A9C2E4B6D8F0A1C3E5B7D9F2A4C"))
+	wallet, err := MockController.Wallet.WalletCreate(context.Background(), newSeed)
+	assert.Nil(t, err)
+
+	// Request JSON
+	reqBody := map[string]interface{}{
+		"action": "epoch_upgrade",
+		"wallet": wallet.ID.String(),
+	}
+	body, _ := json.Marshal(reqBody)
+	w := httptest.NewRecorder()
+	// Build request
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var respJson responses.EpochUpgradeResponse
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+
+	// Only account is unopened
+	assert.Len(t, respJson.Blocks, 0)
+
+	// Without a wallet it goes to the node
+	reqBody = map[string]interface{}{
+		"action": "epoch_upgrade",
+		"epoch":  "2",
+		"key":    "0E6C4A8E9D1B5F3A7C2D8E4F6A1B3C5D7E9F0A2B4C6D8E0F1A3B5C7D9E1F2A4B",
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	// Build request
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var rawResp map[string]interface{}
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &rawResp)
+
+	assert.Equal(t, "1", rawResp["started"])
+
+	// errors
+
+	// Request JSON
+	reqBody = map[string]interface{}{
+		"action": "epoch_upgrade",
+		"wallet": "1234",
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	// Build request
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &rawResp)
+
+	assert.Equal(t, "wallet not found", rawResp["error"])
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
@@ -17,7 +18,7 @@ import (
 // Get wallet if it exists, set response
 func (hc *HttpController) WalletExists(walletId string, w http.ResponseWriter, r *http.Request) *ent.Wallet {
 	// See if wallet exists
-	dbWallet, err := hc.Wallet.GetWallet(walletId)
+	dbWallet, err := hc.Wallet.GetWallet(r.Context(), walletId)
 	if errors.Is(err, wallet.ErrWalletNotFound) || errors.Is(err, wallet.ErrInvalidWallet) {
 		ErrWalletNotFound(w, r)
 		return nil
@@ -71,6 +72,26 @@ func (hc *HttpController) DecodeBaseRequestWithCount(request *map[string]interfa
 	return &baseRequest, count
 }
 
+// Longest we'll hold a request open waiting for a confirmation
+const maxWaitConfirmation = time.Second * 300
+
+// Parse the optional wait_confirmation seconds, ok is false if the error response was already written
+func (hc *HttpController) DecodeWaitConfirmation(waitConfirmation *interface{}, w http.ResponseWriter, r *http.Request) (wait time.Duration, ok bool) {
+	if waitConfirmation == nil {
+		return 0, true
+	}
+	seconds, err := utils.ToInt(*waitConfirmation)
+	if err != nil || seconds < 0 {
+		ErrBadRequest(w, r, "Invalid wait_confirmation")
+		return 0, false
+	}
+	wait = time.Duration(seconds) * time.Second
+	if wait > maxWaitConfirmation {
+		wait = maxWaitConfirmation
+	}
+	return wait, true
+}
+
 // ! TODO - can we reduce duplication with generics or something ?
 func (hc *HttpController) DecodeAccountCreateRequest(request *map[string]interface{}, w http.ResponseWriter, r *http.Request) (*requests.AccountCreateRequest, *int) {
 	var accountCreateRequest requests.AccountCreateRequest
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http/httptest"
@@ -13,7 +14,7 @@ import (
 
 func TestWalletExists(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("9d9e1ede8170a7ef7fee2e28990dbc78c150b705ede136c4ab39dec349c38f42"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	w := httptest.NewRecorder()
 	// Build request
 	req := httptest.NewRequest("GET", "/", nil)
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	nanows "github.com/appditto/pippin_nano_wallet/apps/server/net"
 	"github.com/appditto/pippin_nano_wallet/libs/pow"
 	rpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet"
@@ -10,4 +11,6 @@ type HttpController struct {
 	Wallet    *wallet.NanoWallet
 	RpcClient *rpc.RPCClient
 	PowClient *pow.PippinPow
+	// Nil without a node websocket
+	Websockets *nanows.NodeWebsockets
 }
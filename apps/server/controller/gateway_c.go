@@ -85,6 +85,18 @@ func (hc *HttpController) Gateway(w http.ResponseWriter, r *http.Request) {
 	case "work_generate":
 		hc.HandleWorkGenerate(&baseRequest, w, r)
 		return
+	case "work_validate":
+		hc.HandleWorkValidate(&baseRequest, w, r)
+		return
+	case "work_peers":
+		hc.HandleWorkPeers(&baseRequest, w, r)
+		return
+	case "work_peer_add":
+		hc.HandleWorkPeerAdd(&baseRequest, w, r)
+		return
+	case "work_peers_clear":
+		hc.HandleWorkPeersClear(&baseRequest, w, r)
+		return
 	case "wallet_info":
 		hc.HandleWalletInfo(&baseRequest, w, r)
 		return
@@ -100,6 +112,18 @@ func (hc *HttpController) Gateway(w http.ResponseWriter, r *http.Request) {
 	case "send":
 		hc.HandleSendRequest(&baseRequest, w, r)
 		return
+	case "send_many":
+		hc.HandleSendManyRequest(&baseRequest, w, r)
+		return
+	case "account_resync":
+		hc.HandleAccountResync(&baseRequest, w, r)
+		return
+	case "account_sweep":
+		hc.HandleAccountSweepRequest(&baseRequest, w, r)
+		return
+	case "wallet_sweep":
+		hc.HandleWalletSweepRequest(&baseRequest, w, r)
+		return
 	case "account_representative_set":
 		hc.HandleAccountRepresentativeSetRequest(&baseRequest, w, r)
 		return
@@ -112,12 +136,39 @@ func (hc *HttpController) Gateway(w http.ResponseWriter, r *http.Request) {
 	case "wallet_change_seed":
 		hc.HandleWalletChangeSeedRequest(&baseRequest, w, r)
 		return
+	case "epoch_upgrade":
+		hc.HandleEpochUpgradeRequest(&baseRequest, w, r)
+		return
+	case "webhook_set":
+		hc.HandleWebhookSet(&baseRequest, w, r)
+		return
+	case "webhook_remove":
+		hc.HandleWebhookRemove(&baseRequest, w, r)
+		return
+	case "webhook_dead_letters":
+		hc.HandleWebhookDeadLetters(&baseRequest, w, r)
+		return
+	case "receive_policy_set":
+		hc.HandleReceivePolicySet(&baseRequest, w, r)
+		return
+	case "receive_policy":
+		hc.HandleReceivePolicy(&baseRequest, w, r)
+		return
+	case "receive_policy_remove":
+		hc.HandleReceivePolicyRemove(&baseRequest, w, r)
+		return
 	default:
-		resp, err := hc.RpcClient.MakeRequest(baseRequest)
-		if err != nil {
-			ErrInternalServerError(w, r, "Error forwarding request to node")
-		}
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(resp)
+		hc.ForwardToNode(&baseRequest, w, r)
+	}
+}
+
+// Send the request to the node as is and relay its response
+func (hc *HttpController) ForwardToNode(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	resp, err := hc.RpcClient.MakeRequest(r.Context(), *rawRequest)
+	if err != nil {
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/appditto/pippin_nano_wallet/libs/database"
 	"github.com/appditto/pippin_nano_wallet/libs/log"
 	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/pow/powtest"
 	"github.com/appditto/pippin_nano_wallet/libs/rpc"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet"
 	"github.com/stretchr/testify/assert"
@@ -55,20 +56,20 @@ func testMainWrapper(m *testing.M) int {
 		os.Exit(1)
 	}
 
-	// Setup nano wallet
+	// Setup nano wallet, sharing the pow client like the server does
+	powClient := powtest.NewPippinPow(powtest.NewProvider(), pow.ProviderLocal)
 	wallet := wallet.NanoWallet{
 		DB:         entClient,
-		Ctx:        ctx,
 		Banano:     false,
 		Config:     config,
-		WorkClient: pow.NewPippinPow([]string{}, "", "", 30),
+		WorkClient: powClient,
 		RpcClient:  rpc.NewRPCClient("http://localhost:123456"),
 	}
 
 	MockController = &HttpController{
 		Wallet:    &wallet,
 		RpcClient: rpc.NewRPCClient("http://localhost:123456"),
-		PowClient: pow.NewPippinPow([]string{}, "", "", 30),
+		PowClient: powClient,
 	}
 	return m.Run()
 }
@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/go-chi/render"
+)
+
+// Always 200 while pippin is up, degraded when no node websocket is getting confirmations
+// Also shows how far behind each event bus consumer is
+func (hc *HttpController) Health(w http.ResponseWriter, r *http.Request) {
+	resp := responses.HealthResponse{
+		Status:     "ok",
+		Websockets: hc.Websockets.Status(),
+		EventBus:   hc.Wallet.Events.Stats(),
+	}
+	if len(resp.Websockets) > 0 {
+		resp.Status = "degraded"
+		for _, status := range resp.Websockets {
+			if status.Connected {
+				resp.Status = "ok"
+				break
+			}
+		}
+	}
+	render.JSON(w, r, &resp)
+}
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	nanows "github.com/appditto/pippin_nano_wallet/apps/server/net"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealth(t *testing.T) {
+	// No websockets configured
+	w := httptest.NewRecorder()
+	MockController.Health(w, httptest.NewRequest("GET", "/health", nil))
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "ok", respJson["status"])
+	assert.Len(t, respJson["websockets"], 0)
+	assert.Len(t, respJson["event_bus"], 0)
+
+	// None of them connected
+	callbackChan := make(chan *nanows.WSCallbackMsg)
+	hc := *MockController
+	hc.Websockets = nanows.NewNodeWebsockets([]string{"ws://[::1]:7078", "ws://[::1]:7079"}, nanows.WSModeFailover, &callbackChan, nil)
+	w = httptest.NewRecorder()
+	hc.Health(w, httptest.NewRequest("GET", "/health", nil))
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "degraded", respJson["status"])
+	assert.Len(t, respJson["websockets"], 2)
+	assert.Equal(t, "ws://[::1]:7078", respJson["websockets"].([]interface{})[0].(map[string]interface{})["url"])
+	assert.Equal(t, false, respJson["websockets"].([]interface{})[0].(map[string]interface{})["connected"])
+}
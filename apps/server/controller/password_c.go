@@ -26,7 +26,7 @@ func (hc *HttpController) HandlePasswordChange(rawRequest *map[string]interface{
 		return
 	}
 
-	changed, err := hc.Wallet.EncryptWallet(dbWallet, passwordChangeRequest.Password)
+	changed, err := hc.Wallet.EncryptWallet(r.Context(), dbWallet, passwordChangeRequest.Password)
 	var resp = responses.PasswordChangeResponse{Changed: "1"}
 	if errors.Is(err, wallet.ErrWalletLocked) {
 		ErrWalletLocked(w, r)
@@ -55,7 +55,7 @@ func (hc *HttpController) HandlePasswordEnter(rawRequest *map[string]interface{}
 	}
 
 	// Unlock the wallet
-	unlocked, err := hc.Wallet.UnlockWallet(dbWallet, passwordEnterRequest.Password)
+	unlocked, err := hc.Wallet.UnlockWallet(r.Context(), dbWallet, passwordEnterRequest.Password)
 	var resp = responses.PasswordEnterResponse{Valid: "1"}
 	if errors.Is(err, wallet.ErrWalletNotLocked) {
 		ErrWalletNotLocked(w, r)
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http/httptest"
@@ -15,7 +16,7 @@ import (
 
 func TestPasswordChange(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("ffffff04c76978f47e6630bb97e6fc169dd734d25ddcb323609a5699789b104"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// Request JSON
 	// Wallet non-encrypted and empty password should return error
 	reqBody := map[string]interface{}{
@@ -67,16 +68,16 @@ func TestPasswordChange(t *testing.T) {
 	assert.Equal(t, "1", respJson["changed"].(string))
 
 	// // Make sure wallet is locked now
-	nWallet, _ := MockController.Wallet.GetWallet(wallet.ID.String())
+	nWallet, _ := MockController.Wallet.GetWallet(context.Background(), wallet.ID.String())
 	_, err := pw.GetDecryptedKeyFromStorage(nWallet, "seed")
 	assert.ErrorIs(t, err, pw.ErrWalletLocked)
 }
 
 func TestPasswordEnter(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("eeeeee04c76978f47e6630bb97e6fc169dd734d25ddcb323609a5699789b104"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// Lock wallet
-	MockController.Wallet.EncryptWallet(wallet, "mypassword")
+	MockController.Wallet.EncryptWallet(context.Background(), wallet, "mypassword")
 	// Request JSON
 	// Wallet non-encrypted and empty password should return error
 	reqBody := map[string]interface{}{
@@ -128,7 +129,7 @@ func TestPasswordEnter(t *testing.T) {
 	assert.Equal(t, "1", respJson["valid"].(string))
 
 	// Make sure wallet is unlocked
-	nWallet, _ := MockController.Wallet.GetWallet(wallet.ID.String())
+	nWallet, _ := MockController.Wallet.GetWallet(context.Background(), wallet.ID.String())
 	seed, err := pw.GetDecryptedKeyFromStorage(nWallet, "seed")
 	assert.Nil(t, err)
 	assert.Equal(t, newSeed, seed)
@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/go-chi/render"
+	"github.com/mitchellh/mapstructure"
+)
+
+func receivePolicyResponse(policy *ent.ReceivePolicy, source string) *responses.ReceivePolicyResponse {
+	resp := &responses.ReceivePolicyResponse{
+		Policy:      policy.Policy,
+		Allowlist:   policy.Allowlist,
+		BatchWindow: policy.BatchWindow,
+		Source:      source,
+	}
+	if resp.Allowlist == nil {
+		resp.Allowlist = []string{}
+	}
+	return resp
+}
+
+func receivePolicySource(account string) string {
+	if account != "" {
+		return "account"
+	}
+	return "wallet"
+}
+
+func (hc *HttpController) HandleReceivePolicySet(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.ReceivePolicySetRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling receive_policy_set request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Wallet == "" || request.Action == "" || request.Policy == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	batchWindow := 0
+	if request.BatchWindow != nil {
+		var err error
+		batchWindow, err = utils.ToInt(*request.BatchWindow)
+		if err != nil {
+			ErrBadRequest(w, r, "Invalid batch_window")
+			return
+		}
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	policy, err := hc.Wallet.SetReceivePolicy(r.Context(), dbWallet, request.Account, request.Policy, request.Allowlist, batchWindow)
+	if errors.Is(err, wallet.ErrInvalidReceivePolicy) || errors.Is(err, wallet.ErrInvalidAllowlist) || errors.Is(err, wallet.ErrInvalidBatchWindow) {
+		ErrBadRequest(w, r, err.Error())
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, "Account not found")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, receivePolicyResponse(policy, receivePolicySource(request.Account)))
+}
+
+// The policy that applies, falling back from the account's to the wallet's to the default
+func (hc *HttpController) HandleReceivePolicy(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.ReceivePolicyRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling receive_policy request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Wallet == "" || request.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	addresses := []string{""}
+	if request.Account != "" {
+		addresses = []string{request.Account, ""}
+	}
+	for _, address := range addresses {
+		policy, err := hc.Wallet.GetReceivePolicy(r.Context(), dbWallet, address)
+		if errors.Is(err, wallet.ErrReceivePolicyNotFound) {
+			continue
+		} else if errors.Is(err, wallet.ErrAccountNotFound) {
+			ErrBadRequest(w, r, "Account not found")
+			return
+		} else if err != nil {
+			ErrInternalServerError(w, r, err.Error())
+			return
+		}
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, receivePolicyResponse(policy, receivePolicySource(address)))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.ReceivePolicyResponse{
+		Policy:    wallet.ReceivePolicyEnabled,
+		Allowlist: []string{},
+		Source:    "default",
+	})
+}
+
+func (hc *HttpController) HandleReceivePolicyRemove(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.ReceivePolicyRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling receive_policy_remove request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Wallet == "" || request.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	err := hc.Wallet.RemoveReceivePolicy(r.Context(), dbWallet, request.Account)
+	if errors.Is(err, wallet.ErrReceivePolicyNotFound) {
+		ErrBadRequest(w, r, err.Error())
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, "Account not found")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.ReceivePolicyRemoveResponse{Removed: "1"})
+}
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReceivePolicyActions(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("1e3c5a7b9d2f4e6c8a0b1d3f5e7c9a2b4d6f8e0c1a3b5d7f9e2c4a6b8d0f1e3c"))
+	wallet, err := MockController.Wallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	acc, err := MockController.Wallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+
+	gateway := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Nothing set yet
+	status, respJson := gateway(map[string]interface{}{
+		"action":  "receive_policy",
+		"wallet":  wallet.ID.String(),
+		"account": acc.Address,
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "enabled", respJson["policy"])
+	assert.Equal(t, "default", respJson["source"])
+
+	// Bad input
+	status, respJson = gateway(map[string]interface{}{
+		"action": "receive_policy_set",
+		"wallet": wallet.ID.String(),
+		"policy": "sometimes",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "invalid receive policy", respJson["error"])
+	status, respJson = gateway(map[string]interface{}{
+		"action":       "receive_policy_set",
+		"wallet":       wallet.ID.String(),
+		"policy":       "deferred",
+		"batch_window": "soon",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "Invalid batch_window", respJson["error"])
+	status, respJson = gateway(map[string]interface{}{
+		"action":  "receive_policy_set",
+		"wallet":  wallet.ID.String(),
+		"account": "nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs",
+		"policy":  "disabled",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "Account not found", respJson["error"])
+
+	// The wallet's applies to its accounts
+	status, respJson = gateway(map[string]interface{}{
+		"action":       "receive_policy_set",
+		"wallet":       wallet.ID.String(),
+		"policy":       "deferred",
+		"batch_window": "600",
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "deferred", respJson["policy"])
+	assert.Equal(t, float64(600), respJson["batch_window"])
+	status, respJson = gateway(map[string]interface{}{
+		"action":  "receive_policy",
+		"wallet":  wallet.ID.String(),
+		"account": acc.Address,
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "deferred", respJson["policy"])
+	assert.Equal(t, "wallet", respJson["source"])
+
+	// Until the account has its own
+	status, respJson = gateway(map[string]interface{}{
+		"action":    "receive_policy_set",
+		"wallet":    wallet.ID.String(),
+		"account":   acc.Address,
+		"policy":    "allowlist",
+		"allowlist": []string{"nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs"},
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "account", respJson["source"])
+	status, respJson = gateway(map[string]interface{}{
+		"action":  "receive_policy",
+		"wallet":  wallet.ID.String(),
+		"account": acc.Address,
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "allowlist", respJson["policy"])
+	assert.Equal(t, []interface{}{"nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs"}, respJson["allowlist"])
+
+	status, respJson = gateway(map[string]interface{}{
+		"action":  "receive_policy_remove",
+		"wallet":  wallet.ID.String(),
+		"account": acc.Address,
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1", respJson["removed"])
+	status, _ = gateway(map[string]interface{}{
+		"action":  "receive_policy_remove",
+		"wallet":  wallet.ID.String(),
+		"account": acc.Address,
+	})
+	assert.Equal(t, 400, status)
+}
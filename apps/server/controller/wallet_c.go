@@ -41,7 +41,7 @@ func (hc *HttpController) HandleWalletCreate(request *map[string]interface{}, w
 		}
 	}
 
-	newWallet, err := hc.Wallet.WalletCreate(seed)
+	newWallet, err := hc.Wallet.WalletCreate(r.Context(), seed)
 	if errors.Is(err, wallet.ErrInvalidSeed) {
 		ErrInvalidSeed(w, r)
 		return
@@ -93,7 +93,7 @@ func (hc *HttpController) HandleWalletAdd(request *map[string]interface{}, w htt
 		ErrInvalidKey(w, r)
 		return
 	}
-	acc, err := hc.Wallet.AdhocAccountCreate(dbWallet, priv)
+	acc, err := hc.Wallet.AdhocAccountCreate(r.Context(), dbWallet, priv)
 	if errors.Is(err, wallet.ErrWalletLocked) {
 		ErrWalletLocked(w, r)
 		return
@@ -150,7 +150,7 @@ func (hc *HttpController) HandleWalletLock(rawRequest *map[string]interface{}, w
 	}
 
 	// Lock wallet
-	err := hc.Wallet.LockWallet(dbWallet)
+	err := hc.Wallet.LockWallet(r.Context(), dbWallet)
 	var resp = responses.WalletLockedResponse{
 		Locked: "1",
 	}
@@ -178,7 +178,7 @@ func (hc *HttpController) HandleWalletDestroy(rawRequest *map[string]interface{}
 	}
 
 	// Destroy list
-	err := hc.Wallet.WalletDestroy(dbWallet)
+	err := hc.Wallet.WalletDestroy(r.Context(), dbWallet)
 	var resp = responses.WalletDestroyResponse{
 		Destroyed: "1",
 	}
@@ -206,14 +206,14 @@ func (hc *HttpController) HandleWalletBalances(rawRequest *map[string]interface{
 	}
 
 	// Get accounts on wallet
-	_, accounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
+	_, accounts, err := hc.Wallet.AccountsList(r.Context(), dbWallet, math.MaxInt)
 	if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
 	}
 
 	// Get RPC balances
-	resp, err := hc.RpcClient.MakeAccountsBalancesRequest(accounts)
+	resp, err := hc.RpcClient.MakeAccountsBalancesRequest(r.Context(), accounts)
 	if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
@@ -237,14 +237,14 @@ func (hc *HttpController) HandleWalletFrontiers(rawRequest *map[string]interface
 	}
 
 	// Get accounts on wallet
-	_, accounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
+	_, accounts, err := hc.Wallet.AccountsList(r.Context(), dbWallet, math.MaxInt)
 	if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
 	}
 
 	// Get RPC balances
-	resp, err := hc.RpcClient.MakeAccountsFrontiersRequest(accounts)
+	resp, err := hc.RpcClient.MakeAccountsFrontiersRequest(r.Context(), accounts)
 	if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
@@ -268,14 +268,14 @@ func (hc *HttpController) HandleWalletPending(rawRequest *map[string]interface{}
 	}
 
 	// Get accounts on wallet
-	_, accounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
+	_, accounts, err := hc.Wallet.AccountsList(r.Context(), dbWallet, math.MaxInt)
 	if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
 	}
 
 	// Get RPC balances
-	resp, err := hc.RpcClient.MakeAccountsPendingRequest(accounts)
+	resp, err := hc.RpcClient.MakeAccountsPendingRequest(r.Context(), accounts)
 	if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
@@ -299,14 +299,14 @@ func (hc *HttpController) HandleWalletInfo(rawRequest *map[string]interface{}, w
 	}
 
 	// Get accounts on wallet
-	_, accounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
+	_, accounts, err := hc.Wallet.AccountsList(r.Context(), dbWallet, math.MaxInt)
 	if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
 	}
 
 	// Get RPC balances
-	resp, err := hc.RpcClient.MakeAccountsBalancesRequest(accounts)
+	resp, err := hc.RpcClient.MakeAccountsBalancesRequest(r.Context(), accounts)
 	if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
@@ -335,7 +335,7 @@ func (hc *HttpController) HandleWalletInfo(rawRequest *map[string]interface{}, w
 	}
 
 	// Retrieve wallet info from database
-	walletInfo, err := hc.Wallet.WalletInfo(dbWallet)
+	walletInfo, err := hc.Wallet.WalletInfo(r.Context(), dbWallet)
 	if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
@@ -379,7 +379,7 @@ func (hc *HttpController) HandleWalletContains(rawRequest *map[string]interface{
 	}
 
 	// See if account exists
-	exists, err := hc.Wallet.AccountExists(dbWallet, request.Account)
+	exists, err := hc.Wallet.AccountExists(r.Context(), dbWallet, request.Account)
 	if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
@@ -431,7 +431,7 @@ func (hc *HttpController) HandleWalletRepresentativeSetRequest(rawRequest *map[s
 		updateExisting, err = utils.ToBool(*changeRequest.UpdateExistingAccounts)
 	}
 
-	err = hc.Wallet.WalletRepresentativeSet(dbWallet, changeRequest.Representative, updateExisting, changeRequest.BpowKey)
+	err = hc.Wallet.WalletRepresentativeSet(r.Context(), dbWallet, changeRequest.Representative, updateExisting, changeRequest.BpowKey)
 	setResponse := responses.SetResponse{
 		Set: "1",
 	}
@@ -500,7 +500,7 @@ func (hc *HttpController) HandleWalletChangeSeedRequest(rawRequest *map[string]i
 	}
 
 	// Change the seed
-	newest, err := hc.Wallet.WalletChangeSeed(dbWallet, changeRequest.Seed)
+	newest, err := hc.Wallet.WalletChangeSeed(r.Context(), dbWallet, changeRequest.Seed)
 	if errors.Is(err, wallet.ErrWalletLocked) || errors.Is(err, wallet.ErrInvalidWallet) {
 		ErrWalletLocked(w, r)
 		return
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -94,7 +95,7 @@ func TestWalletCreateWithValidSeed(t *testing.T) {
 
 func TestWalletAdd(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("E11A48D701EA1F8A66A4EB587CDC8808D726FE75B325DF204F62CA2B43F9ADA1"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// Request JSON
 	reqBody := map[string]interface{}{
 		"action": "wallet_add",
@@ -146,7 +147,7 @@ func TestWalletAdd(t *testing.T) {
 
 func TestWalletLocked(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("A11A48D701EA1F8A66A4EB587CDC8808D726FE75B325DF204F62CA2B43F9ADA1"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// Request JSON
 	reqBody := map[string]interface{}{
 		"action": "wallet_locked",
@@ -170,7 +171,7 @@ func TestWalletLocked(t *testing.T) {
 	assert.Equal(t, "0", respJson["locked"].(string))
 
 	// Actually lock the wallet
-	MockController.Wallet.EncryptWallet(wallet, "password")
+	MockController.Wallet.EncryptWallet(context.Background(), wallet, "password")
 
 	reqBody = map[string]interface{}{
 		"action": "wallet_locked",
@@ -195,7 +196,7 @@ func TestWalletLocked(t *testing.T) {
 
 func TestWalletLock(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("ca439f7f9e6a3e2e0291b71391d2a097e6ba9912e5402588ddebf339fe46b270"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// Request JSON
 	reqBody := map[string]interface{}{
 		"action": "wallet_lock",
@@ -219,7 +220,7 @@ func TestWalletLock(t *testing.T) {
 	assert.Equal(t, "0", respJson["locked"].(string))
 
 	// Actually lock the wallet
-	MockController.Wallet.EncryptWallet(wallet, "password")
+	MockController.Wallet.EncryptWallet(context.Background(), wallet, "password")
 
 	reqBody = map[string]interface{}{
 		"action": "wallet_lock",
@@ -244,9 +245,9 @@ func TestWalletLock(t *testing.T) {
 
 func TestWalletDestroy(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("43cededf4d2bacaa096bfe0251519d2adedc31aa1a417073c5a23f30e74b3ed7"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// lock walet
-	MockController.Wallet.EncryptWallet(wallet, "password")
+	MockController.Wallet.EncryptWallet(context.Background(), wallet, "password")
 	// Request JSON
 	reqBody := map[string]interface{}{
 		"action": "wallet_destroy",
@@ -270,7 +271,7 @@ func TestWalletDestroy(t *testing.T) {
 	assert.Equal(t, "wallet locked", respJson["error"].(string))
 
 	// unlock wallet
-	MockController.Wallet.UnlockWallet(wallet, "password")
+	MockController.Wallet.UnlockWallet(context.Background(), wallet, "password")
 	reqBody = map[string]interface{}{
 		"action": "wallet_destroy",
 		"wallet": wallet.ID.String(),
@@ -292,7 +293,7 @@ func TestWalletDestroy(t *testing.T) {
 	assert.Equal(t, "1", respJson["destroyed"].(string))
 
 	// check if wallet is destroyed
-	_, err := MockController.Wallet.GetWallet(wallet.ID.String())
+	_, err := MockController.Wallet.GetWallet(context.Background(), wallet.ID.String())
 	assert.NotNil(t, err)
 }
 
@@ -309,7 +310,7 @@ func TestWalletBalances(t *testing.T) {
 		},
 	)
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("b0ec8a9edddc58abba90656853a27cb9968f0ba48b432c04eb0f2e9143d1e34c"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// Request JSON
 	reqBody := map[string]interface{}{
 		"action": "wallet_balances",
@@ -348,7 +349,7 @@ func TestWalletFrontiers(t *testing.T) {
 		},
 	)
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("597bce5b7950e33ad0c7755bf374b2a4c1a59a43e89b53cca1e4871bf8683c5e"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// Request JSON
 	reqBody := map[string]interface{}{
 		"action": "wallet_frontiers",
@@ -387,7 +388,7 @@ func TestWalletPending(t *testing.T) {
 		},
 	)
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("c53d6d42e94a41f55d8c7df53ebfceb7dffa468be271ab84e3d1a8221d385e0d"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// Request JSON
 	reqBody := map[string]interface{}{
 		"action": "wallet_pending",
@@ -426,9 +427,9 @@ func TestWalletInfo(t *testing.T) {
 		},
 	)
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("34c77ad1c1fbf4fd026c7c8c80069c0e84c636fcd9f0e4f14a69dacf1f9d67d0"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// Create some accounts
-	MockController.Wallet.AccountsCreate(wallet, 2)
+	MockController.Wallet.AccountsCreate(context.Background(), wallet, 2)
 	// Request JSON
 	reqBody := map[string]interface{}{
 		"action": "wallet_info",
@@ -459,9 +460,9 @@ func TestWalletInfo(t *testing.T) {
 
 func TestWalletContains(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("0dc54dc735bb2daad287a1bebc330f78a77af6097b9b45ac9a6144d4e57174ee"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// Create some accounts
-	accs, _ := MockController.Wallet.AccountsCreate(wallet, 2)
+	accs, _ := MockController.Wallet.AccountsCreate(context.Background(), wallet, 2)
 	// Request JSON
 	reqBody := map[string]interface{}{
 		"action":  "wallet_contains",
@@ -530,7 +531,7 @@ func TestWalletContains(t *testing.T) {
 
 func TestWalletRepresentativeSet(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("b40ee7fa4a110bb17e7706e30eeed3bc360f571ddde6b436d7926ed3e77449f2"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// Request JSON
 	reqBody := map[string]interface{}{
 		"action":         "wallet_representative_set",
@@ -578,7 +579,7 @@ func TestWalletRepresentativeSet(t *testing.T) {
 
 func TestWalletRepresentative(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("addf0e0b362aaf49f68ae75caff32cdcd05a5e7a444f5befdb9759e2069c076b"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// Request JSON
 	reqBody := map[string]interface{}{
 		"action": "wallet_representative",
@@ -603,7 +604,7 @@ func TestWalletRepresentative(t *testing.T) {
 
 	// Bad request
 	// Encrypt the wallet
-	MockController.Wallet.EncryptWallet(wallet, "password")
+	MockController.Wallet.EncryptWallet(context.Background(), wallet, "password")
 	reqBody = map[string]interface{}{
 		"action": "wallet_representative",
 		"wallet": wallet.ID.String(),
@@ -627,7 +628,7 @@ func TestWalletRepresentative(t *testing.T) {
 
 func TestWalletChangeSeed(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("addf0e0b362aaf49f68ae75caff32cdcd05a5e7a444f5befdb9759e2069c076b"))
-	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	wallet, _ := MockController.Wallet.WalletCreate(context.Background(), newSeed)
 	// Request JSON
 	reqBody := map[string]interface{}{
 		"action": "wallet_change_seed",
@@ -656,7 +657,7 @@ func TestWalletChangeSeed(t *testing.T) {
 
 	// Bad request
 	// Encrypt the wallet
-	MockController.Wallet.EncryptWallet(wallet, "password")
+	MockController.Wallet.EncryptWallet(context.Background(), wallet, "password")
 	reqBody = map[string]interface{}{
 		"action": "wallet_change_seed",
 		"wallet": wallet.ID.String(),
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/go-chi/render"
+	"github.com/mitchellh/mapstructure"
+)
+
+func (hc *HttpController) HandleWebhookSet(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.WebhookSetRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling webhook_set request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Wallet == "" || request.Action == "" || request.Url == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	hook, err := hc.Wallet.SetWebhook(r.Context(), dbWallet, request.Url, request.Secret, request.Events)
+	if errors.Is(err, wallet.ErrInvalidWebhookUrl) || errors.Is(err, wallet.ErrInvalidWebhookEvent) {
+		ErrBadRequest(w, r, err.Error())
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.WebhookResponse{
+		Url:    hook.URL,
+		Secret: hook.Secret,
+		Events: hook.Events,
+	}
+	if resp.Events == nil {
+		resp.Events = []string{}
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+func (hc *HttpController) HandleWebhookRemove(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	request := hc.DecodeBaseRequest(rawRequest, w, r)
+	if request == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	err := hc.Wallet.RemoveWebhook(r.Context(), dbWallet)
+	if errors.Is(err, wallet.ErrWebhookNotFound) {
+		ErrBadRequest(w, r, err.Error())
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.WebhookRemoveResponse{Removed: "1"})
+}
+
+func (hc *HttpController) HandleWebhookDeadLetters(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	request, count := hc.DecodeBaseRequestWithCount(rawRequest, w, r)
+	if request == nil {
+		return
+	} else if count == 0 {
+		// Default 100
+		count = 100
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	deadLetters, err := hc.Wallet.GetWebhookDeadLetters(r.Context(), dbWallet, count)
+	if errors.Is(err, wallet.ErrWebhookNotFound) {
+		ErrBadRequest(w, r, err.Error())
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.WebhookDeadLettersResponse{
+		DeadLetters: []responses.WebhookDeadLetter{},
+	}
+	for _, deadLetter := range deadLetters {
+		item := responses.WebhookDeadLetter{
+			Id:        deadLetter.ID.String(),
+			Event:     deadLetter.Event,
+			Payload:   deadLetter.Payload,
+			Attempts:  deadLetter.Attempts,
+			CreatedAt: deadLetter.CreatedAt.Unix(),
+		}
+		if deadLetter.LastError != nil {
+			item.LastError = *deadLetter.LastError
+		}
+		resp.DeadLetters = append(resp.DeadLetters, item)
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
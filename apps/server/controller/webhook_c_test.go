@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookActions(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("6c1e9a7b3d5f2e4c8a0b6d1f3e5c7a9b2d4f6e8c0a1b3d5f7e9c2a4b6d8f0e1c"))
+	wallet, err := MockController.Wallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+
+	gateway := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Nothing set yet
+	status, respJson := gateway(map[string]interface{}{
+		"action": "webhook_dead_letters",
+		"wallet": wallet.ID.String(),
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "webhook not found", respJson["error"])
+
+	// Bad url and events
+	status, respJson = gateway(map[string]interface{}{
+		"action": "webhook_set",
+		"wallet": wallet.ID.String(),
+		"url":    "example.com",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "invalid webhook url", respJson["error"])
+	status, respJson = gateway(map[string]interface{}{
+		"action": "webhook_set",
+		"wallet": wallet.ID.String(),
+		"url":    "https://example.com/hook",
+		"events": []string{"nothing"},
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "invalid webhook event", respJson["error"])
+
+	status, respJson = gateway(map[string]interface{}{
+		"action": "webhook_set",
+		"wallet": wallet.ID.String(),
+		"url":    "https://example.com/hook",
+		"events": []string{"incoming_send_confirmed", "send_confirmed"},
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "https://example.com/hook", respJson["url"])
+	assert.Len(t, respJson["secret"], 64)
+	assert.Equal(t, []interface{}{"incoming_send_confirmed", "send_confirmed"}, respJson["events"])
+
+	status, respJson = gateway(map[string]interface{}{
+		"action": "webhook_dead_letters",
+		"wallet": wallet.ID.String(),
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []interface{}{}, respJson["dead_letters"])
+
+	status, respJson = gateway(map[string]interface{}{
+		"action": "webhook_remove",
+		"wallet": wallet.ID.String(),
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1", respJson["removed"])
+	status, _ = gateway(map[string]interface{}{
+		"action": "webhook_remove",
+		"wallet": wallet.ID.String(),
+	})
+	assert.Equal(t, 400, status)
+}
@@ -1,6 +1,8 @@
 package controller
 
 import (
+	"fmt"
+	"net"
 	"net/http"
 	"strconv"
 
@@ -30,7 +32,8 @@ func (hc *HttpController) HandleWorkGenerate(rawRequest *map[string]interface{},
 		return
 	}
 
-	// Difficulty is optional, we default to 64 if in nano mode, 1 if banano mode
+	// Difficulty is optional, we default to the epoch v2 threshold of the subtype in nano mode, send if not given
+	// raised by the network's active difficulty
 	// Banano is always 1 no matter what
 	difficulty := 1
 	if !hc.Wallet.Banano {
@@ -40,14 +43,15 @@ func (hc *HttpController) HandleWorkGenerate(rawRequest *map[string]interface{},
 			// Their difficulty is invalid
 			if err != nil {
 				ErrUnableToParseJson(w, r)
+				return
 			}
 			difficulty = pow.MultiplierFromDifficulty(difficultyUint)
-		} else if workRequest.Subtype == "receive" {
-			// Receives always get 1
-			difficulty = 1
 		} else {
-			// Default to 64 (nano send)
-			difficulty = 64
+			subtype := workRequest.Subtype
+			if subtype == "" {
+				subtype = "send"
+			}
+			difficulty = hc.PowClient.AdjustMultiplier(pow.MultiplierForBlock(subtype, 2, false))
 		}
 	}
 
@@ -56,7 +60,7 @@ func (hc *HttpController) HandleWorkGenerate(rawRequest *map[string]interface{},
 		blockAward = *workRequest.BlockAward
 	}
 
-	work, err := hc.PowClient.WorkGenerateMeta(workRequest.Hash, difficulty, true, blockAward, workRequest.BpowKey)
+	work, err := hc.Wallet.GenerateWork(r.Context(), workRequest.Hash, difficulty, blockAward, workRequest.BpowKey)
 	if err != nil {
 		log.Errorf("Error generating work %s", err)
 		ErrWorkFailed(w, r)
@@ -70,3 +74,93 @@ func (hc *HttpController) HandleWorkGenerate(rawRequest *map[string]interface{},
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, resp)
 }
+
+// Validated here instead of the node, so it works in banano mode too
+func (hc *HttpController) HandleWorkValidate(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var validateRequest requests.WorkValidateRequest
+	if err := mapstructure.Decode(rawRequest, &validateRequest); err != nil {
+		log.Errorf("Error unmarshalling work_validate request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if validateRequest.Action == "" || validateRequest.Hash == "" || validateRequest.Work == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	if !utils.Validate64HexHash(validateRequest.Hash) {
+		ErrInvalidHash(w, r)
+		return
+	}
+
+	// Difficulty and multiplier are optional, like the node valid is only returned with one of them
+	base := pow.SendThreshold
+	if hc.Wallet.Banano {
+		base = pow.ReceiveThreshold
+	}
+	var difficulty uint64
+	var err error
+	if validateRequest.Difficulty != "" {
+		difficulty, err = strconv.ParseUint(validateRequest.Difficulty, 16, 64)
+		if err != nil {
+			ErrBadRequest(w, r, "Bad difficulty")
+			return
+		}
+	} else if validateRequest.Multiplier != "" {
+		multiplier, err := strconv.ParseFloat(validateRequest.Multiplier, 64)
+		if err == nil {
+			difficulty, err = pow.DifficultyFromMultiplierFloat(multiplier, base)
+		}
+		if err != nil {
+			ErrBadRequest(w, r, "Bad multiplier")
+			return
+		}
+	}
+
+	resp, err := pow.ValidateWork(validateRequest.Hash, validateRequest.Work, difficulty, hc.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, "Bad work")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+// Stats of every work peer, nano's work_peers only returns addresses
+func (hc *HttpController) HandleWorkPeers(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, responses.WorkPeersResponse{
+		WorkPeers: hc.PowClient.PeerStats(),
+	})
+}
+
+// Adds a work peer to this instance until it restarts, work_peers in config.yaml is permanent
+func (hc *HttpController) HandleWorkPeerAdd(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var peerRequest requests.WorkPeerAddRequest
+	if err := mapstructure.Decode(rawRequest, &peerRequest); err != nil {
+		log.Errorf("Error unmarshalling work_peer_add request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if peerRequest.Action == "" || peerRequest.Address == "" || peerRequest.Port == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	port, err := strconv.Atoi(peerRequest.Port)
+	if err != nil || port < 1 || port > 65535 {
+		ErrBadRequest(w, r, "Invalid port")
+		return
+	}
+
+	hc.PowClient.AddWorkPeer(fmt.Sprintf("http://%s", net.JoinHostPort(peerRequest.Address, peerRequest.Port)))
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, responses.SuccessResponse{})
+}
+
+func (hc *HttpController) HandleWorkPeersClear(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	hc.PowClient.ClearWorkPeers()
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, responses.SuccessResponse{})
+}
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -58,3 +59,154 @@ func TestWorkGenerate(t *testing.T) {
 	assert.Equal(t, "Invalid hash", respJson["error"])
 
 }
+
+func TestWorkPeers(t *testing.T) {
+	defer MockController.PowClient.ClearWorkPeers()
+
+	// Add a peer
+	reqBody := map[string]interface{}{
+		"action":  "work_peer_add",
+		"address": "::1",
+		"port":    "7076",
+	}
+	body, _ := json.Marshal(reqBody)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "", respJson["success"])
+
+	// Invalid port
+	reqBody["port"] = "70000"
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "Invalid port", respJson["error"])
+
+	// List peers with their stats
+	reqBody = map[string]interface{}{
+		"action": "work_peers",
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var peersJson responses.WorkPeersResponse
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &peersJson)
+	assert.Len(t, peersJson.WorkPeers, 1)
+	assert.Equal(t, "http://[::1]:7076", peersJson.WorkPeers[0].Url)
+	assert.Equal(t, int64(0), peersJson.WorkPeers[0].Requests)
+	assert.False(t, peersJson.WorkPeers[0].CircuitOpen)
+
+	// Clear them
+	reqBody = map[string]interface{}{
+		"action": "work_peers_clear",
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Len(t, MockController.PowClient.PeerStats(), 0)
+}
+
+func TestWorkValidate(t *testing.T) {
+	// Request JSON
+	reqBody := map[string]interface{}{
+		"action": "work_validate",
+		"hash":   "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+		"work":   "205452237a9b01f4",
+	}
+	body, _ := json.Marshal(reqBody)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.NotContains(t, respJson, "valid")
+	assert.Equal(t, "1", respJson["valid_all"])
+	assert.Equal(t, "1", respJson["valid_receive"])
+	assert.Equal(t, "ffffffff287741bf", respJson["difficulty"])
+	assert.Equal(t, "9.501974378880858", respJson["multiplier"])
+
+	// With a multiplier it's too low for
+	reqBody["multiplier"] = "10"
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	respJson = map[string]interface{}{}
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "0", respJson["valid"])
+
+	// Short work isn't padded
+	reqBody = map[string]interface{}{
+		"action": "work_validate",
+		"hash":   "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+		"work":   "2054",
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "Bad work", respJson["error"])
+
+	// Invalid difficulty
+	reqBody["work"] = "205452237a9b01f4"
+	reqBody["difficulty"] = "nothex"
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "Bad difficulty", respJson["error"])
+}
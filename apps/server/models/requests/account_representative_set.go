@@ -5,4 +5,6 @@ type AccountRepresentativeSetRequest struct {
 	Account        string  `json:"account" mapstructure:"account"`
 	Representative string  `json:"representative" mapstructure:"representative"`
 	Work           *string `json:"work,omitempty" mapstructure:"work,omitempty"`
+	// Seconds to wait for the block to be confirmed before responding
+	WaitConfirmation *interface{} `json:"wait_confirmation,omitempty" mapstructure:"wait_confirmation,omitempty"`
 }
@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountResyncRequest(t *testing.T) {
+	encoded := `{"action":"account_resync","wallet":"1234","account":"nano_1"}`
+	var decoded AccountResyncRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_resync", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+}
+
+func TestMapStructureDecodeAccountResyncRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "account_resync",
+		"wallet":  "1234",
+		"account": "nano_1",
+	}
+	var decoded AccountResyncRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_resync", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+}
@@ -0,0 +1,16 @@
+package requests
+
+// For receive_policy and receive_policy_remove, the wallet's policy without an account
+type ReceivePolicyRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Account     string `json:"account,omitempty" mapstructure:"account,omitempty"`
+}
+
+type ReceivePolicySetRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Account     string   `json:"account,omitempty" mapstructure:"account,omitempty"`
+	Policy      string   `json:"policy" mapstructure:"policy"`
+	Allowlist   []string `json:"allowlist,omitempty" mapstructure:"allowlist,omitempty"`
+	// Seconds, for the deferred policy
+	BatchWindow *interface{} `json:"batch_window,omitempty" mapstructure:"batch_window,omitempty"`
+}
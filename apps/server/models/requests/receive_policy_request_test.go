@@ -0,0 +1,50 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeReceivePolicySetRequest(t *testing.T) {
+	encoded := `{"action":"receive_policy_set","wallet":"1234","account":"nano_1","policy":"allowlist","allowlist":["nano_2"],"batch_window":"60"}`
+	var decoded ReceivePolicySetRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "receive_policy_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "allowlist", decoded.Policy)
+	assert.Equal(t, []string{"nano_2"}, decoded.Allowlist)
+	assert.Equal(t, "60", *decoded.BatchWindow)
+}
+
+func TestMapStructureDecodeReceivePolicySetRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "receive_policy_set",
+		"wallet": "1234",
+		"policy": "deferred",
+	}
+	var decoded ReceivePolicySetRequest
+	assert.Nil(t, mapstructure.Decode(request, &decoded))
+	assert.Equal(t, "receive_policy_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "", decoded.Account)
+	assert.Equal(t, "deferred", decoded.Policy)
+	assert.Nil(t, decoded.Allowlist)
+	assert.Nil(t, decoded.BatchWindow)
+}
+
+func TestMapStructureDecodeReceivePolicyRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "receive_policy",
+		"wallet":  "1234",
+		"account": "nano_1",
+	}
+	var decoded ReceivePolicyRequest
+	assert.Nil(t, mapstructure.Decode(request, &decoded))
+	assert.Equal(t, "receive_policy", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+}
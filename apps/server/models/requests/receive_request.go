@@ -6,4 +6,6 @@ type ReceiveRequest struct {
 	BpowKey     *string `json:"bpow_key,omitempty" mapstructure:"bpow_key,omitempty"`
 	Account     string  `json:"account" mapstructure:"account"`
 	Block       string  `json:"block" mapstructure:"block"`
+	// Seconds to wait for the block to be confirmed before responding
+	WaitConfirmation *interface{} `json:"wait_confirmation,omitempty" mapstructure:"wait_confirmation,omitempty"`
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/mitchellh/mapstructure"
 	"github.com/stretchr/testify/assert"
 )
@@ -34,3 +35,19 @@ func TestMapStructureDecodeReceiveRequest(t *testing.T) {
 	assert.Equal(t, "abc", *decoded.BpowKey)
 	assert.Nil(t, decoded.Work)
 }
+
+func TestMapStructureDecodeReceiveRequestWaitConfirmation(t *testing.T) {
+	request := map[string]interface{}{
+		"action":            "receive",
+		"wallet":            "1234",
+		"account":           "nano_1",
+		"block":             "abc",
+		"wait_confirmation": "10",
+	}
+	var decoded ReceiveRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "receive", decoded.Action)
+	assert.Equal(t, "abc", decoded.Block)
+	wait, _ := utils.ToInt(*decoded.WaitConfirmation)
+	assert.Equal(t, 10, wait)
+}
@@ -0,0 +1,14 @@
+package requests
+
+type SendManyItem struct {
+	Destination string  `json:"destination" mapstructure:"destination"`
+	Amount      string  `json:"amount" mapstructure:"amount"`
+	ID          *string `json:"id,omitempty" mapstructure:"id,omitempty"`
+	Work        *string `json:"work,omitempty" mapstructure:"work,omitempty"`
+}
+
+type SendManyRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Source      string         `json:"source" mapstructure:"source"`
+	Sends       []SendManyItem `json:"sends" mapstructure:"sends"`
+}
@@ -0,0 +1,52 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSendManyRequest(t *testing.T) {
+	encoded := `{"action":"send_many","wallet":"1234","source":"nano_1","bpow_key":"abc","sends":[{"destination":"nano_2","amount":"1234","id":"a"},{"destination":"nano_3","amount":"5","work":"0000000000000000"}]}`
+	var decoded SendManyRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "send_many", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Source)
+	assert.Equal(t, "abc", *decoded.BpowKey)
+	assert.Len(t, decoded.Sends, 2)
+	assert.Equal(t, "nano_2", decoded.Sends[0].Destination)
+	assert.Equal(t, "1234", decoded.Sends[0].Amount)
+	assert.Equal(t, "a", *decoded.Sends[0].ID)
+	assert.Nil(t, decoded.Sends[0].Work)
+	assert.Equal(t, "nano_3", decoded.Sends[1].Destination)
+	assert.Nil(t, decoded.Sends[1].ID)
+	assert.Equal(t, "0000000000000000", *decoded.Sends[1].Work)
+}
+
+func TestMapStructureDecodeSendManyRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "send_many",
+		"wallet": "1234",
+		"source": "nano_1",
+		"sends": []interface{}{
+			map[string]interface{}{
+				"destination": "nano_2",
+				"amount":      "1234",
+				"id":          "a",
+			},
+		},
+	}
+	var decoded SendManyRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "send_many", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Source)
+	assert.Nil(t, decoded.BpowKey)
+	assert.Len(t, decoded.Sends, 1)
+	assert.Equal(t, "nano_2", decoded.Sends[0].Destination)
+	assert.Equal(t, "1234", decoded.Sends[0].Amount)
+	assert.Equal(t, "a", *decoded.Sends[0].ID)
+}
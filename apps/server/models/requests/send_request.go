@@ -12,6 +12,8 @@ type SendRequest struct {
 	Amount      string  `json:"amount" mapstructure:"amount"`
 	ID          *string `json:"id,omitempty" mapstructure:"id,omitempty"`
 	Work        *string `json:"work,omitempty" mapstructure:"work,omitempty"`
+	// Seconds to wait for the block to be confirmed before responding
+	WaitConfirmation *interface{} `json:"wait_confirmation,omitempty" mapstructure:"wait_confirmation,omitempty"`
 }
 
 func (r *SendRequest) UnmarshalJSON(data []byte) error {
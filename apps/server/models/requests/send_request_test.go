@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/mitchellh/mapstructure"
 	"github.com/stretchr/testify/assert"
 )
@@ -53,3 +54,20 @@ func TestMapStructureDecodeSendRequest(t *testing.T) {
 	assert.Equal(t, "abc", *decoded.BpowKey)
 	assert.Nil(t, decoded.Work)
 }
+
+func TestMapStructureDecodeSendRequestWaitConfirmation(t *testing.T) {
+	request := map[string]interface{}{
+		"action":            "send",
+		"wallet":            "1234",
+		"source":            "nano_1",
+		"destination":       "nano_2",
+		"amount":            "1234",
+		"wait_confirmation": float64(30),
+	}
+	var decoded SendRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "send", decoded.Action)
+	assert.Equal(t, "1234", decoded.Amount)
+	wait, _ := utils.ToInt(*decoded.WaitConfirmation)
+	assert.Equal(t, 30, wait)
+}
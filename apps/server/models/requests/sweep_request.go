@@ -0,0 +1,12 @@
+package requests
+
+type AccountSweepRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Account     string `json:"account" mapstructure:"account"`
+	Destination string `json:"destination" mapstructure:"destination"`
+}
+
+type WalletSweepRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Destination string `json:"destination" mapstructure:"destination"`
+}
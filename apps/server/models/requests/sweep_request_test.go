@@ -0,0 +1,39 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapStructureDecodeAccountSweepRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":      "account_sweep",
+		"wallet":      "1234",
+		"account":     "nano_1",
+		"destination": "nano_2",
+		"bpow_key":    "abc",
+	}
+	var decoded AccountSweepRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_sweep", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "nano_2", decoded.Destination)
+	assert.Equal(t, "abc", *decoded.BpowKey)
+}
+
+func TestMapStructureDecodeWalletSweepRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":      "wallet_sweep",
+		"wallet":      "1234",
+		"destination": "nano_2",
+	}
+	var decoded WalletSweepRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_sweep", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_2", decoded.Destination)
+	assert.Nil(t, decoded.BpowKey)
+}
@@ -0,0 +1,8 @@
+package requests
+
+type WebhookSetRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Url         string   `json:"url" mapstructure:"url"`
+	Secret      string   `json:"secret,omitempty" mapstructure:"secret,omitempty"`
+	Events      []string `json:"events,omitempty" mapstructure:"events,omitempty"`
+}
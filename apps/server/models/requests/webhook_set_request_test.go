@@ -0,0 +1,36 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWebhookSetRequest(t *testing.T) {
+	encoded := `{"action":"webhook_set","wallet":"1234","url":"https://example.com","secret":"abc","events":["send_published"]}`
+	var decoded WebhookSetRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "webhook_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "https://example.com", decoded.Url)
+	assert.Equal(t, "abc", decoded.Secret)
+	assert.Equal(t, []string{"send_published"}, decoded.Events)
+}
+
+func TestMapStructureDecodeWebhookSetRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "webhook_set",
+		"wallet": "1234",
+		"url":    "https://example.com",
+		"events": []interface{}{"send_published", "send_confirmed"},
+	}
+	var decoded WebhookSetRequest
+	assert.Nil(t, mapstructure.Decode(request, &decoded))
+	assert.Equal(t, "webhook_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "https://example.com", decoded.Url)
+	assert.Equal(t, "", decoded.Secret)
+	assert.Equal(t, []string{"send_published", "send_confirmed"}, decoded.Events)
+}
@@ -0,0 +1,7 @@
+package requests
+
+type WorkPeerAddRequest struct {
+	Action  string `json:"action" mapstructure:"action"`
+	Address string `json:"address" mapstructure:"address"`
+	Port    string `json:"port" mapstructure:"port"`
+}
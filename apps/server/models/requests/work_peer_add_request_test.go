@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWorkPeerAddRequest(t *testing.T) {
+	encoded := `{"action":"work_peer_add","address":"::ffff:172.17.0.1","port":"7076"}`
+	var decoded WorkPeerAddRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "work_peer_add", decoded.Action)
+	assert.Equal(t, "::ffff:172.17.0.1", decoded.Address)
+	assert.Equal(t, "7076", decoded.Port)
+}
+
+func TestMapStructureDecodeWorkPeerAddRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "work_peer_add",
+		"address": "localhost",
+		"port":    "5555",
+	}
+	var decoded WorkPeerAddRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "work_peer_add", decoded.Action)
+	assert.Equal(t, "localhost", decoded.Address)
+	assert.Equal(t, "5555", decoded.Port)
+}
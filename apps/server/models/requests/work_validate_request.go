@@ -0,0 +1,9 @@
+package requests
+
+type WorkValidateRequest struct {
+	Action     string `json:"action" mapstructure:"action"`
+	Hash       string `json:"hash" mapstructure:"hash"`
+	Work       string `json:"work" mapstructure:"work"`
+	Difficulty string `json:"difficulty" mapstructure:"difficulty"`
+	Multiplier string `json:"multiplier" mapstructure:"multiplier"`
+}
@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWorkValidateRequest(t *testing.T) {
+	encoded := `{"action":"work_validate","hash":"my hash","work":"my work","difficulty":"my difficulty","multiplier":"1.5"}`
+	var decoded WorkValidateRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "work_validate", decoded.Action)
+	assert.Equal(t, "my hash", decoded.Hash)
+	assert.Equal(t, "my work", decoded.Work)
+	assert.Equal(t, "my difficulty", decoded.Difficulty)
+	assert.Equal(t, "1.5", decoded.Multiplier)
+}
+
+func TestMapStructureDecodeWorkValidateRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "work_validate",
+		"hash":   "my hash",
+		"work":   "my work",
+	}
+	var decoded WorkValidateRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "work_validate", decoded.Action)
+	assert.Equal(t, "my hash", decoded.Hash)
+	assert.Equal(t, "my work", decoded.Work)
+	assert.Equal(t, "", decoded.Difficulty)
+	assert.Equal(t, "", decoded.Multiplier)
+}
@@ -0,0 +1,8 @@
+package responses
+
+type AccountResyncResponse struct {
+	Account        string `json:"account" mapstructure:"account"`
+	Frontier       string `json:"frontier" mapstructure:"frontier"`
+	Balance        string `json:"balance" mapstructure:"balance"`
+	Representative string `json:"representative" mapstructure:"representative"`
+}
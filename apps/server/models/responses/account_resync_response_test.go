@@ -0,0 +1,20 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountResyncResponse(t *testing.T) {
+	response := AccountResyncResponse{
+		Account:        "nano_1",
+		Frontier:       "1234",
+		Balance:        "100",
+		Representative: "nano_2",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"account\":\"nano_1\",\"frontier\":\"1234\",\"balance\":\"100\",\"representative\":\"nano_2\"}", string(encoded))
+}
@@ -2,4 +2,6 @@ package responses
 
 type BlockResponse struct {
 	Block string `json:"block"`
+	// Only set when wait_confirmation was requested
+	Confirmation string `json:"confirmation,omitempty"`
 }
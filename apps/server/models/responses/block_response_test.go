@@ -15,3 +15,13 @@ func TestEncodeBlockResponse(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "{\"block\":\"1234\"}", string(encoded))
 }
+
+func TestEncodeBlockResponseWithConfirmation(t *testing.T) {
+	response := BlockResponse{
+		Block:        "1234",
+		Confirmation: "confirmed",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"block\":\"1234\",\"confirmation\":\"confirmed\"}", string(encoded))
+}
@@ -0,0 +1,15 @@
+package responses
+
+import walletmodels "github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+
+// Block is unsigned, the epoch signer signs Hash
+type EpochUpgradeBlock struct {
+	Account string                   `json:"account" mapstructure:"account"`
+	Hash    string                   `json:"hash,omitempty" mapstructure:"hash,omitempty"`
+	Block   *walletmodels.StateBlock `json:"block,omitempty" mapstructure:"block,omitempty"`
+	Error   string                   `json:"error,omitempty" mapstructure:"error,omitempty"`
+}
+
+type EpochUpgradeResponse struct {
+	Blocks []EpochUpgradeBlock `json:"blocks" mapstructure:"blocks"`
+}
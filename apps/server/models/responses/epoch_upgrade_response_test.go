@@ -0,0 +1,32 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	walletmodels "github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeEpochUpgradeResponse(t *testing.T) {
+	response := EpochUpgradeResponse{
+		Blocks: []EpochUpgradeBlock{
+			{
+				Account: "nano_1",
+				Hash:    "1234",
+				Block: &walletmodels.StateBlock{
+					Type: "state",
+					Hash: "1234",
+					Work: "abcd",
+				},
+			},
+			{
+				Account: "nano_2",
+				Error:   "Bad signature",
+			},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"blocks\":[{\"account\":\"nano_1\",\"hash\":\"1234\",\"block\":{\"type\":\"state\",\"hash\":\"1234\",\"account\":\"\",\"previous\":\"\",\"representative\":\"\",\"balance\":\"\",\"link\":\"\",\"work\":\"abcd\",\"signature\":\"\"}},{\"account\":\"nano_2\",\"error\":\"Bad signature\"}]}", string(encoded))
+}
@@ -0,0 +1,13 @@
+package responses
+
+import (
+	"github.com/appditto/pippin_nano_wallet/apps/server/net"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+)
+
+type HealthResponse struct {
+	Status     string                 `json:"status" mapstructure:"status"`
+	Websockets []net.WSEndpointStatus `json:"websockets" mapstructure:"websockets"`
+	// Queued and handled events per consumer
+	EventBus []wallet.BusConsumerStats `json:"event_bus" mapstructure:"event_bus"`
+}
@@ -0,0 +1,25 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/net"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeHealthResponse(t *testing.T) {
+	response := HealthResponse{
+		Status: "ok",
+		Websockets: []net.WSEndpointStatus{
+			{Url: "ws://[::1]:7078", Active: true, Connected: true},
+		},
+		EventBus: []wallet.BusConsumerStats{
+			{Name: "auto_receive", Queued: 2, Handled: 10},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"status\":\"ok\",\"websockets\":[{\"url\":\"ws://[::1]:7078\",\"active\":true,\"connected\":true}],\"event_bus\":[{\"name\":\"auto_receive\",\"queued\":2,\"handled\":10}]}", string(encoded))
+}
@@ -0,0 +1,13 @@
+package responses
+
+type ReceivePolicyResponse struct {
+	Policy      string   `json:"policy" mapstructure:"policy"`
+	Allowlist   []string `json:"allowlist" mapstructure:"allowlist"`
+	BatchWindow int      `json:"batch_window" mapstructure:"batch_window"`
+	// Where the policy comes from: account, wallet or default
+	Source string `json:"source" mapstructure:"source"`
+}
+
+type ReceivePolicyRemoveResponse struct {
+	Removed string `json:"removed" mapstructure:"removed"`
+}
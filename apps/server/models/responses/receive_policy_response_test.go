@@ -0,0 +1,26 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeReceivePolicyResponse(t *testing.T) {
+	response := ReceivePolicyResponse{
+		Policy:      "allowlist",
+		Allowlist:   []string{"nano_1"},
+		BatchWindow: 3600,
+		Source:      "account",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"policy\":\"allowlist\",\"allowlist\":[\"nano_1\"],\"batch_window\":3600,\"source\":\"account\"}", string(encoded))
+}
+
+func TestEncodeReceivePolicyRemoveResponse(t *testing.T) {
+	encoded, err := json.Marshal(ReceivePolicyRemoveResponse{Removed: "1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"removed\":\"1\"}", string(encoded))
+}
@@ -0,0 +1,13 @@
+package responses
+
+type SendManyBlock struct {
+	Destination string  `json:"destination" mapstructure:"destination"`
+	Amount      string  `json:"amount" mapstructure:"amount"`
+	ID          *string `json:"id,omitempty" mapstructure:"id,omitempty"`
+	Block       string  `json:"block,omitempty" mapstructure:"block,omitempty"`
+	Error       string  `json:"error,omitempty" mapstructure:"error,omitempty"`
+}
+
+type SendManyResponse struct {
+	Blocks []SendManyBlock `json:"blocks" mapstructure:"blocks"`
+}
@@ -0,0 +1,30 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeSendManyResponse(t *testing.T) {
+	id := "a"
+	response := SendManyResponse{
+		Blocks: []SendManyBlock{
+			{
+				Destination: "nano_1",
+				Amount:      "1",
+				ID:          &id,
+				Block:       "1234",
+			},
+			{
+				Destination: "nano_2",
+				Amount:      "2",
+				Error:       "insufficient balance",
+			},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"blocks\":[{\"destination\":\"nano_1\",\"amount\":\"1\",\"id\":\"a\",\"block\":\"1234\"},{\"destination\":\"nano_2\",\"amount\":\"2\",\"error\":\"insufficient balance\"}]}", string(encoded))
+}
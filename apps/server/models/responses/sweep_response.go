@@ -0,0 +1,14 @@
+package responses
+
+type SweepResponse struct {
+	Account  string `json:"account" mapstructure:"account"`
+	Received int    `json:"received" mapstructure:"received"`
+	Amount   string `json:"amount" mapstructure:"amount"`
+	Block    string `json:"block,omitempty" mapstructure:"block,omitempty"`
+	Error    string `json:"error,omitempty" mapstructure:"error,omitempty"`
+}
+
+type WalletSweepResponse struct {
+	Total  string          `json:"total" mapstructure:"total"`
+	Sweeps []SweepResponse `json:"sweeps" mapstructure:"sweeps"`
+}
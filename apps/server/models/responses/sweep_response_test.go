@@ -0,0 +1,42 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeSweepResponse(t *testing.T) {
+	response := SweepResponse{
+		Account:  "nano_1",
+		Received: 2,
+		Amount:   "100",
+		Block:    "1234",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"account\":\"nano_1\",\"received\":2,\"amount\":\"100\",\"block\":\"1234\"}", string(encoded))
+}
+
+func TestEncodeWalletSweepResponse(t *testing.T) {
+	response := WalletSweepResponse{
+		Total: "100",
+		Sweeps: []SweepResponse{
+			{
+				Account:  "nano_1",
+				Received: 0,
+				Amount:   "100",
+				Block:    "1234",
+			},
+			{
+				Account: "nano_2",
+				Amount:  "0",
+				Error:   "wallet locked",
+			},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"total\":\"100\",\"sweeps\":[{\"account\":\"nano_1\",\"received\":0,\"amount\":\"100\",\"block\":\"1234\"},{\"account\":\"nano_2\",\"received\":0,\"amount\":\"0\",\"error\":\"wallet locked\"}]}", string(encoded))
+}
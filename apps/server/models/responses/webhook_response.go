@@ -0,0 +1,24 @@
+package responses
+
+type WebhookResponse struct {
+	Url    string   `json:"url" mapstructure:"url"`
+	Secret string   `json:"secret" mapstructure:"secret"`
+	Events []string `json:"events" mapstructure:"events"`
+}
+
+type WebhookRemoveResponse struct {
+	Removed string `json:"removed" mapstructure:"removed"`
+}
+
+type WebhookDeadLetter struct {
+	Id        string `json:"id" mapstructure:"id"`
+	Event     string `json:"event" mapstructure:"event"`
+	Payload   string `json:"payload" mapstructure:"payload"`
+	Attempts  int    `json:"attempts" mapstructure:"attempts"`
+	LastError string `json:"last_error,omitempty" mapstructure:"last_error,omitempty"`
+	CreatedAt int64  `json:"created_at" mapstructure:"created_at"`
+}
+
+type WebhookDeadLettersResponse struct {
+	DeadLetters []WebhookDeadLetter `json:"dead_letters" mapstructure:"dead_letters"`
+}
@@ -0,0 +1,39 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWebhookResponse(t *testing.T) {
+	response := WebhookResponse{
+		Url:    "https://example.com",
+		Secret: "abc",
+		Events: []string{},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"url\":\"https://example.com\",\"secret\":\"abc\",\"events\":[]}", string(encoded))
+}
+
+func TestEncodeWebhookRemoveResponse(t *testing.T) {
+	response := WebhookRemoveResponse{
+		Removed: "1",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"removed\":\"1\"}", string(encoded))
+}
+
+func TestEncodeWebhookDeadLettersResponse(t *testing.T) {
+	response := WebhookDeadLettersResponse{
+		DeadLetters: []WebhookDeadLetter{
+			{Id: "1", Event: "send_published", Payload: "{}", Attempts: 10, LastError: "webhook returned 500", CreatedAt: 1},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"dead_letters\":[{\"id\":\"1\",\"event\":\"send_published\",\"payload\":\"{}\",\"attempts\":10,\"last_error\":\"webhook returned 500\",\"created_at\":1}]}", string(encoded))
+}
@@ -0,0 +1,11 @@
+package responses
+
+import "github.com/appditto/pippin_nano_wallet/libs/pow/models"
+
+type WorkPeersResponse struct {
+	WorkPeers []models.PeerStats `json:"work_peers" mapstructure:"work_peers"`
+}
+
+type SuccessResponse struct {
+	Success string `json:"success" mapstructure:"success"`
+}
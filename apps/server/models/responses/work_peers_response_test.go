@@ -0,0 +1,32 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/pow/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWorkPeersResponse(t *testing.T) {
+	response := WorkPeersResponse{
+		WorkPeers: []models.PeerStats{
+			{
+				Url:              "http://localhost:5555",
+				Requests:         3,
+				Failures:         1,
+				AverageLatencyMs: 20,
+				CircuitOpen:      false,
+			},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"work_peers":[{"url":"http://localhost:5555","requests":3,"failures":1,"average_latency_ms":20,"circuit_open":false}]}`, string(encoded))
+}
+
+func TestEncodeSuccessResponse(t *testing.T) {
+	encoded, err := json.Marshal(SuccessResponse{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"success":""}`, string(encoded))
+}
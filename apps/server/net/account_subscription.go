@@ -0,0 +1,136 @@
+package net
+
+import (
+	"sort"
+	"sync"
+)
+
+type wsUpdate struct {
+	Action  string              `json:"action"`
+	Topic   string              `json:"topic"`
+	Options map[string][]string `json:"options"`
+}
+
+// The accounts the websocket gets confirmations for
+// Changes are sent to the node as update messages, a new connection subscribes with the full set
+type AccountSubscription struct {
+	accounts map[string]struct{}
+	// Changes the node doesn't know about yet
+	added   map[string]struct{}
+	removed map[string]struct{}
+	changed chan struct{}
+	mutex   sync.Mutex
+}
+
+func NewAccountSubscription() *AccountSubscription {
+	return &AccountSubscription{
+		accounts: make(map[string]struct{}),
+		added:    make(map[string]struct{}),
+		removed:  make(map[string]struct{}),
+		changed:  make(chan struct{}, 1),
+	}
+}
+
+// Adds and removes accounts
+func (s *AccountSubscription) Update(added []string, removed []string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, account := range removed {
+		if _, ok := s.accounts[account]; !ok {
+			continue
+		}
+		delete(s.accounts, account)
+		if _, ok := s.added[account]; ok {
+			delete(s.added, account)
+		} else {
+			s.removed[account] = struct{}{}
+		}
+	}
+	for _, account := range added {
+		if _, ok := s.accounts[account]; ok {
+			continue
+		}
+		s.accounts[account] = struct{}{}
+		if _, ok := s.removed[account]; ok {
+			delete(s.removed, account)
+		} else {
+			s.added[account] = struct{}{}
+		}
+	}
+	if len(s.added) > 0 || len(s.removed) > 0 {
+		s.notify()
+	}
+}
+
+// Replaces the accounts with this set, only the difference is sent to the node
+func (s *AccountSubscription) Set(accounts []string) {
+	s.mutex.Lock()
+	wanted := make(map[string]struct{}, len(accounts))
+	for _, account := range accounts {
+		wanted[account] = struct{}{}
+	}
+	removed := []string{}
+	for account := range s.accounts {
+		if _, ok := wanted[account]; !ok {
+			removed = append(removed, account)
+		}
+	}
+	s.mutex.Unlock()
+	s.Update(accounts, removed)
+}
+
+func (s *AccountSubscription) notify() {
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Every account, for a new subscription which makes pending changes obsolete
+func (s *AccountSubscription) subscribeRequest(id string) wsSubscribe {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.added = make(map[string]struct{})
+	s.removed = make(map[string]struct{})
+	return wsSubscribe{
+		Action: "subscribe",
+		Topic:  "confirmation",
+		Ack:    false,
+		Id:     id,
+		Options: map[string][]string{
+			"accounts": sortedKeys(s.accounts),
+		},
+	}
+}
+
+// Pending changes for the current subscription, nil when there are none
+func (s *AccountSubscription) updateRequest() *wsUpdate {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if len(s.added) < 1 && len(s.removed) < 1 {
+		return nil
+	}
+	update := &wsUpdate{
+		Action:  "update",
+		Topic:   "confirmation",
+		Options: map[string][]string{},
+	}
+	if len(s.added) > 0 {
+		update.Options["accounts_add"] = sortedKeys(s.added)
+	}
+	if len(s.removed) > 0 {
+		update.Options["accounts_del"] = sortedKeys(s.removed)
+	}
+	s.added = make(map[string]struct{})
+	s.removed = make(map[string]struct{})
+	return update
+}
@@ -0,0 +1,45 @@
+package net
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountSubscription(t *testing.T) {
+	s := NewAccountSubscription()
+	s.Set([]string{"nano_b", "nano_a"})
+
+	// A new subscription has everything, nothing left to update
+	sub := s.subscribeRequest("id")
+	assert.Equal(t, "subscribe", sub.Action)
+	assert.Equal(t, "confirmation", sub.Topic)
+	assert.Equal(t, []string{"nano_a", "nano_b"}, sub.Options["accounts"])
+	assert.Nil(t, s.updateRequest())
+
+	// Changes after that are updates
+	s.Update([]string{"nano_c", "nano_a"}, []string{"nano_b", "nano_x"})
+	update := s.updateRequest()
+	assert.Equal(t, "update", update.Action)
+	assert.Equal(t, "confirmation", update.Topic)
+	assert.Equal(t, []string{"nano_c"}, update.Options["accounts_add"])
+	assert.Equal(t, []string{"nano_b"}, update.Options["accounts_del"])
+	assert.Nil(t, s.updateRequest())
+
+	// Added and removed again before the node heard about it
+	s.Update([]string{"nano_d"}, nil)
+	s.Update(nil, []string{"nano_d"})
+	assert.Nil(t, s.updateRequest())
+
+	// Set sends the difference
+	s.Set([]string{"nano_a", "nano_e"})
+	update = s.updateRequest()
+	assert.Equal(t, []string{"nano_e"}, update.Options["accounts_add"])
+	assert.Equal(t, []string{"nano_c"}, update.Options["accounts_del"])
+
+	// Reconnecting subscribes with the full set
+	s.Update([]string{"nano_f"}, nil)
+	sub = s.subscribeRequest("id")
+	assert.Equal(t, []string{"nano_a", "nano_e", "nano_f"}, sub.Options["accounts"])
+	assert.Nil(t, s.updateRequest())
+}
@@ -0,0 +1,258 @@
+package net
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	guuid "github.com/google/uuid"
+	"github.com/gorilla/websocket"
+)
+
+// Wallet events go through redis so clients get them whichever instance emitted them
+const eventsChannel = "pippin:events"
+
+// Events waiting to be written to a client before it's dropped as too slow
+const eventClientBuffer = 64
+
+const (
+	eventWriteTimeout = time.Second * 10
+	eventPongTimeout  = time.Second * 60
+	eventPingInterval = time.Second * 30
+)
+
+type eventRequest struct {
+	Action string `json:"action"`
+	Wallet string `json:"wallet"`
+	Key    string `json:"key"`
+}
+
+type eventAck struct {
+	Ack    string `json:"ack,omitempty"`
+	Wallet string `json:"wallet,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+type eventClient struct {
+	send chan []byte
+	// Guarded by the hub's mutex
+	wallets map[string]bool
+}
+
+// Serves wallet events to websocket clients subscribed to a wallet
+type EventHub struct {
+	key      string
+	upgrader websocket.Upgrader
+	clients  map[*eventClient]bool
+	mutex    sync.RWMutex
+}
+
+// Clients need key to subscribe, the hub refuses connections without one
+// Browsers can connect from the same host or one of origins, other clients don't send an Origin
+func NewEventHub(key string, origins []string) *EventHub {
+	return &EventHub{
+		key: key,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				if origin == "" || slices.Contains(origins, origin) {
+					return true
+				}
+				u, err := url.Parse(origin)
+				return err == nil && strings.EqualFold(u.Host, r.Host)
+			},
+		},
+		clients: make(map[*eventClient]bool),
+	}
+}
+
+// Sends an event to every instance's subscribers, meant to be the wallet's EventHandler
+func (h *EventHub) Publish(event models.WalletEvent) {
+	if event.Wallet == "" {
+		return
+	}
+	payload, err := json.Marshal(models.EventPayload{
+		Id:          guuid.New().String(),
+		Timestamp:   time.Now().Unix(),
+		WalletEvent: event,
+	})
+	if err != nil {
+		return
+	}
+	if err := database.GetRedisDB().Publish(eventsChannel, string(payload)); err != nil {
+		log.Errorf("Error publishing event %s for %s %v", event.Type, event.Account, err)
+	}
+}
+
+// Passes events from redis to local subscribers until ctx is done
+func (h *EventHub) Start(ctx context.Context) {
+	for ctx.Err() == nil {
+		pubsub, err := database.GetRedisDB().Subscribe(eventsChannel)
+		if err != nil {
+			log.Errorf("Error subscribing to events %v", err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second * 5):
+			}
+			continue
+		}
+		messages := pubsub.Channel()
+	read:
+		for {
+			select {
+			case <-ctx.Done():
+				break read
+			case msg, ok := <-messages:
+				if !ok {
+					break read
+				}
+				h.broadcast([]byte(msg.Payload))
+			}
+		}
+		pubsub.Close()
+	}
+}
+
+// Queues an event for the clients subscribed to its wallet, dropping the ones that fell behind
+func (h *EventHub) broadcast(payload []byte) {
+	var event models.EventPayload
+	if err := json.Unmarshal(payload, &event); err != nil || event.Wallet == "" {
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	for client := range h.clients {
+		if !client.wallets[event.Wallet] {
+			continue
+		}
+		select {
+		case client.send <- payload:
+		default:
+			log.Warnf("Dropping slow event subscriber")
+			h.remove(client)
+		}
+	}
+}
+
+func (h *EventHub) remove(client *eventClient) {
+	if h.clients[client] {
+		delete(h.clients, client)
+		close(client.send)
+	}
+}
+
+// Upgrades to a websocket, clients send {"action":"subscribe","wallet":"<id>","key":"<key>"} for each wallet they want
+func (h *EventHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.key == "" {
+		http.Error(w, "Events are disabled", http.StatusNotFound)
+		return
+	}
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	client := &eventClient{
+		send:    make(chan []byte, eventClientBuffer),
+		wallets: make(map[string]bool),
+	}
+	h.mutex.Lock()
+	h.clients[client] = true
+	h.mutex.Unlock()
+
+	go h.writeLoop(conn, client)
+	h.readLoop(conn, client)
+
+	h.mutex.Lock()
+	h.remove(client)
+	h.mutex.Unlock()
+}
+
+func (h *EventHub) readLoop(conn *websocket.Conn, client *eventClient) {
+	conn.SetReadLimit(4096)
+	conn.SetReadDeadline(time.Now().Add(eventPongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(eventPongTimeout))
+	})
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var request eventRequest
+		if err := json.Unmarshal(message, &request); err != nil {
+			h.reply(client, eventAck{Error: "Unable to parse json"})
+			continue
+		}
+		h.reply(client, h.handle(client, request))
+	}
+}
+
+func (h *EventHub) handle(client *eventClient, request eventRequest) eventAck {
+	if _, err := guuid.Parse(request.Wallet); err != nil {
+		return eventAck{Error: "Invalid wallet"}
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	switch request.Action {
+	case "subscribe":
+		if h.key == "" || subtle.ConstantTimeCompare([]byte(request.Key), []byte(h.key)) != 1 {
+			return eventAck{Error: "Invalid key"}
+		}
+		client.wallets[request.Wallet] = true
+	case "unsubscribe":
+		delete(client.wallets, request.Wallet)
+	default:
+		return eventAck{Error: "Unknown action"}
+	}
+	return eventAck{Ack: request.Action, Wallet: request.Wallet}
+}
+
+func (h *EventHub) reply(client *eventClient, ack eventAck) {
+	payload, _ := json.Marshal(ack)
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if !h.clients[client] {
+		return
+	}
+	select {
+	case client.send <- payload:
+	default:
+		h.remove(client)
+	}
+}
+
+// Writes queued messages and keeps the connection alive, closes it when the client is removed
+func (h *EventHub) writeLoop(conn *websocket.Conn, client *eventClient) {
+	ticker := time.NewTicker(eventPingInterval)
+	defer func() {
+		ticker.Stop()
+		conn.Close()
+	}()
+	for {
+		select {
+		case payload, ok := <-client.send:
+			conn.SetWriteDeadline(time.Now().Add(eventWriteTimeout))
+			if !ok {
+				conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+			if err := conn.WriteMessage(websocket.TextMessage, payload); err != nil {
+				return
+			}
+		case <-ticker.C:
+			conn.SetWriteDeadline(time.Now().Add(eventWriteTimeout))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
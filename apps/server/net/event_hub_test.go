@@ -0,0 +1,104 @@
+package net
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	guuid "github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventHub(t *testing.T) {
+	os.Setenv("MOCK_REDIS", "true")
+	defer os.Unsetenv("MOCK_REDIS")
+
+	hub := NewEventHub("secret", nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go hub.Start(ctx)
+	server := httptest.NewServer(hub)
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+	wallet := guuid.New().String()
+
+	var ack eventAck
+	assert.Nil(t, conn.WriteJSON(eventRequest{Action: "subscribe", Wallet: wallet, Key: "wrong"}))
+	assert.Nil(t, conn.ReadJSON(&ack))
+	assert.Equal(t, "Invalid key", ack.Error)
+	assert.Nil(t, conn.WriteJSON(eventRequest{Action: "subscribe", Wallet: "notawallet", Key: "secret"}))
+	assert.Nil(t, conn.ReadJSON(&ack))
+	assert.Equal(t, "Invalid wallet", ack.Error)
+	assert.Nil(t, conn.WriteJSON(eventRequest{Action: "subscribe", Wallet: wallet, Key: "secret"}))
+	ack = eventAck{}
+	assert.Nil(t, conn.ReadJSON(&ack))
+	assert.Equal(t, eventAck{Ack: "subscribe", Wallet: wallet}, ack)
+
+	// Only events of the wallets it subscribed to
+	assert.Eventually(t, func() bool {
+		subs, _ := database.GetRedisDB().Client.PubSubNumSub(ctx, eventsChannel).Result()
+		return subs[eventsChannel] > 0
+	}, time.Second, time.Millisecond*10)
+	hub.Publish(models.WalletEvent{Type: models.EventSendConfirmed, Wallet: guuid.New().String(), Account: "nano_other"})
+	hub.Publish(models.WalletEvent{Type: models.EventWorkGenerated, Wallet: wallet, Account: "nano_mine", Hash: "ABCD", Detail: "1234"})
+	var event models.EventPayload
+	assert.Nil(t, conn.ReadJSON(&event))
+	assert.Equal(t, models.EventWorkGenerated, event.Type)
+	assert.Equal(t, wallet, event.Wallet)
+	assert.Equal(t, "nano_mine", event.Account)
+	assert.NotEmpty(t, event.Id)
+
+	// Nothing after unsubscribing
+	assert.Nil(t, conn.WriteJSON(eventRequest{Action: "unsubscribe", Wallet: wallet}))
+	ack = eventAck{}
+	assert.Nil(t, conn.ReadJSON(&ack))
+	assert.Equal(t, "unsubscribe", ack.Ack)
+	hub.Publish(models.WalletEvent{Type: models.EventSendConfirmed, Wallet: wallet, Account: "nano_mine"})
+	conn.SetReadDeadline(time.Now().Add(time.Millisecond * 200))
+	_, _, err = conn.ReadMessage()
+	assert.NotNil(t, err)
+}
+
+func TestEventHubRefusals(t *testing.T) {
+	// Nothing is served without a key
+	server := httptest.NewServer(NewEventHub("", nil))
+	_, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	server.Close()
+
+	// Browsers only from the same host or the allowed origins
+	server = httptest.NewServer(NewEventHub("secret", []string{"https://wallet.example.com"}))
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	for origin, allowed := range map[string]bool{
+		"":                           true,
+		server.URL:                   true,
+		"https://wallet.example.com": true,
+		"https://evil.example.com":   false,
+	} {
+		header := http.Header{}
+		if origin != "" {
+			header.Set("Origin", origin)
+		}
+		conn, resp, err := websocket.DefaultDialer.Dial(url, header)
+		if allowed {
+			assert.Nil(t, err, origin)
+			conn.Close()
+		} else {
+			assert.NotNil(t, err, origin)
+			assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		}
+	}
+}
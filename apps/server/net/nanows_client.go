@@ -3,9 +3,6 @@ package net
 import (
 	"context"
 	"encoding/json"
-	"os"
-	"os/signal"
-	"syscall"
 	"time"
 
 	"github.com/appditto/pippin_nano_wallet/libs/log"
@@ -50,69 +47,95 @@ type WSCallbackMsg struct {
 	Amount  string          `json:"amount"`
 }
 
-func StartNanoWSClient(wsUrl string, callbackChan *chan *WSCallbackMsg) {
-	ctx, cancel := context.WithCancel(context.Background())
-	sentSubscribe := false
+// Gets confirmations from one node until ctx is done
+// Gives up when it's been disconnected for longer than giveUpAfter, unless that's 0
+func (e *wsEndpoint) run(ctx context.Context, giveUpAfter time.Duration, group *NodeWebsockets) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 	ws := recws.RecConn{}
-	// Nano subscription request
-	subRequest := wsSubscribe{
-		Action: "subscribe",
-		Topic:  "confirmation",
-		Ack:    false,
-		Id:     guuid.New().String(),
-		// ! TODO - subscribe to only connected acounts
-		// Options: map[string][]string{
-		// 	"accounts": {
-		// 		account,
-		// 	},
-		// },
+	ws.Dial(e.url, nil)
+	defer e.connected.Store(false)
+	// Unblocks a read waiting for the next confirmation
+	go func() {
+		<-ctx.Done()
+		ws.Close()
+	}()
+	var disconnectedSince time.Time
+	disconnected := func() {
+		if e.connected.Swap(false) {
+			group.lost()
+		}
+		if disconnectedSince.IsZero() {
+			disconnectedSince = time.Now()
+		}
 	}
-	ws.Dial(wsUrl, nil)
 
-	sigc := make(chan os.Signal, 1)
-	signal.Notify(sigc,
-		syscall.SIGHUP,
-		syscall.SIGINT,
-		syscall.SIGTERM,
-		syscall.SIGQUIT)
-	defer func() {
-		signal.Stop(sigc)
-		cancel()
+	// Send account changes as they happen, reads block until the next confirmation
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-e.subscription.changed:
+				// Not subscribed yet, the subscription will have them
+				if !e.connected.Load() {
+					continue
+				}
+				update := e.subscription.updateRequest()
+				if update == nil {
+					continue
+				}
+				if err := ws.WriteJSON(update); err != nil {
+					// Reconnecting, subscribes with every account again
+					log.Infof("Error sending subscription update %s", e.url)
+					if e.connected.Swap(false) {
+						group.lost()
+					}
+				}
+			}
+		}
 	}()
 
 	for {
 		select {
-		case <-sigc:
-			cancel()
-			return
 		case <-ctx.Done():
-			go ws.Close()
-			log.Infof("Websocket closed %s", ws.GetURL())
+			if e.connected.Swap(false) {
+				group.lost()
+			}
+			log.Infof("Websocket closed %s", e.url)
 			return
 		default:
 			if !ws.IsConnected() {
-				sentSubscribe = false
-				log.Infof("Websocket disconnected %s", ws.GetURL())
+				disconnected()
+				if giveUpAfter > 0 && time.Since(disconnectedSince) > giveUpAfter {
+					log.Infof("Websocket %s down for %s, giving up", e.url, time.Since(disconnectedSince).Round(time.Second))
+					return
+				}
+				log.Infof("Websocket disconnected %s", e.url)
 				time.Sleep(2 * time.Second)
 				continue
 			}
 
-			// Sent subscribe with ack
-			if !sentSubscribe {
-				if err := ws.WriteJSON(subRequest); err != nil {
-					log.Infof("Error sending subscribe request %s", ws.GetURL())
+			// Subscribe with every account, also after reconnecting
+			if !e.connected.Load() {
+				if err := ws.WriteJSON(e.subscription.subscribeRequest(guuid.New().String())); err != nil {
+					log.Infof("Error sending subscribe request %s", e.url)
+					disconnected()
 					time.Sleep(2 * time.Second)
 					continue
-				} else {
-					sentSubscribe = true
 				}
+				e.connected.Store(true)
+				disconnectedSince = time.Time{}
+				group.gained()
+				// Changes that came in while subscribing
+				e.subscription.notify()
 			}
 
 			var confMessage ConfirmationResponse
 			err := ws.ReadJSON(&confMessage)
 			if err != nil {
-				log.Infof("Error: ReadJSON %s", ws.GetURL())
-				sentSubscribe = false
+				log.Infof("Error: ReadJSON %s", e.url)
+				disconnected()
 				continue
 			}
 
@@ -128,7 +151,7 @@ func StartNanoWSClient(wsUrl string, callbackChan *chan *WSCallbackMsg) {
 					log.Errorf("Error: decoding the callback to WSCallbackMsg %v", err)
 					continue
 				}
-				*callbackChan <- &deserialized
+				group.deliver(&deserialized)
 			}
 		}
 	}
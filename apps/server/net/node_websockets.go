@@ -0,0 +1,189 @@
+package net
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
+
+const (
+	// One endpoint at a time, the next one takes over when it's down
+	WSModeFailover = "failover"
+	// Every endpoint at once, confirmations are de-duplicated by hash
+	WSModeAll = "all"
+)
+
+// How long an endpoint can be down before failing over to the next one
+const wsFailoverAfter = time.Second * 10
+
+// How long a confirmed hash is remembered to drop duplicates
+const wsDedupeWindow = time.Minute * 10
+
+type wsEndpoint struct {
+	url          string
+	subscription *AccountSubscription
+	// Subscribed and getting confirmations
+	connected atomic.Bool
+	// The one in use for failover, all of them otherwise
+	active atomic.Bool
+}
+
+type WSEndpointStatus struct {
+	Url       string `json:"url"`
+	Active    bool   `json:"active"`
+	Connected bool   `json:"connected"`
+}
+
+// Confirmations for our accounts from one or more node websockets
+type NodeWebsockets struct {
+	endpoints    []*wsEndpoint
+	mode         string
+	callbackChan *chan *WSCallbackMsg
+	onSubscribed func(disconnectedAt time.Time)
+	// How many endpoints are subscribed, and since when none are
+	connectedCount int
+	disconnectedAt time.Time
+	seen           map[string]time.Time
+	lastPrune      time.Time
+	mutex          sync.Mutex
+}
+
+// onSubscribed is called when confirmations start coming in, with when they stopped, zero the first time
+func NewNodeWebsockets(urls []string, mode string, callbackChan *chan *WSCallbackMsg, onSubscribed func(disconnectedAt time.Time)) *NodeWebsockets {
+	n := &NodeWebsockets{
+		mode:         mode,
+		callbackChan: callbackChan,
+		onSubscribed: onSubscribed,
+		seen:         make(map[string]time.Time),
+		lastPrune:    time.Now(),
+	}
+	for _, url := range urls {
+		n.endpoints = append(n.endpoints, &wsEndpoint{url: url, subscription: NewAccountSubscription()})
+	}
+	return n
+}
+
+// Adds and removes accounts on every endpoint
+func (n *NodeWebsockets) Update(added []string, removed []string) {
+	for _, endpoint := range n.endpoints {
+		endpoint.subscription.Update(added, removed)
+	}
+}
+
+// Replaces the accounts on every endpoint
+func (n *NodeWebsockets) Set(accounts []string) {
+	for _, endpoint := range n.endpoints {
+		endpoint.subscription.Set(accounts)
+	}
+}
+
+// Replaces the accounts with what accounts returns every interval until ctx is done
+// Picks up accounts created or removed by other instances
+func (n *NodeWebsockets) StartRefresh(ctx context.Context, interval time.Duration, accounts func(ctx context.Context) ([]string, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			addresses, err := accounts(ctx)
+			if err != nil {
+				log.Errorf("Error refreshing websocket accounts %v", err)
+				continue
+			}
+			n.Set(addresses)
+		}
+	}
+}
+
+// State of each endpoint, for the health endpoint
+func (n *NodeWebsockets) Status() []WSEndpointStatus {
+	statuses := []WSEndpointStatus{}
+	if n == nil {
+		return statuses
+	}
+	for _, endpoint := range n.endpoints {
+		statuses = append(statuses, WSEndpointStatus{
+			Url:       endpoint.url,
+			Active:    endpoint.active.Load(),
+			Connected: endpoint.connected.Load(),
+		})
+	}
+	return statuses
+}
+
+// Runs until ctx is done
+func (n *NodeWebsockets) Start(ctx context.Context) {
+	if n.mode == WSModeAll {
+		for _, endpoint := range n.endpoints {
+			endpoint.active.Store(true)
+			go endpoint.run(ctx, 0, n)
+		}
+	} else {
+		go n.runFailover(ctx)
+	}
+	<-ctx.Done()
+}
+
+// Stays on an endpoint until it's down, then moves on to the next
+func (n *NodeWebsockets) runFailover(ctx context.Context) {
+	giveUpAfter := wsFailoverAfter
+	if len(n.endpoints) < 2 {
+		giveUpAfter = 0
+	}
+	for i := 0; ctx.Err() == nil; i = (i + 1) % len(n.endpoints) {
+		endpoint := n.endpoints[i]
+		endpoint.active.Store(true)
+		endpoint.run(ctx, giveUpAfter, n)
+		endpoint.active.Store(false)
+		if ctx.Err() == nil {
+			log.Infof("Failing over to websocket %s", n.endpoints[(i+1)%len(n.endpoints)].url)
+		}
+	}
+}
+
+// An endpoint subscribed
+func (n *NodeWebsockets) gained() {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.connectedCount++
+	if n.connectedCount == 1 && n.onSubscribed != nil {
+		go n.onSubscribed(n.disconnectedAt)
+	}
+	n.disconnectedAt = time.Time{}
+}
+
+// An endpoint stopped getting confirmations
+func (n *NodeWebsockets) lost() {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.connectedCount--
+	if n.connectedCount == 0 {
+		n.disconnectedAt = time.Now()
+	}
+}
+
+// Passes a confirmation on, unless another endpoint already did
+func (n *NodeWebsockets) deliver(msg *WSCallbackMsg) {
+	n.mutex.Lock()
+	now := time.Now()
+	if now.Sub(n.lastPrune) > wsDedupeWindow {
+		for hash, at := range n.seen {
+			if now.Sub(at) > wsDedupeWindow {
+				delete(n.seen, hash)
+			}
+		}
+		n.lastPrune = now
+	}
+	if at, ok := n.seen[msg.Hash]; ok && now.Sub(at) <= wsDedupeWindow {
+		n.mutex.Unlock()
+		return
+	}
+	n.seen[msg.Hash] = now
+	n.mutex.Unlock()
+	*n.callbackChan <- msg
+}
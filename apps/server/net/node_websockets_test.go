@@ -0,0 +1,105 @@
+package net
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeWebsocketsDeliver(t *testing.T) {
+	callbackChan := make(chan *WSCallbackMsg, 10)
+	n := NewNodeWebsockets([]string{"ws://a", "ws://b"}, WSModeAll, &callbackChan, nil)
+
+	// The same confirmation from both endpoints is only passed on once
+	n.deliver(&WSCallbackMsg{Hash: "A"})
+	n.deliver(&WSCallbackMsg{Hash: "A"})
+	n.deliver(&WSCallbackMsg{Hash: "B"})
+	assert.Len(t, callbackChan, 2)
+	assert.Equal(t, "A", (<-callbackChan).Hash)
+	assert.Equal(t, "B", (<-callbackChan).Hash)
+
+	// Forgotten after the window
+	n.seen["A"] = time.Now().Add(-wsDedupeWindow - time.Second)
+	n.lastPrune = time.Now().Add(-wsDedupeWindow - time.Second)
+	n.deliver(&WSCallbackMsg{Hash: "A"})
+	assert.Len(t, callbackChan, 1)
+	assert.Len(t, n.seen, 2)
+}
+
+func TestNodeWebsocketsSubscribed(t *testing.T) {
+	callbackChan := make(chan *WSCallbackMsg, 10)
+	calls := make(chan time.Time, 10)
+	n := NewNodeWebsockets([]string{"ws://a", "ws://b"}, WSModeAll, &callbackChan, func(disconnectedAt time.Time) {
+		calls <- disconnectedAt
+	})
+
+	// First connection, nothing was missed but what came before we started
+	n.gained()
+	assert.True(t, (<-calls).IsZero())
+	// Another endpoint while one is connected
+	n.gained()
+	n.lost()
+	assert.Never(t, func() bool { return len(calls) > 0 }, time.Millisecond*50, time.Millisecond*10)
+
+	// Everything was down for a while
+	n.lost()
+	n.gained()
+	assert.False(t, (<-calls).IsZero())
+}
+
+func TestNodeWebsocketsStatus(t *testing.T) {
+	var n *NodeWebsockets
+	assert.Equal(t, []WSEndpointStatus{}, n.Status())
+
+	callbackChan := make(chan *WSCallbackMsg, 10)
+	n = NewNodeWebsockets([]string{"ws://a", "ws://b"}, WSModeFailover, &callbackChan, nil)
+	n.endpoints[0].active.Store(true)
+	n.endpoints[0].connected.Store(true)
+	assert.Equal(t, []WSEndpointStatus{
+		{Url: "ws://a", Active: true, Connected: true},
+		{Url: "ws://b"},
+	}, n.Status())
+
+	// Accounts go to every endpoint
+	n.Set([]string{"nano_a"})
+	n.Update([]string{"nano_b"}, nil)
+	for _, endpoint := range n.endpoints {
+		assert.Equal(t, []string{"nano_a", "nano_b"}, endpoint.subscription.subscribeRequest("id").Options["accounts"])
+	}
+}
+
+func TestNodeWebsocketsRefresh(t *testing.T) {
+	callbackChan := make(chan *WSCallbackMsg, 10)
+	n := NewNodeWebsockets([]string{"ws://a", "ws://b"}, WSModeAll, &callbackChan, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		n.StartRefresh(ctx, time.Millisecond*10, func(ctx context.Context) ([]string, error) {
+			return []string{"nano_a"}, nil
+		})
+		close(stopped)
+	}()
+
+	// Every endpoint gets the accounts
+	assert.Eventually(t, func() bool {
+		for _, endpoint := range n.endpoints {
+			endpoint.subscription.mutex.Lock()
+			_, ok := endpoint.subscription.accounts["nano_a"]
+			endpoint.subscription.mutex.Unlock()
+			if !ok {
+				return false
+			}
+		}
+		return true
+	}, time.Second, time.Millisecond*10)
+
+	// Stops with ctx
+	cancel()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("refresh didn't stop")
+	}
+}
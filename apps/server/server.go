@@ -2,10 +2,15 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math/big"
+	stdnet "net"
 	"net/http"
 	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/appditto/pippin_nano_wallet/apps/server/controller"
@@ -15,9 +20,11 @@ import (
 	"github.com/appditto/pippin_nano_wallet/libs/database"
 	"github.com/appditto/pippin_nano_wallet/libs/log"
 	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/pow/workserver"
 	rpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
 	"github.com/go-chi/chi/v5"
 )
 
@@ -29,8 +36,11 @@ func StartPippinServer() {
 		os.Exit(1)
 	}
 
+	// Cancelled on shutdown, which stops background work and in-flight requests
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	// Setup database conn
-	ctx := context.Background()
 	fmt.Println("🏡 Connecting to database...")
 	dbconn, err := database.GetSqlDbConn(false)
 	if err != nil {
@@ -52,80 +62,162 @@ func StartPippinServer() {
 	}
 
 	// Setup RPC handlers
-	rpcClient := rpc.NewRPCClient(conf.Server.NodeRpcUrl)
+	rpcClient := rpc.NewRPCClient(conf.Server.RpcUrls()...)
+	rpcClient.Quorum = conf.Server.NodeRpcQuorum
+	if len(conf.Server.RpcUrls()) > 1 {
+		go rpcClient.StartHealthChecks(ctx, time.Second*30)
+	}
 
 	// Setup pow client
-	pow := pow.NewPippinPow(conf.Wallet.WorkPeers, utils.GetEnv("BPOW_KEY", ""), utils.GetEnv("BPOW_URL", ""), conf.Wallet.WorkTimeout)
+	pow := pow.NewPippinPow(conf.Wallet.WorkPeers, utils.GetEnv("BPOW_KEY", ""), utils.GetEnv("BPOW_URL", ""), conf.Wallet.WorkTimeout, *conf.Wallet.MaxWorkProcesses)
+	pow.PeerFanout = *conf.Wallet.WorkPeerFanout
+	pow.Strategy = conf.Wallet.WorkStrategy
+	if conf.Wallet.NodeWorkGenerate || slices.Contains(conf.Wallet.WorkProviders, "node") {
+		pow.EnableNodeWorkGenerate(rpcClient)
+	}
+	if len(conf.Wallet.WorkProviders) > 0 {
+		if err := pow.UseProviders(conf.Wallet.WorkProviders); err != nil {
+			log.Fatalf("Invalid work_providers: %v", err)
+			os.Exit(1)
+		}
+	}
+
+	// Raise work difficulty with the network's active difficulty
+	if !conf.Wallet.Banano {
+		go pow.StartActiveDifficultyPoller(ctx, rpcClient, time.Second*30, conf.Wallet.MaxDifficultyMultiplier)
+	}
 
 	// Setup nano wallet instance with DB, options, etc.
 	nanoWallet := wallet.NanoWallet{
 		DB:         entClient,
-		Ctx:        ctx,
 		Banano:     conf.Wallet.Banano,
 		RpcClient:  rpcClient,
 		WorkClient: pow,
 		Config:     conf,
 	}
 
+	// Confirmations and wallet events are handled by the event bus consumers
+	bus := wallet.NewEventBus(ctx)
+	nanoWallet.UseEventBus(bus)
+
+	// Wallet events for websocket subscribers on every instance
+	// Browsers on other hosts have to be listed in WEBSOCKET_ORIGINS
+	var origins []string
+	if env := utils.GetEnv("WEBSOCKET_ORIGINS", ""); env != "" {
+		origins = strings.Split(env, ",")
+	}
+	websocketKey := utils.GetEnv("WEBSOCKET_KEY", "")
+	eventHub := net.NewEventHub(websocketKey, origins)
+	if websocketKey != "" {
+		wallet.Subscribe(bus, "websocket", 1, eventHub.Publish)
+		go eventHub.Start(ctx)
+	} else {
+		log.Infof("WEBSOCKET_KEY isn't set, the event websocket is disabled")
+	}
+
+	// Work on the queue shared with other instances
+	if conf.Wallet.WorkQueueWorkers > 0 {
+		nanoWallet.StartWorkQueueWorkers(ctx)
+	}
+
 	// Setup nano WS client if configured
-	callbackChan := make(chan *net.WSCallbackMsg, 100)
-	if conf.Server.NodeWsUrl != "" {
-		go net.StartNanoWSClient(conf.Server.NodeWsUrl, &callbackChan)
+	callbackChan := make(chan *net.WSCallbackMsg)
+	var websockets *net.NodeWebsockets
+	if wsUrls := conf.Server.WsUrls(); len(wsUrls) > 0 {
+		// Receive what was confirmed before we started or while we were disconnected
+		websockets = net.NewNodeWebsockets(wsUrls, conf.Server.NodeWsMode, &callbackChan, func(disconnectedAt time.Time) {
+			if !disconnectedAt.IsZero() {
+				log.Infof("Websocket reconnected after %s, checking for missed receivables", time.Since(disconnectedAt).Round(time.Second))
+			}
+			received, err := nanoWallet.BackfillReceivables(ctx)
+			if errors.Is(err, database.ErrLockNotObtained) {
+				return
+			} else if err != nil {
+				log.Errorf("Error checking for missed receivables: %v", err)
+				return
+			}
+			if received > 0 {
+				log.Infof("Received %d missed blocks", received)
+			}
+		})
+		// Only confirmations for our accounts, kept up to date as they're created or removed
+		nanoWallet.AccountsHandler = websockets.Update
+		addresses, err := nanoWallet.AllAccountAddresses(ctx)
+		if err != nil {
+			log.Fatalf("Failed to load accounts: %v", err)
+			os.Exit(1)
+		}
+		websockets.Set(addresses)
+		// Picks up accounts created by other instances
+		go websockets.StartRefresh(ctx, time.Minute, nanoWallet.AllAccountAddresses)
+		go websockets.Start(ctx)
 	}
 
-	// Read channel to automatically receive blocks
+	// Confirmations go to the event bus consumers, a full queue holds up the websocket until they catch up
 	go func() {
 		for msg := range callbackChan {
-			func() {
-				// Lock each callback so we don't handle them on multiple instances
-				lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("blocklock:%s", msg.Hash), time.Second*30, nil)
-				if err != nil {
-					return
-				}
-				defer lock.Release(ctx)
-				// Ignore non-sends
-				if msg.Block.Subtype != "send" || msg.IsSend != "true" {
-					return
-				}
-				amount, ok := big.NewInt(0).SetString(msg.Amount, 10)
-				if !ok {
-					return
-				}
-				// Compare to receive minimum
-				receiveMinimum, ok := big.NewInt(0).SetString(conf.Wallet.ReceiveMinimum, 10)
-				if !ok {
-					return
-				}
-				if amount.Cmp(receiveMinimum) < 0 {
-					return
-				}
-
-				// See if destination is in our wallet
-				dbAccount, err := nanoWallet.GetAccountByAddress(msg.Block.LinkAsAccount)
-				if err != nil {
-					return
-				}
-				wallet, err := dbAccount.QueryWallet().Only(ctx)
-				if err != nil {
-					return
-				}
-
-				// Actually receive the block
-				nanoWallet.CreateAndPublishReceiveBlock(wallet, dbAccount.Address, msg.Hash, nil, nil)
-			}()
-
+			bus.Publish(models.BlockConfirmation{
+				Account:        msg.Account,
+				Hash:           msg.Hash,
+				Subtype:        msg.Block.Subtype,
+				Previous:       msg.Block.Previous,
+				Balance:        msg.Block.Balance,
+				Representative: msg.Block.Representative,
+				LinkAsAccount:  msg.Block.LinkAsAccount,
+				Amount:         msg.Amount,
+				IsSend:         msg.IsSend == "true",
+			})
 		}
 	}()
 
+	// Republish blocks that never confirmed
+	go nanoWallet.StartRebroadcastTracker(ctx, time.Second*10)
+
+	// Receive batches for wallets and accounts with a deferred receive policy
+	go nanoWallet.StartDeferredReceives(ctx, time.Minute)
+
+	// Post wallet events to their webhooks
+	go nanoWallet.StartWebhookDelivery(ctx, time.Second*5)
+
+	// Let nodes and wallets use pippin as a work peer
+	if conf.Server.WorkServerPort > 0 {
+		workServer := workserver.NewWorkServer(pow, conf.Wallet.Banano, utils.GetEnv("WORK_SERVER_KEY", ""), conf.Server.WorkServerWorkers)
+		go workServer.Start(ctx)
+		go func() {
+			if err := http.ListenAndServe(fmt.Sprintf("%s:%d", conf.Server.Host, conf.Server.WorkServerPort), workServer); err != nil {
+				log.Errorf("Work server stopped: %v", err)
+			}
+		}()
+	}
+
 	// Create app
 	app := chi.NewRouter()
 
 	// Setup controller
-	hc := controller.HttpController{Wallet: &nanoWallet, RpcClient: rpcClient, PowClient: pow}
+	hc := controller.HttpController{Wallet: &nanoWallet, RpcClient: rpcClient, PowClient: pow, Websockets: websockets}
 
 	// HTTP Routes
 	app.Use(middleware.Logger)
 	app.Post("/", hc.Gateway)
-
-	http.ListenAndServe(fmt.Sprintf("%s:%d", conf.Server.Host, conf.Server.Port), app)
+	app.Get("/health", hc.Health)
+	app.Handle("/ws", eventHub)
+
+	// Requests are cancelled along with ctx, so shutting down doesn't wait on long sends or work
+	srv := &http.Server{
+		Addr:    fmt.Sprintf("%s:%d", conf.Server.Host, conf.Server.Port),
+		Handler: app,
+		BaseContext: func(stdnet.Listener) context.Context {
+			return ctx
+		},
+	}
+	go func() {
+		<-ctx.Done()
+		log.Info("Shutting down...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		srv.Shutdown(shutdownCtx)
+	}()
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Errorf("Server stopped: %v", err)
+	}
 }
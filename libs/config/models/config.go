@@ -16,11 +16,42 @@ type ServerConfig struct {
 	Host       string `yaml:"host" default:"127.0.0.1"`
 	Port       int    `yaml:"port" default:"11338"`
 	NodeRpcUrl string `yaml:"node_rpc_url"`
-	NodeWsUrl  string `yaml:"node_ws_url"`
+	// More nodes, tried after node_rpc_url
+	NodeRpcUrls   []string `yaml:"node_rpc_urls"`
+	NodeRpcQuorum int      `yaml:"node_rpc_quorum" default:"0"`
+	NodeWsUrl     string   `yaml:"node_ws_url"`
+	// More websockets, tried after node_ws_url
+	NodeWsUrls []string `yaml:"node_ws_urls"`
+	NodeWsMode string   `yaml:"node_ws_mode" default:"failover"`
+	// Serves the nano-work-server protocol on this port when set
+	WorkServerPort    int `yaml:"work_server_port"`
+	WorkServerWorkers int `yaml:"work_server_workers" default:"2"`
+}
+
+// node_rpc_url followed by node_rpc_urls, without duplicates
+func (c *ServerConfig) RpcUrls() []string {
+	urls := []string{}
+	for _, rpcUrl := range append([]string{c.NodeRpcUrl}, c.NodeRpcUrls...) {
+		if rpcUrl != "" && !slices.Contains(urls, rpcUrl) {
+			urls = append(urls, rpcUrl)
+		}
+	}
+	return urls
+}
+
+// node_ws_url followed by node_ws_urls, without duplicates
+func (c *ServerConfig) WsUrls() []string {
+	urls := []string{}
+	for _, wsUrl := range append([]string{c.NodeWsUrl}, c.NodeWsUrls...) {
+		if wsUrl != "" && !slices.Contains(urls, wsUrl) {
+			urls = append(urls, wsUrl)
+		}
+	}
+	return urls
 }
 
 // ! The old server also had:
-// max_work_processes, max_sign_threads
+// max_sign_threads
 type WalletConfig struct {
 	Banano                             bool     `yaml:"banano" default:"false"`
 	PreconfiguredRepresentativesBanano []string `yaml:"preconfigured_representatives_banano" default:"[\"ban_1ka1ium4pfue3uxtntqsrib8mumxgazsjf58gidh1xeo5te3whsq8z476goo\",\"ban_1cake36ua5aqcq1c5i3dg7k8xtosw7r9r7qbbf5j15sk75csp9okesz87nfn\",\"ban_1fomoz167m7o38gw4rzt7hz67oq6itejpt4yocrfywujbpatd711cjew8gjj\"]"`
@@ -29,7 +60,15 @@ type WalletConfig struct {
 	NodeWorkGenerate                   bool     `yaml:"node_work_generate" default:"false"`
 	ReceiveMinimum                     string   `yaml:"receive_minimum"`
 	AutoReceiveOnSend                  *bool    `yaml:"auto_receive_on_send" default:"true"`
+	AutoReceiveWorkers                 int      `yaml:"auto_receive_workers" default:"4"`
 	WorkTimeout                        int      `yaml:"work_timeout" default:"30"`
+	RebroadcastAlertAttempts           int      `yaml:"rebroadcast_alert_attempts" default:"5"`
+	MaxDifficultyMultiplier            float64  `yaml:"max_difficulty_multiplier" default:"4"`
+	MaxWorkProcesses                   *int     `yaml:"max_work_processes" default:"1"`
+	WorkPeerFanout                     *int     `yaml:"work_peer_fanout" default:"2"`
+	WorkQueueWorkers                   int      `yaml:"work_queue_workers" default:"0"`
+	WorkStrategy                       string   `yaml:"work_strategy" default:"race"`
+	WorkProviders                      []string `yaml:"work_providers"`
 }
 
 type PippinConfig struct {
@@ -57,14 +96,28 @@ func (c *PippinConfig) SetDefaults() {
 }
 
 var ErrInvalidRpcUrl = errors.New("invalid node_rpc_url")
+var ErrInvalidRpcQuorum = errors.New("invalid node_rpc_quorum, can't be negative or more than the number of nodes")
 var ErrInvalidWSUrl = errors.New("invalid node_ws_url")
+var ErrInvalidWSMode = errors.New("invalid node_ws_mode, must be failover or all")
 var ErrInvalidPort = errors.New("invalid server port, out of range")
+var ErrInvalidWorkServerPort = errors.New("invalid work_server_port, out of range or same as port")
+var ErrInvalidWorkPeerFanout = errors.New("invalid work_peer_fanout, can't be negative")
+var ErrInvalidAutoReceiveWorkers = errors.New("invalid auto_receive_workers, must be at least 1")
+var ErrInvalidWorkQueueWorkers = errors.New("invalid work_queue_workers, can't be negative")
+var ErrInvalidWorkStrategy = errors.New("invalid work_strategy, must be race, fallback or primary")
+var ErrInvalidWorkProvider = errors.New("invalid work_providers, must be cache, peers, boompow, node or local")
+var ErrInvalidMaxWorkProcesses = errors.New("invalid max_work_processes, can't be negative")
 var ErrInvalidReceiveMinimum = errors.New("invalid receive_minimum, must be between 1 and 133248290000000000000000000000000000000 (max supply)")
 
 func (c *PippinConfig) Validate() error {
-	u, err := url.Parse(c.Server.NodeRpcUrl)
-	if err != nil || !slices.Contains([]string{"http", "https"}, u.Scheme) || u.Host == "" {
-		return ErrInvalidRpcUrl
+	for _, rpcUrl := range c.Server.RpcUrls() {
+		u, err := url.Parse(rpcUrl)
+		if err != nil || !slices.Contains([]string{"http", "https"}, u.Scheme) || u.Host == "" {
+			return ErrInvalidRpcUrl
+		}
+	}
+	if c.Server.NodeRpcQuorum < 0 || c.Server.NodeRpcQuorum > len(c.Server.RpcUrls()) {
+		return ErrInvalidRpcQuorum
 	}
 
 	// Parse server port as int
@@ -72,13 +125,20 @@ func (c *PippinConfig) Validate() error {
 		return ErrInvalidPort
 	}
 
-	// Validate websocket URL if set
-	if c.Server.NodeWsUrl != "" {
-		u, err := url.Parse(c.Server.NodeWsUrl)
+	if c.Server.WorkServerPort != 0 && (c.Server.WorkServerPort < 1 || c.Server.WorkServerPort > 65535 || c.Server.WorkServerPort == c.Server.Port) {
+		return ErrInvalidWorkServerPort
+	}
+
+	// Validate websocket URLs if set
+	for _, wsUrl := range c.Server.WsUrls() {
+		u, err := url.Parse(wsUrl)
 		if err != nil || !slices.Contains([]string{"ws", "wss"}, u.Scheme) || u.Host == "" {
 			return ErrInvalidWSUrl
 		}
 	}
+	if !slices.Contains([]string{"failover", "all"}, c.Server.NodeWsMode) {
+		return ErrInvalidWSMode
+	}
 
 	// Parse receive minimum as big int
 	minimum, ok := big.NewInt(0).SetString(c.Wallet.ReceiveMinimum, 10)
@@ -91,6 +151,27 @@ func (c *PippinConfig) Validate() error {
 		}
 	}
 
+	if c.Wallet.AutoReceiveWorkers < 1 {
+		return ErrInvalidAutoReceiveWorkers
+	}
+	if c.Wallet.MaxWorkProcesses != nil && *c.Wallet.MaxWorkProcesses < 0 {
+		return ErrInvalidMaxWorkProcesses
+	}
+	if c.Wallet.WorkPeerFanout != nil && *c.Wallet.WorkPeerFanout < 0 {
+		return ErrInvalidWorkPeerFanout
+	}
+	if c.Wallet.WorkQueueWorkers < 0 {
+		return ErrInvalidWorkQueueWorkers
+	}
+	if !slices.Contains([]string{"race", "fallback", "primary"}, c.Wallet.WorkStrategy) {
+		return ErrInvalidWorkStrategy
+	}
+	for _, provider := range c.Wallet.WorkProviders {
+		if !slices.Contains([]string{"cache", "peers", "boompow", "node", "local"}, provider) {
+			return ErrInvalidWorkProvider
+		}
+	}
+
 	// Validate all work peers
 	for _, peer := range c.Wallet.WorkPeers {
 		u, err := url.Parse(peer)
@@ -114,7 +195,7 @@ func (c *PippinConfig) Validate() error {
 		}
 	}
 
-	return err
+	return nil
 }
 
 var ErrNoRepsConfigured = errors.New("no representatives configured")
@@ -27,7 +27,13 @@ func TestParser(t *testing.T) {
 	assert.Equal(t, 11338, config.Server.Port)
 	assert.Equal(t, "127.0.0.1", config.Server.Host)
 	assert.Equal(t, "http://[::1]:7076", config.Server.NodeRpcUrl)
+	assert.Len(t, config.Server.NodeRpcUrls, 0)
+	assert.Equal(t, 0, config.Server.NodeRpcQuorum)
+	assert.Equal(t, []string{"http://[::1]:7076"}, config.Server.RpcUrls())
 	assert.Equal(t, "", config.Server.NodeWsUrl)
+	assert.Len(t, config.Server.NodeWsUrls, 0)
+	assert.Equal(t, "failover", config.Server.NodeWsMode)
+	assert.Equal(t, []string{}, config.Server.WsUrls())
 	assert.Equal(t, false, config.Wallet.Banano)
 	assert.Equal(t, true, *config.Wallet.AutoReceiveOnSend)
 	assert.Equal(t, false, config.Wallet.NodeWorkGenerate)
@@ -44,6 +50,16 @@ func TestParser(t *testing.T) {
 	}, config.Wallet.PreconfiguredRepresentativesNano)
 	assert.Equal(t, []string{}, config.Wallet.WorkPeers)
 	assert.Equal(t, "1000000000000000000000000", config.Wallet.ReceiveMinimum)
+	assert.Equal(t, 5, config.Wallet.RebroadcastAlertAttempts)
+	assert.Equal(t, float64(4), config.Wallet.MaxDifficultyMultiplier)
+	assert.Equal(t, 1, *config.Wallet.MaxWorkProcesses)
+	assert.Equal(t, 2, *config.Wallet.WorkPeerFanout)
+	assert.Equal(t, 4, config.Wallet.AutoReceiveWorkers)
+	assert.Equal(t, 0, config.Wallet.WorkQueueWorkers)
+	assert.Equal(t, "race", config.Wallet.WorkStrategy)
+	assert.Len(t, config.Wallet.WorkProviders, 0)
+	assert.Equal(t, 0, config.Server.WorkServerPort)
+	assert.Equal(t, 2, config.Server.WorkServerWorkers)
 
 	// Copy testdata config 1
 	assert.Nil(t, os.Remove(path.Join(configRoot, "config.yaml")))
@@ -123,7 +139,13 @@ func TestParser(t *testing.T) {
 	assert.Equal(t, 500, config.Server.Port)
 	assert.Equal(t, "1.2.3.4", config.Server.Host)
 	assert.Equal(t, "https://coolnanonode.com/rpc", config.Server.NodeRpcUrl)
+	assert.Equal(t, []string{"http://[::1]:7076", "https://othernanonode.com/rpc"}, config.Server.NodeRpcUrls)
+	assert.Equal(t, 2, config.Server.NodeRpcQuorum)
+	assert.Equal(t, []string{"https://coolnanonode.com/rpc", "http://[::1]:7076", "https://othernanonode.com/rpc"}, config.Server.RpcUrls())
 	assert.Equal(t, "ws://[::1]:7078", config.Server.NodeWsUrl)
+	assert.Equal(t, []string{"ws://[::1]:7079", "wss://coolnanonode.com/ws"}, config.Server.NodeWsUrls)
+	assert.Equal(t, "all", config.Server.NodeWsMode)
+	assert.Equal(t, []string{"ws://[::1]:7078", "ws://[::1]:7079", "wss://coolnanonode.com/ws"}, config.Server.WsUrls())
 	assert.Equal(t, true, config.Wallet.Banano)
 	assert.Equal(t, false, *config.Wallet.AutoReceiveOnSend)
 	assert.Equal(t, true, config.Wallet.NodeWorkGenerate)
@@ -138,6 +160,14 @@ func TestParser(t *testing.T) {
 		"http://myotherworkpeer.com",
 	}, config.Wallet.WorkPeers)
 	assert.Equal(t, "1", config.Wallet.ReceiveMinimum)
+	assert.Equal(t, 0, *config.Wallet.MaxWorkProcesses)
+	assert.Equal(t, 0, *config.Wallet.WorkPeerFanout)
+	assert.Equal(t, 8, config.Wallet.AutoReceiveWorkers)
+	assert.Equal(t, 3, config.Wallet.WorkQueueWorkers)
+	assert.Equal(t, "fallback", config.Wallet.WorkStrategy)
+	assert.Equal(t, []string{"node", "peers", "local"}, config.Wallet.WorkProviders)
+	assert.Equal(t, 7000, config.Server.WorkServerPort)
+	assert.Equal(t, 4, config.Server.WorkServerWorkers)
 }
 
 func TestConfigValidation(t *testing.T) {
@@ -166,6 +196,18 @@ func TestConfigValidation(t *testing.T) {
 	assert.NotNil(t, config.Validate())
 	assert.ErrorIs(t, config.Validate(), models.ErrInvalidRpcUrl)
 	config.Server.NodeRpcUrl = "http://[::1]:7072"
+	config.Server.NodeRpcUrls = []string{"http://[::1]:7076", "ftp://[::1]:7077"}
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidRpcUrl)
+	config.Server.NodeRpcUrls = []string{"http://[::1]:7076", "http://[::1]:7072"}
+	assert.Equal(t, []string{"http://[::1]:7072", "http://[::1]:7076"}, config.Server.RpcUrls())
+	config.Server.NodeRpcQuorum = 3
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidRpcQuorum)
+	config.Server.NodeRpcQuorum = -1
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidRpcQuorum)
+	config.Server.NodeRpcQuorum = 2
+	assert.Nil(t, config.Validate())
+	config.Server.NodeRpcQuorum = 0
+	config.Server.NodeRpcUrls = nil
 
 	// Set invalid port
 	config.Server.Port = 0
@@ -173,6 +215,15 @@ func TestConfigValidation(t *testing.T) {
 	assert.ErrorIs(t, config.Validate(), models.ErrInvalidPort)
 	config.Server.Port = 11338
 
+	// Check work server port
+	config.Server.WorkServerPort = 70000
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidWorkServerPort)
+	config.Server.WorkServerPort = 11338
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidWorkServerPort)
+	config.Server.WorkServerPort = 7000
+	assert.Nil(t, config.Validate())
+	config.Server.WorkServerPort = 0
+
 	// Check websocket port
 	config.Server.NodeWsUrl = "ws://[::1]:7078"
 	assert.Nil(t, config.Validate())
@@ -180,6 +231,17 @@ func TestConfigValidation(t *testing.T) {
 	assert.NotNil(t, config.Validate())
 	assert.ErrorIs(t, config.Validate(), models.ErrInvalidWSUrl)
 	config.Server.NodeWsUrl = "ws://[::1]:7078"
+	config.Server.NodeWsUrls = []string{"ws://[::1]:7079", "http://[::1]:7080"}
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidWSUrl)
+	config.Server.NodeWsUrls = []string{"ws://[::1]:7079", "ws://[::1]:7078"}
+	assert.Equal(t, []string{"ws://[::1]:7078", "ws://[::1]:7079"}, config.Server.WsUrls())
+	assert.Nil(t, config.Validate())
+	config.Server.NodeWsMode = "random"
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidWSMode)
+	config.Server.NodeWsMode = "all"
+	assert.Nil(t, config.Validate())
+	config.Server.NodeWsMode = "failover"
+	config.Server.NodeWsUrls = nil
 
 	// Check receive minimum
 	config.Wallet.ReceiveMinimum = "0"
@@ -199,6 +261,41 @@ func TestConfigValidation(t *testing.T) {
 	assert.ErrorContains(t, config.Validate(), "invalid work peer")
 	config.Wallet.WorkPeers = []string{"http://localhost:5555", "http://myotherworkpeer.com"}
 
+	// Check max work processes
+	maxWorkProcesses := -1
+	config.Wallet.MaxWorkProcesses = &maxWorkProcesses
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidMaxWorkProcesses)
+	maxWorkProcesses = 0
+	assert.Nil(t, config.Validate())
+
+	// Check work peer fanout
+	workPeerFanout := -1
+	config.Wallet.WorkPeerFanout = &workPeerFanout
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidWorkPeerFanout)
+	workPeerFanout = 2
+	assert.Nil(t, config.Validate())
+
+	// Check auto receive workers
+	config.Wallet.AutoReceiveWorkers = 0
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidAutoReceiveWorkers)
+	config.Wallet.AutoReceiveWorkers = 4
+	assert.Nil(t, config.Validate())
+
+	// Check work queue workers
+	config.Wallet.WorkQueueWorkers = -1
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidWorkQueueWorkers)
+	config.Wallet.WorkQueueWorkers = 0
+	assert.Nil(t, config.Validate())
+
+	// Check work strategy and providers
+	config.Wallet.WorkStrategy = "fastest"
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidWorkStrategy)
+	config.Wallet.WorkStrategy = "primary"
+	config.Wallet.WorkProviders = []string{"node", "dpow"}
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidWorkProvider)
+	config.Wallet.WorkProviders = []string{"node", "local"}
+	assert.Nil(t, config.Validate())
+
 	// Check representatives
 	config.Wallet.PreconfiguredRepresentativesBanano = []string{"ban_1fomoz167m7o38gw4rzt7hz67oq6itejpt4yocrfywujbpatd711cjew8gjj"}
 	config.Wallet.PreconfiguredRepresentativesNano = []string{"nano_1fomoz167m7o38gw4rzt7hz67oq6itejpt4yocrfywujbpatd711cjew8gjj"}
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
 	"github.com/google/uuid"
 )
@@ -28,6 +29,8 @@ type Account struct {
 	PrivateKey *string `json:"private_key,omitempty"`
 	// Work holds the value of the "work" field.
 	Work bool `json:"work,omitempty"`
+	// NeedsResync holds the value of the "needs_resync" field.
+	NeedsResync bool `json:"needs_resync,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -41,9 +44,11 @@ type AccountEdges struct {
 	Wallet *Wallet `json:"wallet,omitempty"`
 	// Blocks holds the value of the blocks edge.
 	Blocks []*Block `json:"blocks,omitempty"`
+	// ReceivePolicy holds the value of the receive_policy edge.
+	ReceivePolicy *ReceivePolicy `json:"receive_policy,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // WalletOrErr returns the Wallet value or an error if the edge
@@ -68,12 +73,25 @@ func (e AccountEdges) BlocksOrErr() ([]*Block, error) {
 	return nil, &NotLoadedError{edge: "blocks"}
 }
 
+// ReceivePolicyOrErr returns the ReceivePolicy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountEdges) ReceivePolicyOrErr() (*ReceivePolicy, error) {
+	if e.loadedTypes[2] {
+		if e.ReceivePolicy == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: receivepolicy.Label}
+		}
+		return e.ReceivePolicy, nil
+	}
+	return nil, &NotLoadedError{edge: "receive_policy"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Account) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case account.FieldWork:
+		case account.FieldWork, account.FieldNeedsResync:
 			values[i] = new(sql.NullBool)
 		case account.FieldAccountIndex:
 			values[i] = new(sql.NullInt64)
@@ -136,6 +154,12 @@ func (a *Account) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				a.Work = value.Bool
 			}
+		case account.FieldNeedsResync:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field needs_resync", values[i])
+			} else if value.Valid {
+				a.NeedsResync = value.Bool
+			}
 		case account.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -157,6 +181,11 @@ func (a *Account) QueryBlocks() *BlockQuery {
 	return (&AccountClient{config: a.config}).QueryBlocks(a)
 }
 
+// QueryReceivePolicy queries the "receive_policy" edge of the Account entity.
+func (a *Account) QueryReceivePolicy() *ReceivePolicyQuery {
+	return (&AccountClient{config: a.config}).QueryReceivePolicy(a)
+}
+
 // Update returns a builder for updating this Account.
 // Note that you need to call Account.Unwrap() before calling this method if this Account
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -199,6 +228,9 @@ func (a *Account) String() string {
 	builder.WriteString("work=")
 	builder.WriteString(fmt.Sprintf("%v", a.Work))
 	builder.WriteString(", ")
+	builder.WriteString("needs_resync=")
+	builder.WriteString(fmt.Sprintf("%v", a.NeedsResync))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
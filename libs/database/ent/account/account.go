@@ -23,12 +23,16 @@ const (
 	FieldPrivateKey = "private_key"
 	// FieldWork holds the string denoting the work field in the database.
 	FieldWork = "work"
+	// FieldNeedsResync holds the string denoting the needs_resync field in the database.
+	FieldNeedsResync = "needs_resync"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeWallet holds the string denoting the wallet edge name in mutations.
 	EdgeWallet = "wallet"
 	// EdgeBlocks holds the string denoting the blocks edge name in mutations.
 	EdgeBlocks = "blocks"
+	// EdgeReceivePolicy holds the string denoting the receive_policy edge name in mutations.
+	EdgeReceivePolicy = "receive_policy"
 	// Table holds the table name of the account in the database.
 	Table = "accounts"
 	// WalletTable is the table that holds the wallet relation/edge.
@@ -45,6 +49,13 @@ const (
 	BlocksInverseTable = "blocks"
 	// BlocksColumn is the table column denoting the blocks relation/edge.
 	BlocksColumn = "account_id"
+	// ReceivePolicyTable is the table that holds the receive_policy relation/edge.
+	ReceivePolicyTable = "receive_policies"
+	// ReceivePolicyInverseTable is the table name for the ReceivePolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "receivepolicy" package.
+	ReceivePolicyInverseTable = "receive_policies"
+	// ReceivePolicyColumn is the table column denoting the receive_policy relation/edge.
+	ReceivePolicyColumn = "account_id"
 )
 
 // Columns holds all SQL columns for account fields.
@@ -55,6 +66,7 @@ var Columns = []string{
 	FieldAccountIndex,
 	FieldPrivateKey,
 	FieldWork,
+	FieldNeedsResync,
 	FieldCreatedAt,
 }
 
@@ -75,6 +87,8 @@ var (
 	PrivateKeyValidator func(string) error
 	// DefaultWork holds the default value on creation for the "work" field.
 	DefaultWork bool
+	// DefaultNeedsResync holds the default value on creation for the "needs_resync" field.
+	DefaultNeedsResync bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
@@ -117,6 +117,13 @@ func Work(v bool) predicate.Account {
 	})
 }
 
+// NeedsResync applies equality check predicate on the "needs_resync" field. It's identical to NeedsResyncEQ.
+func NeedsResync(v bool) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNeedsResync), v))
+	})
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
@@ -464,6 +471,20 @@ func WorkNEQ(v bool) predicate.Account {
 	})
 }
 
+// NeedsResyncEQ applies the EQ predicate on the "needs_resync" field.
+func NeedsResyncEQ(v bool) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNeedsResync), v))
+	})
+}
+
+// NeedsResyncNEQ applies the NEQ predicate on the "needs_resync" field.
+func NeedsResyncNEQ(v bool) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNeedsResync), v))
+	})
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
@@ -584,6 +605,34 @@ func HasBlocksWith(preds ...predicate.Block) predicate.Account {
 	})
 }
 
+// HasReceivePolicy applies the HasEdge predicate on the "receive_policy" edge.
+func HasReceivePolicy() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ReceivePolicyTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ReceivePolicyTable, ReceivePolicyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceivePolicyWith applies the HasEdge predicate on the "receive_policy" edge with a given conditions (other predicates).
+func HasReceivePolicyWith(preds ...predicate.ReceivePolicy) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ReceivePolicyInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ReceivePolicyTable, ReceivePolicyColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Account) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
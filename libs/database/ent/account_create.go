@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
 	"github.com/google/uuid"
 )
@@ -77,6 +78,20 @@ func (ac *AccountCreate) SetNillableWork(b *bool) *AccountCreate {
 	return ac
 }
 
+// SetNeedsResync sets the "needs_resync" field.
+func (ac *AccountCreate) SetNeedsResync(b bool) *AccountCreate {
+	ac.mutation.SetNeedsResync(b)
+	return ac
+}
+
+// SetNillableNeedsResync sets the "needs_resync" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableNeedsResync(b *bool) *AccountCreate {
+	if b != nil {
+		ac.SetNeedsResync(*b)
+	}
+	return ac
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ac *AccountCreate) SetCreatedAt(t time.Time) *AccountCreate {
 	ac.mutation.SetCreatedAt(t)
@@ -125,6 +140,25 @@ func (ac *AccountCreate) AddBlocks(b ...*Block) *AccountCreate {
 	return ac.AddBlockIDs(ids...)
 }
 
+// SetReceivePolicyID sets the "receive_policy" edge to the ReceivePolicy entity by ID.
+func (ac *AccountCreate) SetReceivePolicyID(id uuid.UUID) *AccountCreate {
+	ac.mutation.SetReceivePolicyID(id)
+	return ac
+}
+
+// SetNillableReceivePolicyID sets the "receive_policy" edge to the ReceivePolicy entity by ID if the given value is not nil.
+func (ac *AccountCreate) SetNillableReceivePolicyID(id *uuid.UUID) *AccountCreate {
+	if id != nil {
+		ac = ac.SetReceivePolicyID(*id)
+	}
+	return ac
+}
+
+// SetReceivePolicy sets the "receive_policy" edge to the ReceivePolicy entity.
+func (ac *AccountCreate) SetReceivePolicy(r *ReceivePolicy) *AccountCreate {
+	return ac.SetReceivePolicyID(r.ID)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (ac *AccountCreate) Mutation() *AccountMutation {
 	return ac.mutation
@@ -206,6 +240,10 @@ func (ac *AccountCreate) defaults() {
 		v := account.DefaultWork
 		ac.mutation.SetWork(v)
 	}
+	if _, ok := ac.mutation.NeedsResync(); !ok {
+		v := account.DefaultNeedsResync
+		ac.mutation.SetNeedsResync(v)
+	}
 	if _, ok := ac.mutation.CreatedAt(); !ok {
 		v := account.DefaultCreatedAt()
 		ac.mutation.SetCreatedAt(v)
@@ -237,6 +275,9 @@ func (ac *AccountCreate) check() error {
 	if _, ok := ac.mutation.Work(); !ok {
 		return &ValidationError{Name: "work", err: errors.New(`ent: missing required field "Account.work"`)}
 	}
+	if _, ok := ac.mutation.NeedsResync(); !ok {
+		return &ValidationError{Name: "needs_resync", err: errors.New(`ent: missing required field "Account.needs_resync"`)}
+	}
 	if _, ok := ac.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Account.created_at"`)}
 	}
@@ -311,6 +352,14 @@ func (ac *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		})
 		_node.Work = value
 	}
+	if value, ok := ac.mutation.NeedsResync(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: account.FieldNeedsResync,
+		})
+		_node.NeedsResync = value
+	}
 	if value, ok := ac.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -358,6 +407,25 @@ func (ac *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.ReceivePolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.ReceivePolicyTable,
+			Columns: []string{account.ReceivePolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: receivepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
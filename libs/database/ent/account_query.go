@@ -14,6 +14,7 @@ import (
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
 	"github.com/google/uuid"
 )
@@ -21,14 +22,15 @@ import (
 // AccountQuery is the builder for querying Account entities.
 type AccountQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
-	order      []OrderFunc
-	fields     []string
-	predicates []predicate.Account
-	withWallet *WalletQuery
-	withBlocks *BlockQuery
+	limit             *int
+	offset            *int
+	unique            *bool
+	order             []OrderFunc
+	fields            []string
+	predicates        []predicate.Account
+	withWallet        *WalletQuery
+	withBlocks        *BlockQuery
+	withReceivePolicy *ReceivePolicyQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -109,6 +111,28 @@ func (aq *AccountQuery) QueryBlocks() *BlockQuery {
 	return query
 }
 
+// QueryReceivePolicy chains the current query on the "receive_policy" edge.
+func (aq *AccountQuery) QueryReceivePolicy() *ReceivePolicyQuery {
+	query := &ReceivePolicyQuery{config: aq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(receivepolicy.Table, receivepolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, account.ReceivePolicyTable, account.ReceivePolicyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Account entity from the query.
 // Returns a *NotFoundError when no Account was found.
 func (aq *AccountQuery) First(ctx context.Context) (*Account, error) {
@@ -285,13 +309,14 @@ func (aq *AccountQuery) Clone() *AccountQuery {
 		return nil
 	}
 	return &AccountQuery{
-		config:     aq.config,
-		limit:      aq.limit,
-		offset:     aq.offset,
-		order:      append([]OrderFunc{}, aq.order...),
-		predicates: append([]predicate.Account{}, aq.predicates...),
-		withWallet: aq.withWallet.Clone(),
-		withBlocks: aq.withBlocks.Clone(),
+		config:            aq.config,
+		limit:             aq.limit,
+		offset:            aq.offset,
+		order:             append([]OrderFunc{}, aq.order...),
+		predicates:        append([]predicate.Account{}, aq.predicates...),
+		withWallet:        aq.withWallet.Clone(),
+		withBlocks:        aq.withBlocks.Clone(),
+		withReceivePolicy: aq.withReceivePolicy.Clone(),
 		// clone intermediate query.
 		sql:    aq.sql.Clone(),
 		path:   aq.path,
@@ -321,6 +346,17 @@ func (aq *AccountQuery) WithBlocks(opts ...func(*BlockQuery)) *AccountQuery {
 	return aq
 }
 
+// WithReceivePolicy tells the query-builder to eager-load the nodes that are connected to
+// the "receive_policy" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithReceivePolicy(opts ...func(*ReceivePolicyQuery)) *AccountQuery {
+	query := &ReceivePolicyQuery{config: aq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withReceivePolicy = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -389,9 +425,10 @@ func (aq *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 	var (
 		nodes       = []*Account{}
 		_spec       = aq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			aq.withWallet != nil,
 			aq.withBlocks != nil,
+			aq.withReceivePolicy != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
@@ -425,6 +462,12 @@ func (aq *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 			return nil, err
 		}
 	}
+	if query := aq.withReceivePolicy; query != nil {
+		if err := aq.loadReceivePolicy(ctx, query, nodes, nil,
+			func(n *Account, e *ReceivePolicy) { n.Edges.ReceivePolicy = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -484,6 +527,33 @@ func (aq *AccountQuery) loadBlocks(ctx context.Context, query *BlockQuery, nodes
 	}
 	return nil
 }
+func (aq *AccountQuery) loadReceivePolicy(ctx context.Context, query *ReceivePolicyQuery, nodes []*Account, init func(*Account), assign func(*Account, *ReceivePolicy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.Where(predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.InValues(account.ReceivePolicyColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "account_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "account_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (aq *AccountQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aq.querySpec()
@@ -13,6 +13,7 @@ import (
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
 	"github.com/google/uuid"
 )
@@ -103,6 +104,20 @@ func (au *AccountUpdate) SetNillableWork(b *bool) *AccountUpdate {
 	return au
 }
 
+// SetNeedsResync sets the "needs_resync" field.
+func (au *AccountUpdate) SetNeedsResync(b bool) *AccountUpdate {
+	au.mutation.SetNeedsResync(b)
+	return au
+}
+
+// SetNillableNeedsResync sets the "needs_resync" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableNeedsResync(b *bool) *AccountUpdate {
+	if b != nil {
+		au.SetNeedsResync(*b)
+	}
+	return au
+}
+
 // SetWallet sets the "wallet" edge to the Wallet entity.
 func (au *AccountUpdate) SetWallet(w *Wallet) *AccountUpdate {
 	return au.SetWalletID(w.ID)
@@ -123,6 +138,25 @@ func (au *AccountUpdate) AddBlocks(b ...*Block) *AccountUpdate {
 	return au.AddBlockIDs(ids...)
 }
 
+// SetReceivePolicyID sets the "receive_policy" edge to the ReceivePolicy entity by ID.
+func (au *AccountUpdate) SetReceivePolicyID(id uuid.UUID) *AccountUpdate {
+	au.mutation.SetReceivePolicyID(id)
+	return au
+}
+
+// SetNillableReceivePolicyID sets the "receive_policy" edge to the ReceivePolicy entity by ID if the given value is not nil.
+func (au *AccountUpdate) SetNillableReceivePolicyID(id *uuid.UUID) *AccountUpdate {
+	if id != nil {
+		au = au.SetReceivePolicyID(*id)
+	}
+	return au
+}
+
+// SetReceivePolicy sets the "receive_policy" edge to the ReceivePolicy entity.
+func (au *AccountUpdate) SetReceivePolicy(r *ReceivePolicy) *AccountUpdate {
+	return au.SetReceivePolicyID(r.ID)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (au *AccountUpdate) Mutation() *AccountMutation {
 	return au.mutation
@@ -155,6 +189,12 @@ func (au *AccountUpdate) RemoveBlocks(b ...*Block) *AccountUpdate {
 	return au.RemoveBlockIDs(ids...)
 }
 
+// ClearReceivePolicy clears the "receive_policy" edge to the ReceivePolicy entity.
+func (au *AccountUpdate) ClearReceivePolicy() *AccountUpdate {
+	au.mutation.ClearReceivePolicy()
+	return au
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *AccountUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -298,6 +338,13 @@ func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: account.FieldWork,
 		})
 	}
+	if value, ok := au.mutation.NeedsResync(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: account.FieldNeedsResync,
+		})
+	}
 	if au.mutation.WalletCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -387,6 +434,41 @@ func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.ReceivePolicyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.ReceivePolicyTable,
+			Columns: []string{account.ReceivePolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: receivepolicy.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.ReceivePolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.ReceivePolicyTable,
+			Columns: []string{account.ReceivePolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: receivepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{account.Label}
@@ -479,6 +561,20 @@ func (auo *AccountUpdateOne) SetNillableWork(b *bool) *AccountUpdateOne {
 	return auo
 }
 
+// SetNeedsResync sets the "needs_resync" field.
+func (auo *AccountUpdateOne) SetNeedsResync(b bool) *AccountUpdateOne {
+	auo.mutation.SetNeedsResync(b)
+	return auo
+}
+
+// SetNillableNeedsResync sets the "needs_resync" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableNeedsResync(b *bool) *AccountUpdateOne {
+	if b != nil {
+		auo.SetNeedsResync(*b)
+	}
+	return auo
+}
+
 // SetWallet sets the "wallet" edge to the Wallet entity.
 func (auo *AccountUpdateOne) SetWallet(w *Wallet) *AccountUpdateOne {
 	return auo.SetWalletID(w.ID)
@@ -499,6 +595,25 @@ func (auo *AccountUpdateOne) AddBlocks(b ...*Block) *AccountUpdateOne {
 	return auo.AddBlockIDs(ids...)
 }
 
+// SetReceivePolicyID sets the "receive_policy" edge to the ReceivePolicy entity by ID.
+func (auo *AccountUpdateOne) SetReceivePolicyID(id uuid.UUID) *AccountUpdateOne {
+	auo.mutation.SetReceivePolicyID(id)
+	return auo
+}
+
+// SetNillableReceivePolicyID sets the "receive_policy" edge to the ReceivePolicy entity by ID if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableReceivePolicyID(id *uuid.UUID) *AccountUpdateOne {
+	if id != nil {
+		auo = auo.SetReceivePolicyID(*id)
+	}
+	return auo
+}
+
+// SetReceivePolicy sets the "receive_policy" edge to the ReceivePolicy entity.
+func (auo *AccountUpdateOne) SetReceivePolicy(r *ReceivePolicy) *AccountUpdateOne {
+	return auo.SetReceivePolicyID(r.ID)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (auo *AccountUpdateOne) Mutation() *AccountMutation {
 	return auo.mutation
@@ -531,6 +646,12 @@ func (auo *AccountUpdateOne) RemoveBlocks(b ...*Block) *AccountUpdateOne {
 	return auo.RemoveBlockIDs(ids...)
 }
 
+// ClearReceivePolicy clears the "receive_policy" edge to the ReceivePolicy entity.
+func (auo *AccountUpdateOne) ClearReceivePolicy() *AccountUpdateOne {
+	auo.mutation.ClearReceivePolicy()
+	return auo
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (auo *AccountUpdateOne) Select(field string, fields ...string) *AccountUpdateOne {
@@ -704,6 +825,13 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 			Column: account.FieldWork,
 		})
 	}
+	if value, ok := auo.mutation.NeedsResync(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: account.FieldNeedsResync,
+		})
+	}
 	if auo.mutation.WalletCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -793,6 +921,41 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.ReceivePolicyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.ReceivePolicyTable,
+			Columns: []string{account.ReceivePolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: receivepolicy.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.ReceivePolicyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   account.ReceivePolicyTable,
+			Columns: []string{account.ReceivePolicyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: receivepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Account{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -13,7 +13,11 @@ import (
 
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhook"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdeadletter"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdelivery"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -29,8 +33,16 @@ type Client struct {
 	Account *AccountClient
 	// Block is the client for interacting with the Block builders.
 	Block *BlockClient
+	// ReceivePolicy is the client for interacting with the ReceivePolicy builders.
+	ReceivePolicy *ReceivePolicyClient
 	// Wallet is the client for interacting with the Wallet builders.
 	Wallet *WalletClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDeadLetter is the client for interacting with the WebhookDeadLetter builders.
+	WebhookDeadLetter *WebhookDeadLetterClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -46,7 +58,11 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Account = NewAccountClient(c.config)
 	c.Block = NewBlockClient(c.config)
+	c.ReceivePolicy = NewReceivePolicyClient(c.config)
 	c.Wallet = NewWalletClient(c.config)
+	c.Webhook = NewWebhookClient(c.config)
+	c.WebhookDeadLetter = NewWebhookDeadLetterClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 }
 
 // Open opens a database/sql.DB specified by the driver name and
@@ -78,11 +94,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Account: NewAccountClient(cfg),
-		Block:   NewBlockClient(cfg),
-		Wallet:  NewWalletClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		Account:           NewAccountClient(cfg),
+		Block:             NewBlockClient(cfg),
+		ReceivePolicy:     NewReceivePolicyClient(cfg),
+		Wallet:            NewWalletClient(cfg),
+		Webhook:           NewWebhookClient(cfg),
+		WebhookDeadLetter: NewWebhookDeadLetterClient(cfg),
+		WebhookDelivery:   NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -100,11 +120,15 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Account: NewAccountClient(cfg),
-		Block:   NewBlockClient(cfg),
-		Wallet:  NewWalletClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		Account:           NewAccountClient(cfg),
+		Block:             NewBlockClient(cfg),
+		ReceivePolicy:     NewReceivePolicyClient(cfg),
+		Wallet:            NewWalletClient(cfg),
+		Webhook:           NewWebhookClient(cfg),
+		WebhookDeadLetter: NewWebhookDeadLetterClient(cfg),
+		WebhookDelivery:   NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -135,7 +159,11 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	c.Account.Use(hooks...)
 	c.Block.Use(hooks...)
+	c.ReceivePolicy.Use(hooks...)
 	c.Wallet.Use(hooks...)
+	c.Webhook.Use(hooks...)
+	c.WebhookDeadLetter.Use(hooks...)
+	c.WebhookDelivery.Use(hooks...)
 }
 
 // AccountClient is a client for the Account schema.
@@ -255,6 +283,22 @@ func (c *AccountClient) QueryBlocks(a *Account) *BlockQuery {
 	return query
 }
 
+// QueryReceivePolicy queries the receive_policy edge of a Account.
+func (c *AccountClient) QueryReceivePolicy(a *Account) *ReceivePolicyQuery {
+	query := &ReceivePolicyQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(receivepolicy.Table, receivepolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, account.ReceivePolicyTable, account.ReceivePolicyColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AccountClient) Hooks() []Hook {
 	return c.hooks.Account
@@ -366,6 +410,128 @@ func (c *BlockClient) Hooks() []Hook {
 	return c.hooks.Block
 }
 
+// ReceivePolicyClient is a client for the ReceivePolicy schema.
+type ReceivePolicyClient struct {
+	config
+}
+
+// NewReceivePolicyClient returns a client for the ReceivePolicy from the given config.
+func NewReceivePolicyClient(c config) *ReceivePolicyClient {
+	return &ReceivePolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `receivepolicy.Hooks(f(g(h())))`.
+func (c *ReceivePolicyClient) Use(hooks ...Hook) {
+	c.hooks.ReceivePolicy = append(c.hooks.ReceivePolicy, hooks...)
+}
+
+// Create returns a builder for creating a ReceivePolicy entity.
+func (c *ReceivePolicyClient) Create() *ReceivePolicyCreate {
+	mutation := newReceivePolicyMutation(c.config, OpCreate)
+	return &ReceivePolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReceivePolicy entities.
+func (c *ReceivePolicyClient) CreateBulk(builders ...*ReceivePolicyCreate) *ReceivePolicyCreateBulk {
+	return &ReceivePolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReceivePolicy.
+func (c *ReceivePolicyClient) Update() *ReceivePolicyUpdate {
+	mutation := newReceivePolicyMutation(c.config, OpUpdate)
+	return &ReceivePolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReceivePolicyClient) UpdateOne(rp *ReceivePolicy) *ReceivePolicyUpdateOne {
+	mutation := newReceivePolicyMutation(c.config, OpUpdateOne, withReceivePolicy(rp))
+	return &ReceivePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReceivePolicyClient) UpdateOneID(id uuid.UUID) *ReceivePolicyUpdateOne {
+	mutation := newReceivePolicyMutation(c.config, OpUpdateOne, withReceivePolicyID(id))
+	return &ReceivePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReceivePolicy.
+func (c *ReceivePolicyClient) Delete() *ReceivePolicyDelete {
+	mutation := newReceivePolicyMutation(c.config, OpDelete)
+	return &ReceivePolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReceivePolicyClient) DeleteOne(rp *ReceivePolicy) *ReceivePolicyDeleteOne {
+	return c.DeleteOneID(rp.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ReceivePolicyClient) DeleteOneID(id uuid.UUID) *ReceivePolicyDeleteOne {
+	builder := c.Delete().Where(receivepolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReceivePolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for ReceivePolicy.
+func (c *ReceivePolicyClient) Query() *ReceivePolicyQuery {
+	return &ReceivePolicyQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ReceivePolicy entity by its id.
+func (c *ReceivePolicyClient) Get(ctx context.Context, id uuid.UUID) (*ReceivePolicy, error) {
+	return c.Query().Where(receivepolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReceivePolicyClient) GetX(ctx context.Context, id uuid.UUID) *ReceivePolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWallet queries the wallet edge of a ReceivePolicy.
+func (c *ReceivePolicyClient) QueryWallet(rp *ReceivePolicy) *WalletQuery {
+	query := &WalletQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := rp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receivepolicy.Table, receivepolicy.FieldID, id),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, receivepolicy.WalletTable, receivepolicy.WalletColumn),
+		)
+		fromV = sqlgraph.Neighbors(rp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccount queries the account edge of a ReceivePolicy.
+func (c *ReceivePolicyClient) QueryAccount(rp *ReceivePolicy) *AccountQuery {
+	query := &AccountQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := rp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receivepolicy.Table, receivepolicy.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, receivepolicy.AccountTable, receivepolicy.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(rp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReceivePolicyClient) Hooks() []Hook {
+	return c.hooks.ReceivePolicy
+}
+
 // WalletClient is a client for the Wallet schema.
 type WalletClient struct {
 	config
@@ -467,7 +633,389 @@ func (c *WalletClient) QueryAccounts(w *Wallet) *AccountQuery {
 	return query
 }
 
+// QueryWebhook queries the webhook edge of a Wallet.
+func (c *WalletClient) QueryWebhook(w *Wallet) *WebhookQuery {
+	query := &WebhookQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, wallet.WebhookTable, wallet.WebhookColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceivePolicies queries the receive_policies edge of a Wallet.
+func (c *WalletClient) QueryReceivePolicies(w *Wallet) *ReceivePolicyQuery {
+	query := &ReceivePolicyQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, id),
+			sqlgraph.To(receivepolicy.Table, receivepolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.ReceivePoliciesTable, wallet.ReceivePoliciesColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *WalletClient) Hooks() []Hook {
 	return c.hooks.Wallet
 }
+
+// WebhookClient is a client for the Webhook schema.
+type WebhookClient struct {
+	config
+}
+
+// NewWebhookClient returns a client for the Webhook from the given config.
+func NewWebhookClient(c config) *WebhookClient {
+	return &WebhookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhook.Hooks(f(g(h())))`.
+func (c *WebhookClient) Use(hooks ...Hook) {
+	c.hooks.Webhook = append(c.hooks.Webhook, hooks...)
+}
+
+// Create returns a builder for creating a Webhook entity.
+func (c *WebhookClient) Create() *WebhookCreate {
+	mutation := newWebhookMutation(c.config, OpCreate)
+	return &WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Webhook entities.
+func (c *WebhookClient) CreateBulk(builders ...*WebhookCreate) *WebhookCreateBulk {
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Webhook.
+func (c *WebhookClient) Update() *WebhookUpdate {
+	mutation := newWebhookMutation(c.config, OpUpdate)
+	return &WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookClient) UpdateOne(w *Webhook) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhook(w))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookClient) UpdateOneID(id uuid.UUID) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhookID(id))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Webhook.
+func (c *WebhookClient) Delete() *WebhookDelete {
+	mutation := newWebhookMutation(c.config, OpDelete)
+	return &WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookClient) DeleteOne(w *Webhook) *WebhookDeleteOne {
+	return c.DeleteOneID(w.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *WebhookClient) DeleteOneID(id uuid.UUID) *WebhookDeleteOne {
+	builder := c.Delete().Where(webhook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeleteOne{builder}
+}
+
+// Query returns a query builder for Webhook.
+func (c *WebhookClient) Query() *WebhookQuery {
+	return &WebhookQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Webhook entity by its id.
+func (c *WebhookClient) Get(ctx context.Context, id uuid.UUID) (*Webhook, error) {
+	return c.Query().Where(webhook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookClient) GetX(ctx context.Context, id uuid.UUID) *Webhook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWallet queries the wallet edge of a Webhook.
+func (c *WebhookClient) QueryWallet(w *Webhook) *WalletQuery {
+	query := &WalletQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, webhook.WalletTable, webhook.WalletColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeliveries queries the deliveries edge of a Webhook.
+func (c *WebhookClient) QueryDeliveries(w *Webhook) *WebhookDeliveryQuery {
+	query := &WebhookDeliveryQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeadLetters queries the dead_letters edge of a Webhook.
+func (c *WebhookClient) QueryDeadLetters(w *Webhook) *WebhookDeadLetterQuery {
+	query := &WebhookDeadLetterQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(webhookdeadletter.Table, webhookdeadletter.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeadLettersTable, webhook.DeadLettersColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookClient) Hooks() []Hook {
+	return c.hooks.Webhook
+}
+
+// WebhookDeadLetterClient is a client for the WebhookDeadLetter schema.
+type WebhookDeadLetterClient struct {
+	config
+}
+
+// NewWebhookDeadLetterClient returns a client for the WebhookDeadLetter from the given config.
+func NewWebhookDeadLetterClient(c config) *WebhookDeadLetterClient {
+	return &WebhookDeadLetterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdeadletter.Hooks(f(g(h())))`.
+func (c *WebhookDeadLetterClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDeadLetter = append(c.hooks.WebhookDeadLetter, hooks...)
+}
+
+// Create returns a builder for creating a WebhookDeadLetter entity.
+func (c *WebhookDeadLetterClient) Create() *WebhookDeadLetterCreate {
+	mutation := newWebhookDeadLetterMutation(c.config, OpCreate)
+	return &WebhookDeadLetterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDeadLetter entities.
+func (c *WebhookDeadLetterClient) CreateBulk(builders ...*WebhookDeadLetterCreate) *WebhookDeadLetterCreateBulk {
+	return &WebhookDeadLetterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDeadLetter.
+func (c *WebhookDeadLetterClient) Update() *WebhookDeadLetterUpdate {
+	mutation := newWebhookDeadLetterMutation(c.config, OpUpdate)
+	return &WebhookDeadLetterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeadLetterClient) UpdateOne(wdl *WebhookDeadLetter) *WebhookDeadLetterUpdateOne {
+	mutation := newWebhookDeadLetterMutation(c.config, OpUpdateOne, withWebhookDeadLetter(wdl))
+	return &WebhookDeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeadLetterClient) UpdateOneID(id uuid.UUID) *WebhookDeadLetterUpdateOne {
+	mutation := newWebhookDeadLetterMutation(c.config, OpUpdateOne, withWebhookDeadLetterID(id))
+	return &WebhookDeadLetterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDeadLetter.
+func (c *WebhookDeadLetterClient) Delete() *WebhookDeadLetterDelete {
+	mutation := newWebhookDeadLetterMutation(c.config, OpDelete)
+	return &WebhookDeadLetterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeadLetterClient) DeleteOne(wdl *WebhookDeadLetter) *WebhookDeadLetterDeleteOne {
+	return c.DeleteOneID(wdl.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *WebhookDeadLetterClient) DeleteOneID(id uuid.UUID) *WebhookDeadLetterDeleteOne {
+	builder := c.Delete().Where(webhookdeadletter.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeadLetterDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDeadLetter.
+func (c *WebhookDeadLetterClient) Query() *WebhookDeadLetterQuery {
+	return &WebhookDeadLetterQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WebhookDeadLetter entity by its id.
+func (c *WebhookDeadLetterClient) Get(ctx context.Context, id uuid.UUID) (*WebhookDeadLetter, error) {
+	return c.Query().Where(webhookdeadletter.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeadLetterClient) GetX(ctx context.Context, id uuid.UUID) *WebhookDeadLetter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWebhook queries the webhook edge of a WebhookDeadLetter.
+func (c *WebhookDeadLetterClient) QueryWebhook(wdl *WebhookDeadLetter) *WebhookQuery {
+	query := &WebhookQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := wdl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdeadletter.Table, webhookdeadletter.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdeadletter.WebhookTable, webhookdeadletter.WebhookColumn),
+		)
+		fromV = sqlgraph.Neighbors(wdl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeadLetterClient) Hooks() []Hook {
+	return c.hooks.WebhookDeadLetter
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id uuid.UUID) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id uuid.UUID) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id uuid.UUID) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWebhook queries the webhook edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryWebhook(wd *WebhookDelivery) *WebhookQuery {
+	query := &WebhookQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.WebhookTable, webhookdelivery.WebhookColumn),
+		)
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
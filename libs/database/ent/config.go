@@ -24,9 +24,13 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Account []ent.Hook
-	Block   []ent.Hook
-	Wallet  []ent.Hook
+	Account           []ent.Hook
+	Block             []ent.Hook
+	ReceivePolicy     []ent.Hook
+	Wallet            []ent.Hook
+	Webhook           []ent.Hook
+	WebhookDeadLetter []ent.Hook
+	WebhookDelivery   []ent.Hook
 }
 
 // Options applies the options on the config object.
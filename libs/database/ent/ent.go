@@ -12,7 +12,11 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhook"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdeadletter"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -33,9 +37,13 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		account.Table: account.ValidColumn,
-		block.Table:   block.ValidColumn,
-		wallet.Table:  wallet.ValidColumn,
+		account.Table:           account.ValidColumn,
+		block.Table:             block.ValidColumn,
+		receivepolicy.Table:     receivepolicy.ValidColumn,
+		wallet.Table:            wallet.ValidColumn,
+		webhook.Table:           webhook.ValidColumn,
+		webhookdeadletter.Table: webhookdeadletter.ValidColumn,
+		webhookdelivery.Table:   webhookdelivery.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -16,6 +16,7 @@ var (
 		{Name: "account_index", Type: field.TypeInt, Nullable: true},
 		{Name: "private_key", Type: field.TypeString, Nullable: true, Size: 512},
 		{Name: "work", Type: field.TypeBool, Default: true},
+		{Name: "needs_resync", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "wallet_id", Type: field.TypeUUID},
 	}
@@ -27,7 +28,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "accounts_wallets_accounts",
-				Columns:    []*schema.Column{AccountsColumns[6]},
+				Columns:    []*schema.Column{AccountsColumns[7]},
 				RefColumns: []*schema.Column{WalletsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -36,12 +37,12 @@ var (
 			{
 				Name:    "account_wallet_id",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[6]},
+				Columns: []*schema.Column{AccountsColumns[7]},
 			},
 			{
 				Name:    "account_wallet_id_address",
 				Unique:  true,
-				Columns: []*schema.Column{AccountsColumns[6], AccountsColumns[1]},
+				Columns: []*schema.Column{AccountsColumns[7], AccountsColumns[1]},
 			},
 		},
 	}
@@ -76,6 +77,44 @@ var (
 			},
 		},
 	}
+	// ReceivePoliciesColumns holds the columns for the "receive_policies" table.
+	ReceivePoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "policy", Type: field.TypeString, Size: 16},
+		{Name: "allowlist", Type: field.TypeJSON, Nullable: true},
+		{Name: "batch_window", Type: field.TypeInt, Default: 3600},
+		{Name: "last_batch_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	// ReceivePoliciesTable holds the schema information for the "receive_policies" table.
+	ReceivePoliciesTable = &schema.Table{
+		Name:       "receive_policies",
+		Columns:    ReceivePoliciesColumns,
+		PrimaryKey: []*schema.Column{ReceivePoliciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "receive_policies_accounts_receive_policy",
+				Columns:    []*schema.Column{ReceivePoliciesColumns[6]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "receive_policies_wallets_receive_policies",
+				Columns:    []*schema.Column{ReceivePoliciesColumns[7]},
+				RefColumns: []*schema.Column{WalletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "receivepolicy_wallet_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReceivePoliciesColumns[7]},
+			},
+		},
+	}
 	// WalletsColumns holds the columns for the "wallets" table.
 	WalletsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -91,11 +130,94 @@ var (
 		Columns:    WalletsColumns,
 		PrimaryKey: []*schema.Column{WalletsColumns[0]},
 	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "url", Type: field.TypeString, Size: 1024},
+		{Name: "secret", Type: field.TypeString, Size: 128},
+		{Name: "events", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID, Unique: true},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_wallets_webhook",
+				Columns:    []*schema.Column{WebhooksColumns[5]},
+				RefColumns: []*schema.Column{WalletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// WebhookDeadLettersColumns holds the columns for the "webhook_dead_letters" table.
+	WebhookDeadLettersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "event", Type: field.TypeString, Size: 64},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "attempts", Type: field.TypeInt},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "webhook_id", Type: field.TypeUUID},
+	}
+	// WebhookDeadLettersTable holds the schema information for the "webhook_dead_letters" table.
+	WebhookDeadLettersTable = &schema.Table{
+		Name:       "webhook_dead_letters",
+		Columns:    WebhookDeadLettersColumns,
+		PrimaryKey: []*schema.Column{WebhookDeadLettersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_dead_letters_webhooks_dead_letters",
+				Columns:    []*schema.Column{WebhookDeadLettersColumns[6]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "event", Type: field.TypeString, Size: 64},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "webhook_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[7]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[4]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccountsTable,
 		BlocksTable,
+		ReceivePoliciesTable,
 		WalletsTable,
+		WebhooksTable,
+		WebhookDeadLettersTable,
+		WebhookDeliveriesTable,
 	}
 )
 
@@ -108,7 +230,24 @@ func init() {
 	BlocksTable.Annotation = &entsql.Annotation{
 		Table: "blocks",
 	}
+	ReceivePoliciesTable.ForeignKeys[0].RefTable = AccountsTable
+	ReceivePoliciesTable.ForeignKeys[1].RefTable = WalletsTable
+	ReceivePoliciesTable.Annotation = &entsql.Annotation{
+		Table: "receive_policies",
+	}
 	WalletsTable.Annotation = &entsql.Annotation{
 		Table: "wallets",
 	}
+	WebhooksTable.ForeignKeys[0].RefTable = WalletsTable
+	WebhooksTable.Annotation = &entsql.Annotation{
+		Table: "webhooks",
+	}
+	WebhookDeadLettersTable.ForeignKeys[0].RefTable = WebhooksTable
+	WebhookDeadLettersTable.Annotation = &entsql.Annotation{
+		Table: "webhook_dead_letters",
+	}
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
+	WebhookDeliveriesTable.Annotation = &entsql.Annotation{
+		Table: "webhook_deliveries",
+	}
 }
@@ -12,7 +12,11 @@ import (
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhook"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdeadletter"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdelivery"
 	"github.com/google/uuid"
 
 	"entgo.io/ent"
@@ -27,32 +31,39 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAccount = "Account"
-	TypeBlock   = "Block"
-	TypeWallet  = "Wallet"
+	TypeAccount           = "Account"
+	TypeBlock             = "Block"
+	TypeReceivePolicy     = "ReceivePolicy"
+	TypeWallet            = "Wallet"
+	TypeWebhook           = "Webhook"
+	TypeWebhookDeadLetter = "WebhookDeadLetter"
+	TypeWebhookDelivery   = "WebhookDelivery"
 )
 
 // AccountMutation represents an operation that mutates the Account nodes in the graph.
 type AccountMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	address          *string
-	account_index    *int
-	addaccount_index *int
-	private_key      *string
-	work             *bool
-	created_at       *time.Time
-	clearedFields    map[string]struct{}
-	wallet           *uuid.UUID
-	clearedwallet    bool
-	blocks           map[uuid.UUID]struct{}
-	removedblocks    map[uuid.UUID]struct{}
-	clearedblocks    bool
-	done             bool
-	oldValue         func(context.Context) (*Account, error)
-	predicates       []predicate.Account
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	address               *string
+	account_index         *int
+	addaccount_index      *int
+	private_key           *string
+	work                  *bool
+	needs_resync          *bool
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	wallet                *uuid.UUID
+	clearedwallet         bool
+	blocks                map[uuid.UUID]struct{}
+	removedblocks         map[uuid.UUID]struct{}
+	clearedblocks         bool
+	receive_policy        *uuid.UUID
+	clearedreceive_policy bool
+	done                  bool
+	oldValue              func(context.Context) (*Account, error)
+	predicates            []predicate.Account
 }
 
 var _ ent.Mutation = (*AccountMutation)(nil)
@@ -386,6 +397,42 @@ func (m *AccountMutation) ResetWork() {
 	m.work = nil
 }
 
+// SetNeedsResync sets the "needs_resync" field.
+func (m *AccountMutation) SetNeedsResync(b bool) {
+	m.needs_resync = &b
+}
+
+// NeedsResync returns the value of the "needs_resync" field in the mutation.
+func (m *AccountMutation) NeedsResync() (r bool, exists bool) {
+	v := m.needs_resync
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNeedsResync returns the old "needs_resync" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldNeedsResync(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNeedsResync is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNeedsResync requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNeedsResync: %w", err)
+	}
+	return oldValue.NeedsResync, nil
+}
+
+// ResetNeedsResync resets all changes to the "needs_resync" field.
+func (m *AccountMutation) ResetNeedsResync() {
+	m.needs_resync = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *AccountMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -502,6 +549,45 @@ func (m *AccountMutation) ResetBlocks() {
 	m.removedblocks = nil
 }
 
+// SetReceivePolicyID sets the "receive_policy" edge to the ReceivePolicy entity by id.
+func (m *AccountMutation) SetReceivePolicyID(id uuid.UUID) {
+	m.receive_policy = &id
+}
+
+// ClearReceivePolicy clears the "receive_policy" edge to the ReceivePolicy entity.
+func (m *AccountMutation) ClearReceivePolicy() {
+	m.clearedreceive_policy = true
+}
+
+// ReceivePolicyCleared reports if the "receive_policy" edge to the ReceivePolicy entity was cleared.
+func (m *AccountMutation) ReceivePolicyCleared() bool {
+	return m.clearedreceive_policy
+}
+
+// ReceivePolicyID returns the "receive_policy" edge ID in the mutation.
+func (m *AccountMutation) ReceivePolicyID() (id uuid.UUID, exists bool) {
+	if m.receive_policy != nil {
+		return *m.receive_policy, true
+	}
+	return
+}
+
+// ReceivePolicyIDs returns the "receive_policy" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReceivePolicyID instead. It exists only for internal usage by the builders.
+func (m *AccountMutation) ReceivePolicyIDs() (ids []uuid.UUID) {
+	if id := m.receive_policy; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReceivePolicy resets all changes to the "receive_policy" edge.
+func (m *AccountMutation) ResetReceivePolicy() {
+	m.receive_policy = nil
+	m.clearedreceive_policy = false
+}
+
 // Where appends a list predicates to the AccountMutation builder.
 func (m *AccountMutation) Where(ps ...predicate.Account) {
 	m.predicates = append(m.predicates, ps...)
@@ -521,7 +607,7 @@ func (m *AccountMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AccountMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 7)
 	if m.wallet != nil {
 		fields = append(fields, account.FieldWalletID)
 	}
@@ -537,6 +623,9 @@ func (m *AccountMutation) Fields() []string {
 	if m.work != nil {
 		fields = append(fields, account.FieldWork)
 	}
+	if m.needs_resync != nil {
+		fields = append(fields, account.FieldNeedsResync)
+	}
 	if m.created_at != nil {
 		fields = append(fields, account.FieldCreatedAt)
 	}
@@ -558,6 +647,8 @@ func (m *AccountMutation) Field(name string) (ent.Value, bool) {
 		return m.PrivateKey()
 	case account.FieldWork:
 		return m.Work()
+	case account.FieldNeedsResync:
+		return m.NeedsResync()
 	case account.FieldCreatedAt:
 		return m.CreatedAt()
 	}
@@ -579,6 +670,8 @@ func (m *AccountMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldPrivateKey(ctx)
 	case account.FieldWork:
 		return m.OldWork(ctx)
+	case account.FieldNeedsResync:
+		return m.OldNeedsResync(ctx)
 	case account.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
@@ -625,6 +718,13 @@ func (m *AccountMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetWork(v)
 		return nil
+	case account.FieldNeedsResync:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNeedsResync(v)
+		return nil
 	case account.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -726,6 +826,9 @@ func (m *AccountMutation) ResetField(name string) error {
 	case account.FieldWork:
 		m.ResetWork()
 		return nil
+	case account.FieldNeedsResync:
+		m.ResetNeedsResync()
+		return nil
 	case account.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -735,13 +838,16 @@ func (m *AccountMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *AccountMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.wallet != nil {
 		edges = append(edges, account.EdgeWallet)
 	}
 	if m.blocks != nil {
 		edges = append(edges, account.EdgeBlocks)
 	}
+	if m.receive_policy != nil {
+		edges = append(edges, account.EdgeReceivePolicy)
+	}
 	return edges
 }
 
@@ -759,13 +865,17 @@ func (m *AccountMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case account.EdgeReceivePolicy:
+		if id := m.receive_policy; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *AccountMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.removedblocks != nil {
 		edges = append(edges, account.EdgeBlocks)
 	}
@@ -788,13 +898,16 @@ func (m *AccountMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *AccountMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.clearedwallet {
 		edges = append(edges, account.EdgeWallet)
 	}
 	if m.clearedblocks {
 		edges = append(edges, account.EdgeBlocks)
 	}
+	if m.clearedreceive_policy {
+		edges = append(edges, account.EdgeReceivePolicy)
+	}
 	return edges
 }
 
@@ -806,6 +919,8 @@ func (m *AccountMutation) EdgeCleared(name string) bool {
 		return m.clearedwallet
 	case account.EdgeBlocks:
 		return m.clearedblocks
+	case account.EdgeReceivePolicy:
+		return m.clearedreceive_policy
 	}
 	return false
 }
@@ -817,6 +932,9 @@ func (m *AccountMutation) ClearEdge(name string) error {
 	case account.EdgeWallet:
 		m.ClearWallet()
 		return nil
+	case account.EdgeReceivePolicy:
+		m.ClearReceivePolicy()
+		return nil
 	}
 	return fmt.Errorf("unknown Account unique edge %s", name)
 }
@@ -831,6 +949,9 @@ func (m *AccountMutation) ResetEdge(name string) error {
 	case account.EdgeBlocks:
 		m.ResetBlocks()
 		return nil
+	case account.EdgeReceivePolicy:
+		m.ResetReceivePolicy()
+		return nil
 	}
 	return fmt.Errorf("unknown Account edge %s", name)
 }
@@ -1518,37 +1639,39 @@ func (m *BlockMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Block edge %s", name)
 }
 
-// WalletMutation represents an operation that mutates the Wallet nodes in the graph.
-type WalletMutation struct {
+// ReceivePolicyMutation represents an operation that mutates the ReceivePolicy nodes in the graph.
+type ReceivePolicyMutation struct {
 	config
 	op              Op
 	typ             string
 	id              *uuid.UUID
-	seed            *string
-	representative  *string
-	encrypted       *bool
-	work            *bool
+	policy          *string
+	allowlist       *[]string
+	batch_window    *int
+	addbatch_window *int
+	last_batch_at   *time.Time
 	created_at      *time.Time
 	clearedFields   map[string]struct{}
-	accounts        map[uuid.UUID]struct{}
-	removedaccounts map[uuid.UUID]struct{}
-	clearedaccounts bool
+	wallet          *uuid.UUID
+	clearedwallet   bool
+	account         *uuid.UUID
+	clearedaccount  bool
 	done            bool
-	oldValue        func(context.Context) (*Wallet, error)
-	predicates      []predicate.Wallet
+	oldValue        func(context.Context) (*ReceivePolicy, error)
+	predicates      []predicate.ReceivePolicy
 }
 
-var _ ent.Mutation = (*WalletMutation)(nil)
+var _ ent.Mutation = (*ReceivePolicyMutation)(nil)
 
-// walletOption allows management of the mutation configuration using functional options.
-type walletOption func(*WalletMutation)
+// receivepolicyOption allows management of the mutation configuration using functional options.
+type receivepolicyOption func(*ReceivePolicyMutation)
 
-// newWalletMutation creates new mutation for the Wallet entity.
-func newWalletMutation(c config, op Op, opts ...walletOption) *WalletMutation {
-	m := &WalletMutation{
+// newReceivePolicyMutation creates new mutation for the ReceivePolicy entity.
+func newReceivePolicyMutation(c config, op Op, opts ...receivepolicyOption) *ReceivePolicyMutation {
+	m := &ReceivePolicyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeWallet,
+		typ:           TypeReceivePolicy,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1557,20 +1680,20 @@ func newWalletMutation(c config, op Op, opts ...walletOption) *WalletMutation {
 	return m
 }
 
-// withWalletID sets the ID field of the mutation.
-func withWalletID(id uuid.UUID) walletOption {
-	return func(m *WalletMutation) {
+// withReceivePolicyID sets the ID field of the mutation.
+func withReceivePolicyID(id uuid.UUID) receivepolicyOption {
+	return func(m *ReceivePolicyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Wallet
+			value *ReceivePolicy
 		)
-		m.oldValue = func(ctx context.Context) (*Wallet, error) {
+		m.oldValue = func(ctx context.Context) (*ReceivePolicy, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Wallet.Get(ctx, id)
+					value, err = m.Client().ReceivePolicy.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1579,10 +1702,10 @@ func withWalletID(id uuid.UUID) walletOption {
 	}
 }
 
-// withWallet sets the old Wallet of the mutation.
-func withWallet(node *Wallet) walletOption {
-	return func(m *WalletMutation) {
-		m.oldValue = func(context.Context) (*Wallet, error) {
+// withReceivePolicy sets the old ReceivePolicy of the mutation.
+func withReceivePolicy(node *ReceivePolicy) receivepolicyOption {
+	return func(m *ReceivePolicyMutation) {
+		m.oldValue = func(context.Context) (*ReceivePolicy, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1591,7 +1714,7 @@ func withWallet(node *Wallet) walletOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WalletMutation) Client() *Client {
+func (m ReceivePolicyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1599,7 +1722,7 @@ func (m WalletMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m WalletMutation) Tx() (*Tx, error) {
+func (m ReceivePolicyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1609,14 +1732,14 @@ func (m WalletMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Wallet entities.
-func (m *WalletMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ReceivePolicy entities.
+func (m *ReceivePolicyMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *WalletMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ReceivePolicyMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1627,7 +1750,7 @@ func (m *WalletMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *WalletMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ReceivePolicyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1636,293 +1759,3465 @@ func (m *WalletMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Wallet.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ReceivePolicy.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSeed sets the "seed" field.
-func (m *WalletMutation) SetSeed(s string) {
-	m.seed = &s
+// SetWalletID sets the "wallet_id" field.
+func (m *ReceivePolicyMutation) SetWalletID(u uuid.UUID) {
+	m.wallet = &u
 }
 
-// Seed returns the value of the "seed" field in the mutation.
-func (m *WalletMutation) Seed() (r string, exists bool) {
-	v := m.seed
+// WalletID returns the value of the "wallet_id" field in the mutation.
+func (m *ReceivePolicyMutation) WalletID() (r uuid.UUID, exists bool) {
+	v := m.wallet
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSeed returns the old "seed" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldWalletID returns the old "wallet_id" field's value of the ReceivePolicy entity.
+// If the ReceivePolicy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldSeed(ctx context.Context) (v string, err error) {
+func (m *ReceivePolicyMutation) OldWalletID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+		return v, errors.New("OldWalletID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeed requires an ID field in the mutation")
+		return v, errors.New("OldWalletID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
+		return v, fmt.Errorf("querying old value for OldWalletID: %w", err)
 	}
-	return oldValue.Seed, nil
+	return oldValue.WalletID, nil
 }
 
-// ResetSeed resets all changes to the "seed" field.
-func (m *WalletMutation) ResetSeed() {
-	m.seed = nil
+// ResetWalletID resets all changes to the "wallet_id" field.
+func (m *ReceivePolicyMutation) ResetWalletID() {
+	m.wallet = nil
 }
 
-// SetRepresentative sets the "representative" field.
-func (m *WalletMutation) SetRepresentative(s string) {
-	m.representative = &s
+// SetAccountID sets the "account_id" field.
+func (m *ReceivePolicyMutation) SetAccountID(u uuid.UUID) {
+	m.account = &u
 }
 
-// Representative returns the value of the "representative" field in the mutation.
-func (m *WalletMutation) Representative() (r string, exists bool) {
-	v := m.representative
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *ReceivePolicyMutation) AccountID() (r uuid.UUID, exists bool) {
+	v := m.account
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRepresentative returns the old "representative" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldAccountID returns the old "account_id" field's value of the ReceivePolicy entity.
+// If the ReceivePolicy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldRepresentative(ctx context.Context) (v *string, err error) {
+func (m *ReceivePolicyMutation) OldAccountID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRepresentative is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRepresentative requires an ID field in the mutation")
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRepresentative: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
 	}
-	return oldValue.Representative, nil
+	return oldValue.AccountID, nil
 }
 
-// ClearRepresentative clears the value of the "representative" field.
-func (m *WalletMutation) ClearRepresentative() {
-	m.representative = nil
-	m.clearedFields[wallet.FieldRepresentative] = struct{}{}
+// ClearAccountID clears the value of the "account_id" field.
+func (m *ReceivePolicyMutation) ClearAccountID() {
+	m.account = nil
+	m.clearedFields[receivepolicy.FieldAccountID] = struct{}{}
 }
 
-// RepresentativeCleared returns if the "representative" field was cleared in this mutation.
-func (m *WalletMutation) RepresentativeCleared() bool {
-	_, ok := m.clearedFields[wallet.FieldRepresentative]
+// AccountIDCleared returns if the "account_id" field was cleared in this mutation.
+func (m *ReceivePolicyMutation) AccountIDCleared() bool {
+	_, ok := m.clearedFields[receivepolicy.FieldAccountID]
 	return ok
 }
 
-// ResetRepresentative resets all changes to the "representative" field.
-func (m *WalletMutation) ResetRepresentative() {
-	m.representative = nil
-	delete(m.clearedFields, wallet.FieldRepresentative)
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *ReceivePolicyMutation) ResetAccountID() {
+	m.account = nil
+	delete(m.clearedFields, receivepolicy.FieldAccountID)
 }
 
-// SetEncrypted sets the "encrypted" field.
-func (m *WalletMutation) SetEncrypted(b bool) {
-	m.encrypted = &b
+// SetPolicy sets the "policy" field.
+func (m *ReceivePolicyMutation) SetPolicy(s string) {
+	m.policy = &s
 }
 
-// Encrypted returns the value of the "encrypted" field in the mutation.
-func (m *WalletMutation) Encrypted() (r bool, exists bool) {
-	v := m.encrypted
+// Policy returns the value of the "policy" field in the mutation.
+func (m *ReceivePolicyMutation) Policy() (r string, exists bool) {
+	v := m.policy
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncrypted returns the old "encrypted" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldPolicy returns the old "policy" field's value of the ReceivePolicy entity.
+// If the ReceivePolicy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldEncrypted(ctx context.Context) (v bool, err error) {
+func (m *ReceivePolicyMutation) OldPolicy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncrypted is only allowed on UpdateOne operations")
+		return v, errors.New("OldPolicy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncrypted requires an ID field in the mutation")
+		return v, errors.New("OldPolicy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncrypted: %w", err)
+		return v, fmt.Errorf("querying old value for OldPolicy: %w", err)
 	}
-	return oldValue.Encrypted, nil
+	return oldValue.Policy, nil
 }
 
-// ResetEncrypted resets all changes to the "encrypted" field.
-func (m *WalletMutation) ResetEncrypted() {
-	m.encrypted = nil
+// ResetPolicy resets all changes to the "policy" field.
+func (m *ReceivePolicyMutation) ResetPolicy() {
+	m.policy = nil
 }
 
-// SetWork sets the "work" field.
-func (m *WalletMutation) SetWork(b bool) {
-	m.work = &b
+// SetAllowlist sets the "allowlist" field.
+func (m *ReceivePolicyMutation) SetAllowlist(s []string) {
+	m.allowlist = &s
 }
 
-// Work returns the value of the "work" field in the mutation.
-func (m *WalletMutation) Work() (r bool, exists bool) {
-	v := m.work
+// Allowlist returns the value of the "allowlist" field in the mutation.
+func (m *ReceivePolicyMutation) Allowlist() (r []string, exists bool) {
+	v := m.allowlist
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWork returns the old "work" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldAllowlist returns the old "allowlist" field's value of the ReceivePolicy entity.
+// If the ReceivePolicy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldWork(ctx context.Context) (v bool, err error) {
+func (m *ReceivePolicyMutation) OldAllowlist(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWork is only allowed on UpdateOne operations")
+		return v, errors.New("OldAllowlist is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWork requires an ID field in the mutation")
+		return v, errors.New("OldAllowlist requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWork: %w", err)
+		return v, fmt.Errorf("querying old value for OldAllowlist: %w", err)
 	}
-	return oldValue.Work, nil
+	return oldValue.Allowlist, nil
 }
 
-// ResetWork resets all changes to the "work" field.
-func (m *WalletMutation) ResetWork() {
-	m.work = nil
+// ClearAllowlist clears the value of the "allowlist" field.
+func (m *ReceivePolicyMutation) ClearAllowlist() {
+	m.allowlist = nil
+	m.clearedFields[receivepolicy.FieldAllowlist] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *WalletMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AllowlistCleared returns if the "allowlist" field was cleared in this mutation.
+func (m *ReceivePolicyMutation) AllowlistCleared() bool {
+	_, ok := m.clearedFields[receivepolicy.FieldAllowlist]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *WalletMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetAllowlist resets all changes to the "allowlist" field.
+func (m *ReceivePolicyMutation) ResetAllowlist() {
+	m.allowlist = nil
+	delete(m.clearedFields, receivepolicy.FieldAllowlist)
+}
+
+// SetBatchWindow sets the "batch_window" field.
+func (m *ReceivePolicyMutation) SetBatchWindow(i int) {
+	m.batch_window = &i
+	m.addbatch_window = nil
+}
+
+// BatchWindow returns the value of the "batch_window" field in the mutation.
+func (m *ReceivePolicyMutation) BatchWindow() (r int, exists bool) {
+	v := m.batch_window
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldBatchWindow returns the old "batch_window" field's value of the ReceivePolicy entity.
+// If the ReceivePolicy object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReceivePolicyMutation) OldBatchWindow(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldBatchWindow is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldBatchWindow requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldBatchWindow: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.BatchWindow, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *WalletMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddBatchWindow adds i to the "batch_window" field.
+func (m *ReceivePolicyMutation) AddBatchWindow(i int) {
+	if m.addbatch_window != nil {
+		*m.addbatch_window += i
+	} else {
+		m.addbatch_window = &i
+	}
 }
 
-// AddAccountIDs adds the "accounts" edge to the Account entity by ids.
-func (m *WalletMutation) AddAccountIDs(ids ...uuid.UUID) {
-	if m.accounts == nil {
-		m.accounts = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.accounts[ids[i]] = struct{}{}
+// AddedBatchWindow returns the value that was added to the "batch_window" field in this mutation.
+func (m *ReceivePolicyMutation) AddedBatchWindow() (r int, exists bool) {
+	v := m.addbatch_window
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearAccounts clears the "accounts" edge to the Account entity.
-func (m *WalletMutation) ClearAccounts() {
-	m.clearedaccounts = true
+// ResetBatchWindow resets all changes to the "batch_window" field.
+func (m *ReceivePolicyMutation) ResetBatchWindow() {
+	m.batch_window = nil
+	m.addbatch_window = nil
 }
 
-// AccountsCleared reports if the "accounts" edge to the Account entity was cleared.
-func (m *WalletMutation) AccountsCleared() bool {
-	return m.clearedaccounts
+// SetLastBatchAt sets the "last_batch_at" field.
+func (m *ReceivePolicyMutation) SetLastBatchAt(t time.Time) {
+	m.last_batch_at = &t
 }
 
-// RemoveAccountIDs removes the "accounts" edge to the Account entity by IDs.
-func (m *WalletMutation) RemoveAccountIDs(ids ...uuid.UUID) {
-	if m.removedaccounts == nil {
-		m.removedaccounts = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.accounts, ids[i])
-		m.removedaccounts[ids[i]] = struct{}{}
+// LastBatchAt returns the value of the "last_batch_at" field in the mutation.
+func (m *ReceivePolicyMutation) LastBatchAt() (r time.Time, exists bool) {
+	v := m.last_batch_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAccounts returns the removed IDs of the "accounts" edge to the Account entity.
-func (m *WalletMutation) RemovedAccountsIDs() (ids []uuid.UUID) {
-	for id := range m.removedaccounts {
-		ids = append(ids, id)
+// OldLastBatchAt returns the old "last_batch_at" field's value of the ReceivePolicy entity.
+// If the ReceivePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceivePolicyMutation) OldLastBatchAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastBatchAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastBatchAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastBatchAt: %w", err)
+	}
+	return oldValue.LastBatchAt, nil
 }
 
-// AccountsIDs returns the "accounts" edge IDs in the mutation.
-func (m *WalletMutation) AccountsIDs() (ids []uuid.UUID) {
-	for id := range m.accounts {
-		ids = append(ids, id)
-	}
-	return
+// ClearLastBatchAt clears the value of the "last_batch_at" field.
+func (m *ReceivePolicyMutation) ClearLastBatchAt() {
+	m.last_batch_at = nil
+	m.clearedFields[receivepolicy.FieldLastBatchAt] = struct{}{}
 }
 
-// ResetAccounts resets all changes to the "accounts" edge.
-func (m *WalletMutation) ResetAccounts() {
-	m.accounts = nil
-	m.clearedaccounts = false
-	m.removedaccounts = nil
+// LastBatchAtCleared returns if the "last_batch_at" field was cleared in this mutation.
+func (m *ReceivePolicyMutation) LastBatchAtCleared() bool {
+	_, ok := m.clearedFields[receivepolicy.FieldLastBatchAt]
+	return ok
 }
 
-// Where appends a list predicates to the WalletMutation builder.
-func (m *WalletMutation) Where(ps ...predicate.Wallet) {
-	m.predicates = append(m.predicates, ps...)
+// ResetLastBatchAt resets all changes to the "last_batch_at" field.
+func (m *ReceivePolicyMutation) ResetLastBatchAt() {
+	m.last_batch_at = nil
+	delete(m.clearedFields, receivepolicy.FieldLastBatchAt)
 }
 
-// Op returns the operation name.
-func (m *WalletMutation) Op() Op {
+// SetCreatedAt sets the "created_at" field.
+func (m *ReceivePolicyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReceivePolicyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ReceivePolicy entity.
+// If the ReceivePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceivePolicyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReceivePolicyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (m *ReceivePolicyMutation) ClearWallet() {
+	m.clearedwallet = true
+}
+
+// WalletCleared reports if the "wallet" edge to the Wallet entity was cleared.
+func (m *ReceivePolicyMutation) WalletCleared() bool {
+	return m.clearedwallet
+}
+
+// WalletIDs returns the "wallet" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WalletID instead. It exists only for internal usage by the builders.
+func (m *ReceivePolicyMutation) WalletIDs() (ids []uuid.UUID) {
+	if id := m.wallet; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWallet resets all changes to the "wallet" edge.
+func (m *ReceivePolicyMutation) ResetWallet() {
+	m.wallet = nil
+	m.clearedwallet = false
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *ReceivePolicyMutation) ClearAccount() {
+	m.clearedaccount = true
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *ReceivePolicyMutation) AccountCleared() bool {
+	return m.AccountIDCleared() || m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *ReceivePolicyMutation) AccountIDs() (ids []uuid.UUID) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *ReceivePolicyMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// Where appends a list predicates to the ReceivePolicyMutation builder.
+func (m *ReceivePolicyMutation) Where(ps ...predicate.ReceivePolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *ReceivePolicyMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (ReceivePolicy).
+func (m *ReceivePolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReceivePolicyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.wallet != nil {
+		fields = append(fields, receivepolicy.FieldWalletID)
+	}
+	if m.account != nil {
+		fields = append(fields, receivepolicy.FieldAccountID)
+	}
+	if m.policy != nil {
+		fields = append(fields, receivepolicy.FieldPolicy)
+	}
+	if m.allowlist != nil {
+		fields = append(fields, receivepolicy.FieldAllowlist)
+	}
+	if m.batch_window != nil {
+		fields = append(fields, receivepolicy.FieldBatchWindow)
+	}
+	if m.last_batch_at != nil {
+		fields = append(fields, receivepolicy.FieldLastBatchAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, receivepolicy.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReceivePolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case receivepolicy.FieldWalletID:
+		return m.WalletID()
+	case receivepolicy.FieldAccountID:
+		return m.AccountID()
+	case receivepolicy.FieldPolicy:
+		return m.Policy()
+	case receivepolicy.FieldAllowlist:
+		return m.Allowlist()
+	case receivepolicy.FieldBatchWindow:
+		return m.BatchWindow()
+	case receivepolicy.FieldLastBatchAt:
+		return m.LastBatchAt()
+	case receivepolicy.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReceivePolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case receivepolicy.FieldWalletID:
+		return m.OldWalletID(ctx)
+	case receivepolicy.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case receivepolicy.FieldPolicy:
+		return m.OldPolicy(ctx)
+	case receivepolicy.FieldAllowlist:
+		return m.OldAllowlist(ctx)
+	case receivepolicy.FieldBatchWindow:
+		return m.OldBatchWindow(ctx)
+	case receivepolicy.FieldLastBatchAt:
+		return m.OldLastBatchAt(ctx)
+	case receivepolicy.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReceivePolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReceivePolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case receivepolicy.FieldWalletID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletID(v)
+		return nil
+	case receivepolicy.FieldAccountID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case receivepolicy.FieldPolicy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPolicy(v)
+		return nil
+	case receivepolicy.FieldAllowlist:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowlist(v)
+		return nil
+	case receivepolicy.FieldBatchWindow:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBatchWindow(v)
+		return nil
+	case receivepolicy.FieldLastBatchAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastBatchAt(v)
+		return nil
+	case receivepolicy.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReceivePolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReceivePolicyMutation) AddedFields() []string {
+	var fields []string
+	if m.addbatch_window != nil {
+		fields = append(fields, receivepolicy.FieldBatchWindow)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReceivePolicyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case receivepolicy.FieldBatchWindow:
+		return m.AddedBatchWindow()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReceivePolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case receivepolicy.FieldBatchWindow:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBatchWindow(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReceivePolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReceivePolicyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(receivepolicy.FieldAccountID) {
+		fields = append(fields, receivepolicy.FieldAccountID)
+	}
+	if m.FieldCleared(receivepolicy.FieldAllowlist) {
+		fields = append(fields, receivepolicy.FieldAllowlist)
+	}
+	if m.FieldCleared(receivepolicy.FieldLastBatchAt) {
+		fields = append(fields, receivepolicy.FieldLastBatchAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReceivePolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReceivePolicyMutation) ClearField(name string) error {
+	switch name {
+	case receivepolicy.FieldAccountID:
+		m.ClearAccountID()
+		return nil
+	case receivepolicy.FieldAllowlist:
+		m.ClearAllowlist()
+		return nil
+	case receivepolicy.FieldLastBatchAt:
+		m.ClearLastBatchAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReceivePolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReceivePolicyMutation) ResetField(name string) error {
+	switch name {
+	case receivepolicy.FieldWalletID:
+		m.ResetWalletID()
+		return nil
+	case receivepolicy.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case receivepolicy.FieldPolicy:
+		m.ResetPolicy()
+		return nil
+	case receivepolicy.FieldAllowlist:
+		m.ResetAllowlist()
+		return nil
+	case receivepolicy.FieldBatchWindow:
+		m.ResetBatchWindow()
+		return nil
+	case receivepolicy.FieldLastBatchAt:
+		m.ResetLastBatchAt()
+		return nil
+	case receivepolicy.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReceivePolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReceivePolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.wallet != nil {
+		edges = append(edges, receivepolicy.EdgeWallet)
+	}
+	if m.account != nil {
+		edges = append(edges, receivepolicy.EdgeAccount)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReceivePolicyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case receivepolicy.EdgeWallet:
+		if id := m.wallet; id != nil {
+			return []ent.Value{*id}
+		}
+	case receivepolicy.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReceivePolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReceivePolicyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReceivePolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedwallet {
+		edges = append(edges, receivepolicy.EdgeWallet)
+	}
+	if m.clearedaccount {
+		edges = append(edges, receivepolicy.EdgeAccount)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReceivePolicyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case receivepolicy.EdgeWallet:
+		return m.clearedwallet
+	case receivepolicy.EdgeAccount:
+		return m.clearedaccount
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReceivePolicyMutation) ClearEdge(name string) error {
+	switch name {
+	case receivepolicy.EdgeWallet:
+		m.ClearWallet()
+		return nil
+	case receivepolicy.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown ReceivePolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReceivePolicyMutation) ResetEdge(name string) error {
+	switch name {
+	case receivepolicy.EdgeWallet:
+		m.ResetWallet()
+		return nil
+	case receivepolicy.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown ReceivePolicy edge %s", name)
+}
+
+// WalletMutation represents an operation that mutates the Wallet nodes in the graph.
+type WalletMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	seed                    *string
+	representative          *string
+	encrypted               *bool
+	work                    *bool
+	created_at              *time.Time
+	clearedFields           map[string]struct{}
+	accounts                map[uuid.UUID]struct{}
+	removedaccounts         map[uuid.UUID]struct{}
+	clearedaccounts         bool
+	webhook                 *uuid.UUID
+	clearedwebhook          bool
+	receive_policies        map[uuid.UUID]struct{}
+	removedreceive_policies map[uuid.UUID]struct{}
+	clearedreceive_policies bool
+	done                    bool
+	oldValue                func(context.Context) (*Wallet, error)
+	predicates              []predicate.Wallet
+}
+
+var _ ent.Mutation = (*WalletMutation)(nil)
+
+// walletOption allows management of the mutation configuration using functional options.
+type walletOption func(*WalletMutation)
+
+// newWalletMutation creates new mutation for the Wallet entity.
+func newWalletMutation(c config, op Op, opts ...walletOption) *WalletMutation {
+	m := &WalletMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWallet,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWalletID sets the ID field of the mutation.
+func withWalletID(id uuid.UUID) walletOption {
+	return func(m *WalletMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Wallet
+		)
+		m.oldValue = func(ctx context.Context) (*Wallet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Wallet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWallet sets the old Wallet of the mutation.
+func withWallet(node *Wallet) walletOption {
+	return func(m *WalletMutation) {
+		m.oldValue = func(context.Context) (*Wallet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WalletMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WalletMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Wallet entities.
+func (m *WalletMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WalletMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WalletMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Wallet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSeed sets the "seed" field.
+func (m *WalletMutation) SetSeed(s string) {
+	m.seed = &s
+}
+
+// Seed returns the value of the "seed" field in the mutation.
+func (m *WalletMutation) Seed() (r string, exists bool) {
+	v := m.seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeed returns the old "seed" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldSeed(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
+	}
+	return oldValue.Seed, nil
+}
+
+// ResetSeed resets all changes to the "seed" field.
+func (m *WalletMutation) ResetSeed() {
+	m.seed = nil
+}
+
+// SetRepresentative sets the "representative" field.
+func (m *WalletMutation) SetRepresentative(s string) {
+	m.representative = &s
+}
+
+// Representative returns the value of the "representative" field in the mutation.
+func (m *WalletMutation) Representative() (r string, exists bool) {
+	v := m.representative
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepresentative returns the old "representative" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldRepresentative(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepresentative is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepresentative requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepresentative: %w", err)
+	}
+	return oldValue.Representative, nil
+}
+
+// ClearRepresentative clears the value of the "representative" field.
+func (m *WalletMutation) ClearRepresentative() {
+	m.representative = nil
+	m.clearedFields[wallet.FieldRepresentative] = struct{}{}
+}
+
+// RepresentativeCleared returns if the "representative" field was cleared in this mutation.
+func (m *WalletMutation) RepresentativeCleared() bool {
+	_, ok := m.clearedFields[wallet.FieldRepresentative]
+	return ok
+}
+
+// ResetRepresentative resets all changes to the "representative" field.
+func (m *WalletMutation) ResetRepresentative() {
+	m.representative = nil
+	delete(m.clearedFields, wallet.FieldRepresentative)
+}
+
+// SetEncrypted sets the "encrypted" field.
+func (m *WalletMutation) SetEncrypted(b bool) {
+	m.encrypted = &b
+}
+
+// Encrypted returns the value of the "encrypted" field in the mutation.
+func (m *WalletMutation) Encrypted() (r bool, exists bool) {
+	v := m.encrypted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncrypted returns the old "encrypted" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldEncrypted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncrypted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncrypted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncrypted: %w", err)
+	}
+	return oldValue.Encrypted, nil
+}
+
+// ResetEncrypted resets all changes to the "encrypted" field.
+func (m *WalletMutation) ResetEncrypted() {
+	m.encrypted = nil
+}
+
+// SetWork sets the "work" field.
+func (m *WalletMutation) SetWork(b bool) {
+	m.work = &b
+}
+
+// Work returns the value of the "work" field in the mutation.
+func (m *WalletMutation) Work() (r bool, exists bool) {
+	v := m.work
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWork returns the old "work" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldWork(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWork is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWork requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWork: %w", err)
+	}
+	return oldValue.Work, nil
+}
+
+// ResetWork resets all changes to the "work" field.
+func (m *WalletMutation) ResetWork() {
+	m.work = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WalletMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WalletMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WalletMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddAccountIDs adds the "accounts" edge to the Account entity by ids.
+func (m *WalletMutation) AddAccountIDs(ids ...uuid.UUID) {
+	if m.accounts == nil {
+		m.accounts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.accounts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAccounts clears the "accounts" edge to the Account entity.
+func (m *WalletMutation) ClearAccounts() {
+	m.clearedaccounts = true
+}
+
+// AccountsCleared reports if the "accounts" edge to the Account entity was cleared.
+func (m *WalletMutation) AccountsCleared() bool {
+	return m.clearedaccounts
+}
+
+// RemoveAccountIDs removes the "accounts" edge to the Account entity by IDs.
+func (m *WalletMutation) RemoveAccountIDs(ids ...uuid.UUID) {
+	if m.removedaccounts == nil {
+		m.removedaccounts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.accounts, ids[i])
+		m.removedaccounts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAccounts returns the removed IDs of the "accounts" edge to the Account entity.
+func (m *WalletMutation) RemovedAccountsIDs() (ids []uuid.UUID) {
+	for id := range m.removedaccounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AccountsIDs returns the "accounts" edge IDs in the mutation.
+func (m *WalletMutation) AccountsIDs() (ids []uuid.UUID) {
+	for id := range m.accounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAccounts resets all changes to the "accounts" edge.
+func (m *WalletMutation) ResetAccounts() {
+	m.accounts = nil
+	m.clearedaccounts = false
+	m.removedaccounts = nil
+}
+
+// SetWebhookID sets the "webhook" edge to the Webhook entity by id.
+func (m *WalletMutation) SetWebhookID(id uuid.UUID) {
+	m.webhook = &id
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (m *WalletMutation) ClearWebhook() {
+	m.clearedwebhook = true
+}
+
+// WebhookCleared reports if the "webhook" edge to the Webhook entity was cleared.
+func (m *WalletMutation) WebhookCleared() bool {
+	return m.clearedwebhook
+}
+
+// WebhookID returns the "webhook" edge ID in the mutation.
+func (m *WalletMutation) WebhookID() (id uuid.UUID, exists bool) {
+	if m.webhook != nil {
+		return *m.webhook, true
+	}
+	return
+}
+
+// WebhookIDs returns the "webhook" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WalletMutation) WebhookIDs() (ids []uuid.UUID) {
+	if id := m.webhook; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWebhook resets all changes to the "webhook" edge.
+func (m *WalletMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
+}
+
+// AddReceivePolicyIDs adds the "receive_policies" edge to the ReceivePolicy entity by ids.
+func (m *WalletMutation) AddReceivePolicyIDs(ids ...uuid.UUID) {
+	if m.receive_policies == nil {
+		m.receive_policies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.receive_policies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReceivePolicies clears the "receive_policies" edge to the ReceivePolicy entity.
+func (m *WalletMutation) ClearReceivePolicies() {
+	m.clearedreceive_policies = true
+}
+
+// ReceivePoliciesCleared reports if the "receive_policies" edge to the ReceivePolicy entity was cleared.
+func (m *WalletMutation) ReceivePoliciesCleared() bool {
+	return m.clearedreceive_policies
+}
+
+// RemoveReceivePolicyIDs removes the "receive_policies" edge to the ReceivePolicy entity by IDs.
+func (m *WalletMutation) RemoveReceivePolicyIDs(ids ...uuid.UUID) {
+	if m.removedreceive_policies == nil {
+		m.removedreceive_policies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.receive_policies, ids[i])
+		m.removedreceive_policies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReceivePolicies returns the removed IDs of the "receive_policies" edge to the ReceivePolicy entity.
+func (m *WalletMutation) RemovedReceivePoliciesIDs() (ids []uuid.UUID) {
+	for id := range m.removedreceive_policies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReceivePoliciesIDs returns the "receive_policies" edge IDs in the mutation.
+func (m *WalletMutation) ReceivePoliciesIDs() (ids []uuid.UUID) {
+	for id := range m.receive_policies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReceivePolicies resets all changes to the "receive_policies" edge.
+func (m *WalletMutation) ResetReceivePolicies() {
+	m.receive_policies = nil
+	m.clearedreceive_policies = false
+	m.removedreceive_policies = nil
+}
+
+// Where appends a list predicates to the WalletMutation builder.
+func (m *WalletMutation) Where(ps ...predicate.Wallet) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *WalletMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Wallet).
+func (m *WalletMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WalletMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.seed != nil {
+		fields = append(fields, wallet.FieldSeed)
+	}
+	if m.representative != nil {
+		fields = append(fields, wallet.FieldRepresentative)
+	}
+	if m.encrypted != nil {
+		fields = append(fields, wallet.FieldEncrypted)
+	}
+	if m.work != nil {
+		fields = append(fields, wallet.FieldWork)
+	}
+	if m.created_at != nil {
+		fields = append(fields, wallet.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WalletMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case wallet.FieldSeed:
+		return m.Seed()
+	case wallet.FieldRepresentative:
+		return m.Representative()
+	case wallet.FieldEncrypted:
+		return m.Encrypted()
+	case wallet.FieldWork:
+		return m.Work()
+	case wallet.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WalletMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case wallet.FieldSeed:
+		return m.OldSeed(ctx)
+	case wallet.FieldRepresentative:
+		return m.OldRepresentative(ctx)
+	case wallet.FieldEncrypted:
+		return m.OldEncrypted(ctx)
+	case wallet.FieldWork:
+		return m.OldWork(ctx)
+	case wallet.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Wallet field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WalletMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case wallet.FieldSeed:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeed(v)
+		return nil
+	case wallet.FieldRepresentative:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepresentative(v)
+		return nil
+	case wallet.FieldEncrypted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncrypted(v)
+		return nil
+	case wallet.FieldWork:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWork(v)
+		return nil
+	case wallet.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Wallet field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WalletMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WalletMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WalletMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Wallet numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WalletMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(wallet.FieldRepresentative) {
+		fields = append(fields, wallet.FieldRepresentative)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WalletMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WalletMutation) ClearField(name string) error {
+	switch name {
+	case wallet.FieldRepresentative:
+		m.ClearRepresentative()
+		return nil
+	}
+	return fmt.Errorf("unknown Wallet nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WalletMutation) ResetField(name string) error {
+	switch name {
+	case wallet.FieldSeed:
+		m.ResetSeed()
+		return nil
+	case wallet.FieldRepresentative:
+		m.ResetRepresentative()
+		return nil
+	case wallet.FieldEncrypted:
+		m.ResetEncrypted()
+		return nil
+	case wallet.FieldWork:
+		m.ResetWork()
+		return nil
+	case wallet.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Wallet field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WalletMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.accounts != nil {
+		edges = append(edges, wallet.EdgeAccounts)
+	}
+	if m.webhook != nil {
+		edges = append(edges, wallet.EdgeWebhook)
+	}
+	if m.receive_policies != nil {
+		edges = append(edges, wallet.EdgeReceivePolicies)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WalletMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case wallet.EdgeAccounts:
+		ids := make([]ent.Value, 0, len(m.accounts))
+		for id := range m.accounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeWebhook:
+		if id := m.webhook; id != nil {
+			return []ent.Value{*id}
+		}
+	case wallet.EdgeReceivePolicies:
+		ids := make([]ent.Value, 0, len(m.receive_policies))
+		for id := range m.receive_policies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WalletMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedaccounts != nil {
+		edges = append(edges, wallet.EdgeAccounts)
+	}
+	if m.removedreceive_policies != nil {
+		edges = append(edges, wallet.EdgeReceivePolicies)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WalletMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case wallet.EdgeAccounts:
+		ids := make([]ent.Value, 0, len(m.removedaccounts))
+		for id := range m.removedaccounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeReceivePolicies:
+		ids := make([]ent.Value, 0, len(m.removedreceive_policies))
+		for id := range m.removedreceive_policies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WalletMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedaccounts {
+		edges = append(edges, wallet.EdgeAccounts)
+	}
+	if m.clearedwebhook {
+		edges = append(edges, wallet.EdgeWebhook)
+	}
+	if m.clearedreceive_policies {
+		edges = append(edges, wallet.EdgeReceivePolicies)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WalletMutation) EdgeCleared(name string) bool {
+	switch name {
+	case wallet.EdgeAccounts:
+		return m.clearedaccounts
+	case wallet.EdgeWebhook:
+		return m.clearedwebhook
+	case wallet.EdgeReceivePolicies:
+		return m.clearedreceive_policies
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WalletMutation) ClearEdge(name string) error {
+	switch name {
+	case wallet.EdgeWebhook:
+		m.ClearWebhook()
+		return nil
+	}
+	return fmt.Errorf("unknown Wallet unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WalletMutation) ResetEdge(name string) error {
+	switch name {
+	case wallet.EdgeAccounts:
+		m.ResetAccounts()
+		return nil
+	case wallet.EdgeWebhook:
+		m.ResetWebhook()
+		return nil
+	case wallet.EdgeReceivePolicies:
+		m.ResetReceivePolicies()
+		return nil
+	}
+	return fmt.Errorf("unknown Wallet edge %s", name)
+}
+
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	url                 *string
+	secret              *string
+	events              *[]string
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	wallet              *uuid.UUID
+	clearedwallet       bool
+	deliveries          map[uuid.UUID]struct{}
+	removeddeliveries   map[uuid.UUID]struct{}
+	cleareddeliveries   bool
+	dead_letters        map[uuid.UUID]struct{}
+	removeddead_letters map[uuid.UUID]struct{}
+	cleareddead_letters bool
+	done                bool
+	oldValue            func(context.Context) (*Webhook, error)
+	predicates          []predicate.Webhook
+}
+
+var _ ent.Mutation = (*WebhookMutation)(nil)
+
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
+
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhook,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id uuid.UUID) webhookOption {
+	return func(m *WebhookMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Webhook
+		)
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Webhook.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Webhook entities.
+func (m *WebhookMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (m *WebhookMutation) SetWalletID(u uuid.UUID) {
+	m.wallet = &u
+}
+
+// WalletID returns the value of the "wallet_id" field in the mutation.
+func (m *WebhookMutation) WalletID() (r uuid.UUID, exists bool) {
+	v := m.wallet
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWalletID returns the old "wallet_id" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldWalletID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletID: %w", err)
+	}
+	return oldValue.WalletID, nil
+}
+
+// ResetWalletID resets all changes to the "wallet_id" field.
+func (m *WebhookMutation) ResetWalletID() {
+	m.wallet = nil
+}
+
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetEvents sets the "events" field.
+func (m *WebhookMutation) SetEvents(s []string) {
+	m.events = &s
+}
+
+// Events returns the value of the "events" field in the mutation.
+func (m *WebhookMutation) Events() (r []string, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvents returns the old "events" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldEvents(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
+}
+
+// ClearEvents clears the value of the "events" field.
+func (m *WebhookMutation) ClearEvents() {
+	m.events = nil
+	m.clearedFields[webhook.FieldEvents] = struct{}{}
+}
+
+// EventsCleared returns if the "events" field was cleared in this mutation.
+func (m *WebhookMutation) EventsCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldEvents]
+	return ok
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *WebhookMutation) ResetEvents() {
+	m.events = nil
+	delete(m.clearedFields, webhook.FieldEvents)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (m *WebhookMutation) ClearWallet() {
+	m.clearedwallet = true
+}
+
+// WalletCleared reports if the "wallet" edge to the Wallet entity was cleared.
+func (m *WebhookMutation) WalletCleared() bool {
+	return m.clearedwallet
+}
+
+// WalletIDs returns the "wallet" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WalletID instead. It exists only for internal usage by the builders.
+func (m *WebhookMutation) WalletIDs() (ids []uuid.UUID) {
+	if id := m.wallet; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWallet resets all changes to the "wallet" edge.
+func (m *WebhookMutation) ResetWallet() {
+	m.wallet = nil
+	m.clearedwallet = false
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookMutation) AddDeliveryIDs(ids ...uuid.UUID) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.deliveries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
+}
+
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookMutation) RemoveDeliveryIDs(ids ...uuid.UUID) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) RemovedDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookMutation) DeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.deliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
+}
+
+// AddDeadLetterIDs adds the "dead_letters" edge to the WebhookDeadLetter entity by ids.
+func (m *WebhookMutation) AddDeadLetterIDs(ids ...uuid.UUID) {
+	if m.dead_letters == nil {
+		m.dead_letters = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.dead_letters[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeadLetters clears the "dead_letters" edge to the WebhookDeadLetter entity.
+func (m *WebhookMutation) ClearDeadLetters() {
+	m.cleareddead_letters = true
+}
+
+// DeadLettersCleared reports if the "dead_letters" edge to the WebhookDeadLetter entity was cleared.
+func (m *WebhookMutation) DeadLettersCleared() bool {
+	return m.cleareddead_letters
+}
+
+// RemoveDeadLetterIDs removes the "dead_letters" edge to the WebhookDeadLetter entity by IDs.
+func (m *WebhookMutation) RemoveDeadLetterIDs(ids ...uuid.UUID) {
+	if m.removeddead_letters == nil {
+		m.removeddead_letters = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.dead_letters, ids[i])
+		m.removeddead_letters[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeadLetters returns the removed IDs of the "dead_letters" edge to the WebhookDeadLetter entity.
+func (m *WebhookMutation) RemovedDeadLettersIDs() (ids []uuid.UUID) {
+	for id := range m.removeddead_letters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeadLettersIDs returns the "dead_letters" edge IDs in the mutation.
+func (m *WebhookMutation) DeadLettersIDs() (ids []uuid.UUID) {
+	for id := range m.dead_letters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeadLetters resets all changes to the "dead_letters" edge.
+func (m *WebhookMutation) ResetDeadLetters() {
+	m.dead_letters = nil
+	m.cleareddead_letters = false
+	m.removeddead_letters = nil
+}
+
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *WebhookMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.wallet != nil {
+		fields = append(fields, webhook.FieldWalletID)
+	}
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
+	}
+	if m.events != nil {
+		fields = append(fields, webhook.FieldEvents)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhook.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhook.FieldWalletID:
+		return m.WalletID()
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldEvents:
+		return m.Events()
+	case webhook.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhook.FieldWalletID:
+		return m.OldWalletID(ctx)
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldEvents:
+		return m.OldEvents(ctx)
+	case webhook.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhook.FieldWalletID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletID(v)
+		return nil
+	case webhook.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case webhook.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhook.FieldEvents:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case webhook.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WebhookMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WebhookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhook.FieldEvents) {
+		fields = append(fields, webhook.FieldEvents)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WebhookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WebhookMutation) ClearField(name string) error {
+	switch name {
+	case webhook.FieldEvents:
+		m.ClearEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WebhookMutation) ResetField(name string) error {
+	switch name {
+	case webhook.FieldWalletID:
+		m.ResetWalletID()
+		return nil
+	case webhook.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhook.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhook.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case webhook.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.wallet != nil {
+		edges = append(edges, webhook.EdgeWallet)
+	}
+	if m.deliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
+	}
+	if m.dead_letters != nil {
+		edges = append(edges, webhook.EdgeDeadLetters)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhook.EdgeWallet:
+		if id := m.wallet; id != nil {
+			return []ent.Value{*id}
+		}
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	case webhook.EdgeDeadLetters:
+		ids := make([]ent.Value, 0, len(m.dead_letters))
+		for id := range m.dead_letters {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
+	}
+	if m.removeddead_letters != nil {
+		edges = append(edges, webhook.EdgeDeadLetters)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	case webhook.EdgeDeadLetters:
+		ids := make([]ent.Value, 0, len(m.removeddead_letters))
+		for id := range m.removeddead_letters {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedwallet {
+		edges = append(edges, webhook.EdgeWallet)
+	}
+	if m.cleareddeliveries {
+		edges = append(edges, webhook.EdgeDeliveries)
+	}
+	if m.cleareddead_letters {
+		edges = append(edges, webhook.EdgeDeadLetters)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WebhookMutation) EdgeCleared(name string) bool {
+	switch name {
+	case webhook.EdgeWallet:
+		return m.clearedwallet
+	case webhook.EdgeDeliveries:
+		return m.cleareddeliveries
+	case webhook.EdgeDeadLetters:
+		return m.cleareddead_letters
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WebhookMutation) ClearEdge(name string) error {
+	switch name {
+	case webhook.EdgeWallet:
+		m.ClearWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WebhookMutation) ResetEdge(name string) error {
+	switch name {
+	case webhook.EdgeWallet:
+		m.ResetWallet()
+		return nil
+	case webhook.EdgeDeliveries:
+		m.ResetDeliveries()
+		return nil
+	case webhook.EdgeDeadLetters:
+		m.ResetDeadLetters()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook edge %s", name)
+}
+
+// WebhookDeadLetterMutation represents an operation that mutates the WebhookDeadLetter nodes in the graph.
+type WebhookDeadLetterMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	event          *string
+	payload        *string
+	attempts       *int
+	addattempts    *int
+	last_error     *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	webhook        *uuid.UUID
+	clearedwebhook bool
+	done           bool
+	oldValue       func(context.Context) (*WebhookDeadLetter, error)
+	predicates     []predicate.WebhookDeadLetter
+}
+
+var _ ent.Mutation = (*WebhookDeadLetterMutation)(nil)
+
+// webhookdeadletterOption allows management of the mutation configuration using functional options.
+type webhookdeadletterOption func(*WebhookDeadLetterMutation)
+
+// newWebhookDeadLetterMutation creates new mutation for the WebhookDeadLetter entity.
+func newWebhookDeadLetterMutation(c config, op Op, opts ...webhookdeadletterOption) *WebhookDeadLetterMutation {
+	m := &WebhookDeadLetterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookDeadLetter,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebhookDeadLetterID sets the ID field of the mutation.
+func withWebhookDeadLetterID(id uuid.UUID) webhookdeadletterOption {
+	return func(m *WebhookDeadLetterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookDeadLetter
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookDeadLetter, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookDeadLetter.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhookDeadLetter sets the old WebhookDeadLetter of the mutation.
+func withWebhookDeadLetter(node *WebhookDeadLetter) webhookdeadletterOption {
+	return func(m *WebhookDeadLetterMutation) {
+		m.oldValue = func(context.Context) (*WebhookDeadLetter, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeadLetterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeadLetterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebhookDeadLetter entities.
+func (m *WebhookDeadLetterMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeadLetterMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookDeadLetterMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookDeadLetter.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (m *WebhookDeadLetterMutation) SetWebhookID(u uuid.UUID) {
+	m.webhook = &u
+}
+
+// WebhookID returns the value of the "webhook_id" field in the mutation.
+func (m *WebhookDeadLetterMutation) WebhookID() (r uuid.UUID, exists bool) {
+	v := m.webhook
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebhookID returns the old "webhook_id" field's value of the WebhookDeadLetter entity.
+// If the WebhookDeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeadLetterMutation) OldWebhookID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebhookID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebhookID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebhookID: %w", err)
+	}
+	return oldValue.WebhookID, nil
+}
+
+// ResetWebhookID resets all changes to the "webhook_id" field.
+func (m *WebhookDeadLetterMutation) ResetWebhookID() {
+	m.webhook = nil
+}
+
+// SetEvent sets the "event" field.
+func (m *WebhookDeadLetterMutation) SetEvent(s string) {
+	m.event = &s
+}
+
+// Event returns the value of the "event" field in the mutation.
+func (m *WebhookDeadLetterMutation) Event() (r string, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvent returns the old "event" field's value of the WebhookDeadLetter entity.
+// If the WebhookDeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeadLetterMutation) OldEvent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
+}
+
+// ResetEvent resets all changes to the "event" field.
+func (m *WebhookDeadLetterMutation) ResetEvent() {
+	m.event = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *WebhookDeadLetterMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeadLetterMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the WebhookDeadLetter entity.
+// If the WebhookDeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeadLetterMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeadLetterMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeadLetterMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeadLetterMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the WebhookDeadLetter entity.
+// If the WebhookDeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeadLetterMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeadLetterMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeadLetterMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeadLetterMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeadLetterMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeadLetterMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the WebhookDeadLetter entity.
+// If the WebhookDeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeadLetterMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *WebhookDeadLetterMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdeadletter.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *WebhookDeadLetterMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdeadletter.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeadLetterMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdeadletter.FieldLastError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookDeadLetterMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookDeadLetterMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDeadLetter entity.
+// If the WebhookDeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeadLetterMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookDeadLetterMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (m *WebhookDeadLetterMutation) ClearWebhook() {
+	m.clearedwebhook = true
+}
+
+// WebhookCleared reports if the "webhook" edge to the Webhook entity was cleared.
+func (m *WebhookDeadLetterMutation) WebhookCleared() bool {
+	return m.clearedwebhook
+}
+
+// WebhookIDs returns the "webhook" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeadLetterMutation) WebhookIDs() (ids []uuid.UUID) {
+	if id := m.webhook; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWebhook resets all changes to the "webhook" edge.
+func (m *WebhookDeadLetterMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
+}
+
+// Where appends a list predicates to the WebhookDeadLetterMutation builder.
+func (m *WebhookDeadLetterMutation) Where(ps ...predicate.WebhookDeadLetter) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *WebhookDeadLetterMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (WebhookDeadLetter).
+func (m *WebhookDeadLetterMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WebhookDeadLetterMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.webhook != nil {
+		fields = append(fields, webhookdeadletter.FieldWebhookID)
+	}
+	if m.event != nil {
+		fields = append(fields, webhookdeadletter.FieldEvent)
+	}
+	if m.payload != nil {
+		fields = append(fields, webhookdeadletter.FieldPayload)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdeadletter.FieldAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdeadletter.FieldLastError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhookdeadletter.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WebhookDeadLetterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdeadletter.FieldWebhookID:
+		return m.WebhookID()
+	case webhookdeadletter.FieldEvent:
+		return m.Event()
+	case webhookdeadletter.FieldPayload:
+		return m.Payload()
+	case webhookdeadletter.FieldAttempts:
+		return m.Attempts()
+	case webhookdeadletter.FieldLastError:
+		return m.LastError()
+	case webhookdeadletter.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookDeadLetterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhookdeadletter.FieldWebhookID:
+		return m.OldWebhookID(ctx)
+	case webhookdeadletter.FieldEvent:
+		return m.OldEvent(ctx)
+	case webhookdeadletter.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdeadletter.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdeadletter.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdeadletter.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebhookDeadLetter field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookDeadLetterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhookdeadletter.FieldWebhookID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebhookID(v)
+		return nil
+	case webhookdeadletter.FieldEvent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvent(v)
+		return nil
+	case webhookdeadletter.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case webhookdeadletter.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdeadletter.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case webhookdeadletter.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDeadLetter field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WebhookDeadLetterMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdeadletter.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WebhookDeadLetterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdeadletter.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookDeadLetterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case webhookdeadletter.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDeadLetter numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WebhookDeadLetterMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhookdeadletter.FieldLastError) {
+		fields = append(fields, webhookdeadletter.FieldLastError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WebhookDeadLetterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WebhookDeadLetterMutation) ClearField(name string) error {
+	switch name {
+	case webhookdeadletter.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDeadLetter nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WebhookDeadLetterMutation) ResetField(name string) error {
+	switch name {
+	case webhookdeadletter.FieldWebhookID:
+		m.ResetWebhookID()
+		return nil
+	case webhookdeadletter.FieldEvent:
+		m.ResetEvent()
+		return nil
+	case webhookdeadletter.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case webhookdeadletter.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdeadletter.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdeadletter.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDeadLetter field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WebhookDeadLetterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.webhook != nil {
+		edges = append(edges, webhookdeadletter.EdgeWebhook)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WebhookDeadLetterMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhookdeadletter.EdgeWebhook:
+		if id := m.webhook; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WebhookDeadLetterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WebhookDeadLetterMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WebhookDeadLetterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdeadletter.EdgeWebhook)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WebhookDeadLetterMutation) EdgeCleared(name string) bool {
+	switch name {
+	case webhookdeadletter.EdgeWebhook:
+		return m.clearedwebhook
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WebhookDeadLetterMutation) ClearEdge(name string) error {
+	switch name {
+	case webhookdeadletter.EdgeWebhook:
+		m.ClearWebhook()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDeadLetter unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WebhookDeadLetterMutation) ResetEdge(name string) error {
+	switch name {
+	case webhookdeadletter.EdgeWebhook:
+		m.ResetWebhook()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDeadLetter edge %s", name)
+}
+
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	event           *string
+	payload         *string
+	attempts        *int
+	addattempts     *int
+	next_attempt_at *time.Time
+	last_error      *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	webhook         *uuid.UUID
+	clearedwebhook  bool
+	done            bool
+	oldValue        func(context.Context) (*WebhookDelivery, error)
+	predicates      []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id uuid.UUID) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeliveryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (m *WebhookDeliveryMutation) SetWebhookID(u uuid.UUID) {
+	m.webhook = &u
+}
+
+// WebhookID returns the value of the "webhook_id" field in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (r uuid.UUID, exists bool) {
+	v := m.webhook
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebhookID returns the old "webhook_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldWebhookID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebhookID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebhookID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebhookID: %w", err)
+	}
+	return oldValue.WebhookID, nil
+}
+
+// ResetWebhookID resets all changes to the "webhook_id" field.
+func (m *WebhookDeliveryMutation) ResetWebhookID() {
+	m.webhook = nil
+}
+
+// SetEvent sets the "event" field.
+func (m *WebhookDeliveryMutation) SetEvent(s string) {
+	m.event = &s
+}
+
+// Event returns the value of the "event" field in the mutation.
+func (m *WebhookDeliveryMutation) Event() (r string, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvent returns the old "event" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEvent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
+	}
+	return oldValue.Event, nil
+}
+
+// ResetEvent resets all changes to the "event" field.
+func (m *WebhookDeliveryMutation) ResetEvent() {
+	m.event = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (m *WebhookDeliveryMutation) ClearWebhook() {
+	m.clearedwebhook = true
+}
+
+// WebhookCleared reports if the "webhook" edge to the Webhook entity was cleared.
+func (m *WebhookDeliveryMutation) WebhookCleared() bool {
+	return m.clearedwebhook
+}
+
+// WebhookIDs returns the "webhook" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) WebhookIDs() (ids []uuid.UUID) {
+	if id := m.webhook; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWebhook resets all changes to the "webhook" edge.
+func (m *WebhookDeliveryMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
+}
+
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Wallet).
-func (m *WalletMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *WalletMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.seed != nil {
-		fields = append(fields, wallet.FieldSeed)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.webhook != nil {
+		fields = append(fields, webhookdelivery.FieldWebhookID)
 	}
-	if m.representative != nil {
-		fields = append(fields, wallet.FieldRepresentative)
+	if m.event != nil {
+		fields = append(fields, webhookdelivery.FieldEvent)
 	}
-	if m.encrypted != nil {
-		fields = append(fields, wallet.FieldEncrypted)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.work != nil {
-		fields = append(fields, wallet.FieldWork)
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
 	if m.created_at != nil {
-		fields = append(fields, wallet.FieldCreatedAt)
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1930,17 +5225,21 @@ func (m *WalletMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *WalletMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case wallet.FieldSeed:
-		return m.Seed()
-	case wallet.FieldRepresentative:
-		return m.Representative()
-	case wallet.FieldEncrypted:
-		return m.Encrypted()
-	case wallet.FieldWork:
-		return m.Work()
-	case wallet.FieldCreatedAt:
+	case webhookdelivery.FieldWebhookID:
+		return m.WebhookID()
+	case webhookdelivery.FieldEvent:
+		return m.Event()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -1949,56 +5248,74 @@ func (m *WalletMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *WalletMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case wallet.FieldSeed:
-		return m.OldSeed(ctx)
-	case wallet.FieldRepresentative:
-		return m.OldRepresentative(ctx)
-	case wallet.FieldEncrypted:
-		return m.OldEncrypted(ctx)
-	case wallet.FieldWork:
-		return m.OldWork(ctx)
-	case wallet.FieldCreatedAt:
+	case webhookdelivery.FieldWebhookID:
+		return m.OldWebhookID(ctx)
+	case webhookdelivery.FieldEvent:
+		return m.OldEvent(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Wallet field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WalletMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case wallet.FieldSeed:
+	case webhookdelivery.FieldWebhookID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebhookID(v)
+		return nil
+	case webhookdelivery.FieldEvent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSeed(v)
+		m.SetEvent(v)
 		return nil
-	case wallet.FieldRepresentative:
+	case webhookdelivery.FieldPayload:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRepresentative(v)
+		m.SetPayload(v)
 		return nil
-	case wallet.FieldEncrypted:
-		v, ok := value.(bool)
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEncrypted(v)
+		m.SetAttempts(v)
 		return nil
-	case wallet.FieldWork:
-		v, ok := value.(bool)
+	case webhookdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWork(v)
+		m.SetNextAttemptAt(v)
 		return nil
-	case wallet.FieldCreatedAt:
+	case webhookdelivery.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case webhookdelivery.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2006,162 +5323,175 @@ func (m *WalletMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Wallet field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *WalletMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *WalletMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WalletMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Wallet numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *WalletMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(wallet.FieldRepresentative) {
-		fields = append(fields, wallet.FieldRepresentative)
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *WalletMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *WalletMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case wallet.FieldRepresentative:
-		m.ClearRepresentative()
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
 		return nil
 	}
-	return fmt.Errorf("unknown Wallet nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *WalletMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case wallet.FieldSeed:
-		m.ResetSeed()
+	case webhookdelivery.FieldWebhookID:
+		m.ResetWebhookID()
 		return nil
-	case wallet.FieldRepresentative:
-		m.ResetRepresentative()
+	case webhookdelivery.FieldEvent:
+		m.ResetEvent()
 		return nil
-	case wallet.FieldEncrypted:
-		m.ResetEncrypted()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case wallet.FieldWork:
-		m.ResetWork()
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case wallet.FieldCreatedAt:
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Wallet field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WalletMutation) AddedEdges() []string {
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.accounts != nil {
-		edges = append(edges, wallet.EdgeAccounts)
+	if m.webhook != nil {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *WalletMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case wallet.EdgeAccounts:
-		ids := make([]ent.Value, 0, len(m.accounts))
-		for id := range m.accounts {
-			ids = append(ids, id)
+	case webhookdelivery.EdgeWebhook:
+		if id := m.webhook; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WalletMutation) RemovedEdges() []string {
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedaccounts != nil {
-		edges = append(edges, wallet.EdgeAccounts)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *WalletMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case wallet.EdgeAccounts:
-		ids := make([]ent.Value, 0, len(m.removedaccounts))
-		for id := range m.removedaccounts {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WalletMutation) ClearedEdges() []string {
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedaccounts {
-		edges = append(edges, wallet.EdgeAccounts)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *WalletMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case wallet.EdgeAccounts:
-		return m.clearedaccounts
+	case webhookdelivery.EdgeWebhook:
+		return m.clearedwebhook
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *WalletMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
+	case webhookdelivery.EdgeWebhook:
+		m.ClearWebhook()
+		return nil
 	}
-	return fmt.Errorf("unknown Wallet unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *WalletMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case wallet.EdgeAccounts:
-		m.ResetAccounts()
+	case webhookdelivery.EdgeWebhook:
+		m.ResetWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown Wallet edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
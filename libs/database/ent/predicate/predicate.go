@@ -12,5 +12,17 @@ type Account func(*sql.Selector)
 // Block is the predicate function for block builders.
 type Block func(*sql.Selector)
 
+// ReceivePolicy is the predicate function for receivepolicy builders.
+type ReceivePolicy func(*sql.Selector)
+
 // Wallet is the predicate function for wallet builders.
 type Wallet func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDeadLetter is the predicate function for webhookdeadletter builders.
+type WebhookDeadLetter func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
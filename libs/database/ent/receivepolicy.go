@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// ReceivePolicy is the model entity for the ReceivePolicy schema.
+type ReceivePolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WalletID holds the value of the "wallet_id" field.
+	WalletID uuid.UUID `json:"wallet_id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID *uuid.UUID `json:"account_id,omitempty"`
+	// Policy holds the value of the "policy" field.
+	Policy string `json:"policy,omitempty"`
+	// Allowlist holds the value of the "allowlist" field.
+	Allowlist []string `json:"allowlist,omitempty"`
+	// BatchWindow holds the value of the "batch_window" field.
+	BatchWindow int `json:"batch_window,omitempty"`
+	// LastBatchAt holds the value of the "last_batch_at" field.
+	LastBatchAt *time.Time `json:"last_batch_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReceivePolicyQuery when eager-loading is set.
+	Edges ReceivePolicyEdges `json:"edges"`
+}
+
+// ReceivePolicyEdges holds the relations/edges for other nodes in the graph.
+type ReceivePolicyEdges struct {
+	// Wallet holds the value of the wallet edge.
+	Wallet *Wallet `json:"wallet,omitempty"`
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// WalletOrErr returns the Wallet value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReceivePolicyEdges) WalletOrErr() (*Wallet, error) {
+	if e.loadedTypes[0] {
+		if e.Wallet == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: wallet.Label}
+		}
+		return e.Wallet, nil
+	}
+	return nil, &NotLoadedError{edge: "wallet"}
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReceivePolicyEdges) AccountOrErr() (*Account, error) {
+	if e.loadedTypes[1] {
+		if e.Account == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: account.Label}
+		}
+		return e.Account, nil
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReceivePolicy) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case receivepolicy.FieldAccountID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case receivepolicy.FieldAllowlist:
+			values[i] = new([]byte)
+		case receivepolicy.FieldBatchWindow:
+			values[i] = new(sql.NullInt64)
+		case receivepolicy.FieldPolicy:
+			values[i] = new(sql.NullString)
+		case receivepolicy.FieldLastBatchAt, receivepolicy.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case receivepolicy.FieldID, receivepolicy.FieldWalletID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ReceivePolicy", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReceivePolicy fields.
+func (rp *ReceivePolicy) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case receivepolicy.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rp.ID = *value
+			}
+		case receivepolicy.FieldWalletID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_id", values[i])
+			} else if value != nil {
+				rp.WalletID = *value
+			}
+		case receivepolicy.FieldAccountID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value.Valid {
+				rp.AccountID = new(uuid.UUID)
+				*rp.AccountID = *value.S.(*uuid.UUID)
+			}
+		case receivepolicy.FieldPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy", values[i])
+			} else if value.Valid {
+				rp.Policy = value.String
+			}
+		case receivepolicy.FieldAllowlist:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowlist", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rp.Allowlist); err != nil {
+					return fmt.Errorf("unmarshal field allowlist: %w", err)
+				}
+			}
+		case receivepolicy.FieldBatchWindow:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_window", values[i])
+			} else if value.Valid {
+				rp.BatchWindow = int(value.Int64)
+			}
+		case receivepolicy.FieldLastBatchAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_batch_at", values[i])
+			} else if value.Valid {
+				rp.LastBatchAt = new(time.Time)
+				*rp.LastBatchAt = value.Time
+			}
+		case receivepolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rp.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryWallet queries the "wallet" edge of the ReceivePolicy entity.
+func (rp *ReceivePolicy) QueryWallet() *WalletQuery {
+	return (&ReceivePolicyClient{config: rp.config}).QueryWallet(rp)
+}
+
+// QueryAccount queries the "account" edge of the ReceivePolicy entity.
+func (rp *ReceivePolicy) QueryAccount() *AccountQuery {
+	return (&ReceivePolicyClient{config: rp.config}).QueryAccount(rp)
+}
+
+// Update returns a builder for updating this ReceivePolicy.
+// Note that you need to call ReceivePolicy.Unwrap() before calling this method if this ReceivePolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rp *ReceivePolicy) Update() *ReceivePolicyUpdateOne {
+	return (&ReceivePolicyClient{config: rp.config}).UpdateOne(rp)
+}
+
+// Unwrap unwraps the ReceivePolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rp *ReceivePolicy) Unwrap() *ReceivePolicy {
+	_tx, ok := rp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReceivePolicy is not a transactional entity")
+	}
+	rp.config.driver = _tx.drv
+	return rp
+}
+
+// String implements the fmt.Stringer.
+func (rp *ReceivePolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReceivePolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rp.ID))
+	builder.WriteString("wallet_id=")
+	builder.WriteString(fmt.Sprintf("%v", rp.WalletID))
+	builder.WriteString(", ")
+	if v := rp.AccountID; v != nil {
+		builder.WriteString("account_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("policy=")
+	builder.WriteString(rp.Policy)
+	builder.WriteString(", ")
+	builder.WriteString("allowlist=")
+	builder.WriteString(fmt.Sprintf("%v", rp.Allowlist))
+	builder.WriteString(", ")
+	builder.WriteString("batch_window=")
+	builder.WriteString(fmt.Sprintf("%v", rp.BatchWindow))
+	builder.WriteString(", ")
+	if v := rp.LastBatchAt; v != nil {
+		builder.WriteString("last_batch_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rp.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReceivePolicies is a parsable slice of ReceivePolicy.
+type ReceivePolicies []*ReceivePolicy
+
+func (rp ReceivePolicies) config(cfg config) {
+	for _i := range rp {
+		rp[_i].config = cfg
+	}
+}
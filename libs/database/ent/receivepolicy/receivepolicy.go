@@ -0,0 +1,83 @@
+// Code generated by ent, DO NOT EDIT.
+
+package receivepolicy
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the receivepolicy type in the database.
+	Label = "receive_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWalletID holds the string denoting the wallet_id field in the database.
+	FieldWalletID = "wallet_id"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldPolicy holds the string denoting the policy field in the database.
+	FieldPolicy = "policy"
+	// FieldAllowlist holds the string denoting the allowlist field in the database.
+	FieldAllowlist = "allowlist"
+	// FieldBatchWindow holds the string denoting the batch_window field in the database.
+	FieldBatchWindow = "batch_window"
+	// FieldLastBatchAt holds the string denoting the last_batch_at field in the database.
+	FieldLastBatchAt = "last_batch_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeWallet holds the string denoting the wallet edge name in mutations.
+	EdgeWallet = "wallet"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// Table holds the table name of the receivepolicy in the database.
+	Table = "receive_policies"
+	// WalletTable is the table that holds the wallet relation/edge.
+	WalletTable = "receive_policies"
+	// WalletInverseTable is the table name for the Wallet entity.
+	// It exists in this package in order to avoid circular dependency with the "wallet" package.
+	WalletInverseTable = "wallets"
+	// WalletColumn is the table column denoting the wallet relation/edge.
+	WalletColumn = "wallet_id"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "receive_policies"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+)
+
+// Columns holds all SQL columns for receivepolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldWalletID,
+	FieldAccountID,
+	FieldPolicy,
+	FieldAllowlist,
+	FieldBatchWindow,
+	FieldLastBatchAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PolicyValidator is a validator for the "policy" field. It is called by the builders before save.
+	PolicyValidator func(string) error
+	// DefaultBatchWindow holds the default value on creation for the "batch_window" field.
+	DefaultBatchWindow int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
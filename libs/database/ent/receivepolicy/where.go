@@ -0,0 +1,611 @@
+// Code generated by ent, DO NOT EDIT.
+
+package receivepolicy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// WalletID applies equality check predicate on the "wallet_id" field. It's identical to WalletIDEQ.
+func WalletID(v uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletID), v))
+	})
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAccountID), v))
+	})
+}
+
+// BatchWindow applies equality check predicate on the "batch_window" field. It's identical to BatchWindowEQ.
+func BatchWindow(v int) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBatchWindow), v))
+	})
+}
+
+// LastBatchAt applies equality check predicate on the "last_batch_at" field. It's identical to LastBatchAtEQ.
+func LastBatchAt(v time.Time) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastBatchAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// WalletIDEQ applies the EQ predicate on the "wallet_id" field.
+func WalletIDEQ(v uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletID), v))
+	})
+}
+
+// WalletIDNEQ applies the NEQ predicate on the "wallet_id" field.
+func WalletIDNEQ(v uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWalletID), v))
+	})
+}
+
+// WalletIDIn applies the In predicate on the "wallet_id" field.
+func WalletIDIn(vs ...uuid.UUID) predicate.ReceivePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldWalletID), v...))
+	})
+}
+
+// WalletIDNotIn applies the NotIn predicate on the "wallet_id" field.
+func WalletIDNotIn(vs ...uuid.UUID) predicate.ReceivePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldWalletID), v...))
+	})
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAccountID), v))
+	})
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v uuid.UUID) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAccountID), v))
+	})
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...uuid.UUID) predicate.ReceivePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAccountID), v...))
+	})
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...uuid.UUID) predicate.ReceivePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAccountID), v...))
+	})
+}
+
+// AccountIDIsNil applies the IsNil predicate on the "account_id" field.
+func AccountIDIsNil() predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAccountID)))
+	})
+}
+
+// AccountIDNotNil applies the NotNil predicate on the "account_id" field.
+func AccountIDNotNil() predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAccountID)))
+	})
+}
+
+// PolicyEQ applies the EQ predicate on the "policy" field.
+func PolicyEQ(v string) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPolicy), v))
+	})
+}
+
+// PolicyNEQ applies the NEQ predicate on the "policy" field.
+func PolicyNEQ(v string) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPolicy), v))
+	})
+}
+
+// PolicyIn applies the In predicate on the "policy" field.
+func PolicyIn(vs ...string) predicate.ReceivePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldPolicy), v...))
+	})
+}
+
+// PolicyNotIn applies the NotIn predicate on the "policy" field.
+func PolicyNotIn(vs ...string) predicate.ReceivePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldPolicy), v...))
+	})
+}
+
+// PolicyGT applies the GT predicate on the "policy" field.
+func PolicyGT(v string) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPolicy), v))
+	})
+}
+
+// PolicyGTE applies the GTE predicate on the "policy" field.
+func PolicyGTE(v string) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPolicy), v))
+	})
+}
+
+// PolicyLT applies the LT predicate on the "policy" field.
+func PolicyLT(v string) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPolicy), v))
+	})
+}
+
+// PolicyLTE applies the LTE predicate on the "policy" field.
+func PolicyLTE(v string) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPolicy), v))
+	})
+}
+
+// PolicyContains applies the Contains predicate on the "policy" field.
+func PolicyContains(v string) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPolicy), v))
+	})
+}
+
+// PolicyHasPrefix applies the HasPrefix predicate on the "policy" field.
+func PolicyHasPrefix(v string) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPolicy), v))
+	})
+}
+
+// PolicyHasSuffix applies the HasSuffix predicate on the "policy" field.
+func PolicyHasSuffix(v string) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPolicy), v))
+	})
+}
+
+// PolicyEqualFold applies the EqualFold predicate on the "policy" field.
+func PolicyEqualFold(v string) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPolicy), v))
+	})
+}
+
+// PolicyContainsFold applies the ContainsFold predicate on the "policy" field.
+func PolicyContainsFold(v string) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPolicy), v))
+	})
+}
+
+// AllowlistIsNil applies the IsNil predicate on the "allowlist" field.
+func AllowlistIsNil() predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAllowlist)))
+	})
+}
+
+// AllowlistNotNil applies the NotNil predicate on the "allowlist" field.
+func AllowlistNotNil() predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAllowlist)))
+	})
+}
+
+// BatchWindowEQ applies the EQ predicate on the "batch_window" field.
+func BatchWindowEQ(v int) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBatchWindow), v))
+	})
+}
+
+// BatchWindowNEQ applies the NEQ predicate on the "batch_window" field.
+func BatchWindowNEQ(v int) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBatchWindow), v))
+	})
+}
+
+// BatchWindowIn applies the In predicate on the "batch_window" field.
+func BatchWindowIn(vs ...int) predicate.ReceivePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldBatchWindow), v...))
+	})
+}
+
+// BatchWindowNotIn applies the NotIn predicate on the "batch_window" field.
+func BatchWindowNotIn(vs ...int) predicate.ReceivePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldBatchWindow), v...))
+	})
+}
+
+// BatchWindowGT applies the GT predicate on the "batch_window" field.
+func BatchWindowGT(v int) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBatchWindow), v))
+	})
+}
+
+// BatchWindowGTE applies the GTE predicate on the "batch_window" field.
+func BatchWindowGTE(v int) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBatchWindow), v))
+	})
+}
+
+// BatchWindowLT applies the LT predicate on the "batch_window" field.
+func BatchWindowLT(v int) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBatchWindow), v))
+	})
+}
+
+// BatchWindowLTE applies the LTE predicate on the "batch_window" field.
+func BatchWindowLTE(v int) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBatchWindow), v))
+	})
+}
+
+// LastBatchAtEQ applies the EQ predicate on the "last_batch_at" field.
+func LastBatchAtEQ(v time.Time) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastBatchAt), v))
+	})
+}
+
+// LastBatchAtNEQ applies the NEQ predicate on the "last_batch_at" field.
+func LastBatchAtNEQ(v time.Time) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastBatchAt), v))
+	})
+}
+
+// LastBatchAtIn applies the In predicate on the "last_batch_at" field.
+func LastBatchAtIn(vs ...time.Time) predicate.ReceivePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldLastBatchAt), v...))
+	})
+}
+
+// LastBatchAtNotIn applies the NotIn predicate on the "last_batch_at" field.
+func LastBatchAtNotIn(vs ...time.Time) predicate.ReceivePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldLastBatchAt), v...))
+	})
+}
+
+// LastBatchAtGT applies the GT predicate on the "last_batch_at" field.
+func LastBatchAtGT(v time.Time) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastBatchAt), v))
+	})
+}
+
+// LastBatchAtGTE applies the GTE predicate on the "last_batch_at" field.
+func LastBatchAtGTE(v time.Time) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastBatchAt), v))
+	})
+}
+
+// LastBatchAtLT applies the LT predicate on the "last_batch_at" field.
+func LastBatchAtLT(v time.Time) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastBatchAt), v))
+	})
+}
+
+// LastBatchAtLTE applies the LTE predicate on the "last_batch_at" field.
+func LastBatchAtLTE(v time.Time) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastBatchAt), v))
+	})
+}
+
+// LastBatchAtIsNil applies the IsNil predicate on the "last_batch_at" field.
+func LastBatchAtIsNil() predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLastBatchAt)))
+	})
+}
+
+// LastBatchAtNotNil applies the NotNil predicate on the "last_batch_at" field.
+func LastBatchAtNotNil() predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLastBatchAt)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReceivePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReceivePolicy {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasWallet applies the HasEdge predicate on the "wallet" edge.
+func HasWallet() predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWalletWith applies the HasEdge predicate on the "wallet" edge with a given conditions (other predicates).
+func HasWalletWith(preds ...predicate.Wallet) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AccountTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AccountInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReceivePolicy) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReceivePolicy) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReceivePolicy) predicate.ReceivePolicy {
+	return predicate.ReceivePolicy(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// ReceivePolicyCreate is the builder for creating a ReceivePolicy entity.
+type ReceivePolicyCreate struct {
+	config
+	mutation *ReceivePolicyMutation
+	hooks    []Hook
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (rpc *ReceivePolicyCreate) SetWalletID(u uuid.UUID) *ReceivePolicyCreate {
+	rpc.mutation.SetWalletID(u)
+	return rpc
+}
+
+// SetAccountID sets the "account_id" field.
+func (rpc *ReceivePolicyCreate) SetAccountID(u uuid.UUID) *ReceivePolicyCreate {
+	rpc.mutation.SetAccountID(u)
+	return rpc
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (rpc *ReceivePolicyCreate) SetNillableAccountID(u *uuid.UUID) *ReceivePolicyCreate {
+	if u != nil {
+		rpc.SetAccountID(*u)
+	}
+	return rpc
+}
+
+// SetPolicy sets the "policy" field.
+func (rpc *ReceivePolicyCreate) SetPolicy(s string) *ReceivePolicyCreate {
+	rpc.mutation.SetPolicy(s)
+	return rpc
+}
+
+// SetAllowlist sets the "allowlist" field.
+func (rpc *ReceivePolicyCreate) SetAllowlist(s []string) *ReceivePolicyCreate {
+	rpc.mutation.SetAllowlist(s)
+	return rpc
+}
+
+// SetBatchWindow sets the "batch_window" field.
+func (rpc *ReceivePolicyCreate) SetBatchWindow(i int) *ReceivePolicyCreate {
+	rpc.mutation.SetBatchWindow(i)
+	return rpc
+}
+
+// SetNillableBatchWindow sets the "batch_window" field if the given value is not nil.
+func (rpc *ReceivePolicyCreate) SetNillableBatchWindow(i *int) *ReceivePolicyCreate {
+	if i != nil {
+		rpc.SetBatchWindow(*i)
+	}
+	return rpc
+}
+
+// SetLastBatchAt sets the "last_batch_at" field.
+func (rpc *ReceivePolicyCreate) SetLastBatchAt(t time.Time) *ReceivePolicyCreate {
+	rpc.mutation.SetLastBatchAt(t)
+	return rpc
+}
+
+// SetNillableLastBatchAt sets the "last_batch_at" field if the given value is not nil.
+func (rpc *ReceivePolicyCreate) SetNillableLastBatchAt(t *time.Time) *ReceivePolicyCreate {
+	if t != nil {
+		rpc.SetLastBatchAt(*t)
+	}
+	return rpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rpc *ReceivePolicyCreate) SetCreatedAt(t time.Time) *ReceivePolicyCreate {
+	rpc.mutation.SetCreatedAt(t)
+	return rpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rpc *ReceivePolicyCreate) SetNillableCreatedAt(t *time.Time) *ReceivePolicyCreate {
+	if t != nil {
+		rpc.SetCreatedAt(*t)
+	}
+	return rpc
+}
+
+// SetID sets the "id" field.
+func (rpc *ReceivePolicyCreate) SetID(u uuid.UUID) *ReceivePolicyCreate {
+	rpc.mutation.SetID(u)
+	return rpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rpc *ReceivePolicyCreate) SetNillableID(u *uuid.UUID) *ReceivePolicyCreate {
+	if u != nil {
+		rpc.SetID(*u)
+	}
+	return rpc
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (rpc *ReceivePolicyCreate) SetWallet(w *Wallet) *ReceivePolicyCreate {
+	return rpc.SetWalletID(w.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (rpc *ReceivePolicyCreate) SetAccount(a *Account) *ReceivePolicyCreate {
+	return rpc.SetAccountID(a.ID)
+}
+
+// Mutation returns the ReceivePolicyMutation object of the builder.
+func (rpc *ReceivePolicyCreate) Mutation() *ReceivePolicyMutation {
+	return rpc.mutation
+}
+
+// Save creates the ReceivePolicy in the database.
+func (rpc *ReceivePolicyCreate) Save(ctx context.Context) (*ReceivePolicy, error) {
+	var (
+		err  error
+		node *ReceivePolicy
+	)
+	rpc.defaults()
+	if len(rpc.hooks) == 0 {
+		if err = rpc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rpc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReceivePolicyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rpc.check(); err != nil {
+				return nil, err
+			}
+			rpc.mutation = mutation
+			if node, err = rpc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rpc.hooks) - 1; i >= 0; i-- {
+			if rpc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rpc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ReceivePolicy)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ReceivePolicyMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rpc *ReceivePolicyCreate) SaveX(ctx context.Context) *ReceivePolicy {
+	v, err := rpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpc *ReceivePolicyCreate) Exec(ctx context.Context) error {
+	_, err := rpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpc *ReceivePolicyCreate) ExecX(ctx context.Context) {
+	if err := rpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpc *ReceivePolicyCreate) defaults() {
+	if _, ok := rpc.mutation.BatchWindow(); !ok {
+		v := receivepolicy.DefaultBatchWindow
+		rpc.mutation.SetBatchWindow(v)
+	}
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		v := receivepolicy.DefaultCreatedAt()
+		rpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rpc.mutation.ID(); !ok {
+		v := receivepolicy.DefaultID()
+		rpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpc *ReceivePolicyCreate) check() error {
+	if _, ok := rpc.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet_id", err: errors.New(`ent: missing required field "ReceivePolicy.wallet_id"`)}
+	}
+	if _, ok := rpc.mutation.Policy(); !ok {
+		return &ValidationError{Name: "policy", err: errors.New(`ent: missing required field "ReceivePolicy.policy"`)}
+	}
+	if v, ok := rpc.mutation.Policy(); ok {
+		if err := receivepolicy.PolicyValidator(v); err != nil {
+			return &ValidationError{Name: "policy", err: fmt.Errorf(`ent: validator failed for field "ReceivePolicy.policy": %w`, err)}
+		}
+	}
+	if _, ok := rpc.mutation.BatchWindow(); !ok {
+		return &ValidationError{Name: "batch_window", err: errors.New(`ent: missing required field "ReceivePolicy.batch_window"`)}
+	}
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReceivePolicy.created_at"`)}
+	}
+	if _, ok := rpc.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet", err: errors.New(`ent: missing required edge "ReceivePolicy.wallet"`)}
+	}
+	return nil
+}
+
+func (rpc *ReceivePolicyCreate) sqlSave(ctx context.Context) (*ReceivePolicy, error) {
+	_node, _spec := rpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (rpc *ReceivePolicyCreate) createSpec() (*ReceivePolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReceivePolicy{config: rpc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: receivepolicy.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: receivepolicy.FieldID,
+			},
+		}
+	)
+	if id, ok := rpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rpc.mutation.Policy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: receivepolicy.FieldPolicy,
+		})
+		_node.Policy = value
+	}
+	if value, ok := rpc.mutation.Allowlist(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: receivepolicy.FieldAllowlist,
+		})
+		_node.Allowlist = value
+	}
+	if value, ok := rpc.mutation.BatchWindow(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: receivepolicy.FieldBatchWindow,
+		})
+		_node.BatchWindow = value
+	}
+	if value, ok := rpc.mutation.LastBatchAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: receivepolicy.FieldLastBatchAt,
+		})
+		_node.LastBatchAt = &value
+	}
+	if value, ok := rpc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: receivepolicy.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := rpc.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receivepolicy.WalletTable,
+			Columns: []string{receivepolicy.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WalletID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rpc.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   receivepolicy.AccountTable,
+			Columns: []string{receivepolicy.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReceivePolicyCreateBulk is the builder for creating many ReceivePolicy entities in bulk.
+type ReceivePolicyCreateBulk struct {
+	config
+	builders []*ReceivePolicyCreate
+}
+
+// Save creates the ReceivePolicy entities in the database.
+func (rpcb *ReceivePolicyCreateBulk) Save(ctx context.Context) ([]*ReceivePolicy, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rpcb.builders))
+	nodes := make([]*ReceivePolicy, len(rpcb.builders))
+	mutators := make([]Mutator, len(rpcb.builders))
+	for i := range rpcb.builders {
+		func(i int, root context.Context) {
+			builder := rpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReceivePolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpcb *ReceivePolicyCreateBulk) SaveX(ctx context.Context) []*ReceivePolicy {
+	v, err := rpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpcb *ReceivePolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := rpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpcb *ReceivePolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := rpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
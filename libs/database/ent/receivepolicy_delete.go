@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
+)
+
+// ReceivePolicyDelete is the builder for deleting a ReceivePolicy entity.
+type ReceivePolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReceivePolicyMutation
+}
+
+// Where appends a list predicates to the ReceivePolicyDelete builder.
+func (rpd *ReceivePolicyDelete) Where(ps ...predicate.ReceivePolicy) *ReceivePolicyDelete {
+	rpd.mutation.Where(ps...)
+	return rpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rpd *ReceivePolicyDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rpd.hooks) == 0 {
+		affected, err = rpd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReceivePolicyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rpd.mutation = mutation
+			affected, err = rpd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rpd.hooks) - 1; i >= 0; i-- {
+			if rpd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rpd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpd *ReceivePolicyDelete) ExecX(ctx context.Context) int {
+	n, err := rpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rpd *ReceivePolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: receivepolicy.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: receivepolicy.FieldID,
+			},
+		},
+	}
+	if ps := rpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// ReceivePolicyDeleteOne is the builder for deleting a single ReceivePolicy entity.
+type ReceivePolicyDeleteOne struct {
+	rpd *ReceivePolicyDelete
+}
+
+// Exec executes the deletion query.
+func (rpdo *ReceivePolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := rpdo.rpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{receivepolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpdo *ReceivePolicyDeleteOne) ExecX(ctx context.Context) {
+	rpdo.rpd.ExecX(ctx)
+}
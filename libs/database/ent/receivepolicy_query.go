@@ -0,0 +1,672 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// ReceivePolicyQuery is the builder for querying ReceivePolicy entities.
+type ReceivePolicyQuery struct {
+	config
+	limit       *int
+	offset      *int
+	unique      *bool
+	order       []OrderFunc
+	fields      []string
+	predicates  []predicate.ReceivePolicy
+	withWallet  *WalletQuery
+	withAccount *AccountQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReceivePolicyQuery builder.
+func (rpq *ReceivePolicyQuery) Where(ps ...predicate.ReceivePolicy) *ReceivePolicyQuery {
+	rpq.predicates = append(rpq.predicates, ps...)
+	return rpq
+}
+
+// Limit adds a limit step to the query.
+func (rpq *ReceivePolicyQuery) Limit(limit int) *ReceivePolicyQuery {
+	rpq.limit = &limit
+	return rpq
+}
+
+// Offset adds an offset step to the query.
+func (rpq *ReceivePolicyQuery) Offset(offset int) *ReceivePolicyQuery {
+	rpq.offset = &offset
+	return rpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rpq *ReceivePolicyQuery) Unique(unique bool) *ReceivePolicyQuery {
+	rpq.unique = &unique
+	return rpq
+}
+
+// Order adds an order step to the query.
+func (rpq *ReceivePolicyQuery) Order(o ...OrderFunc) *ReceivePolicyQuery {
+	rpq.order = append(rpq.order, o...)
+	return rpq
+}
+
+// QueryWallet chains the current query on the "wallet" edge.
+func (rpq *ReceivePolicyQuery) QueryWallet() *WalletQuery {
+	query := &WalletQuery{config: rpq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receivepolicy.Table, receivepolicy.FieldID, selector),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, receivepolicy.WalletTable, receivepolicy.WalletColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAccount chains the current query on the "account" edge.
+func (rpq *ReceivePolicyQuery) QueryAccount() *AccountQuery {
+	query := &AccountQuery{config: rpq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receivepolicy.Table, receivepolicy.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, receivepolicy.AccountTable, receivepolicy.AccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReceivePolicy entity from the query.
+// Returns a *NotFoundError when no ReceivePolicy was found.
+func (rpq *ReceivePolicyQuery) First(ctx context.Context) (*ReceivePolicy, error) {
+	nodes, err := rpq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{receivepolicy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rpq *ReceivePolicyQuery) FirstX(ctx context.Context) *ReceivePolicy {
+	node, err := rpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReceivePolicy ID from the query.
+// Returns a *NotFoundError when no ReceivePolicy ID was found.
+func (rpq *ReceivePolicyQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rpq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{receivepolicy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rpq *ReceivePolicyQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReceivePolicy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReceivePolicy entity is found.
+// Returns a *NotFoundError when no ReceivePolicy entities are found.
+func (rpq *ReceivePolicyQuery) Only(ctx context.Context) (*ReceivePolicy, error) {
+	nodes, err := rpq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{receivepolicy.Label}
+	default:
+		return nil, &NotSingularError{receivepolicy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rpq *ReceivePolicyQuery) OnlyX(ctx context.Context) *ReceivePolicy {
+	node, err := rpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReceivePolicy ID in the query.
+// Returns a *NotSingularError when more than one ReceivePolicy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rpq *ReceivePolicyQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rpq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{receivepolicy.Label}
+	default:
+		err = &NotSingularError{receivepolicy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rpq *ReceivePolicyQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReceivePolicies.
+func (rpq *ReceivePolicyQuery) All(ctx context.Context) ([]*ReceivePolicy, error) {
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return rpq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rpq *ReceivePolicyQuery) AllX(ctx context.Context) []*ReceivePolicy {
+	nodes, err := rpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReceivePolicy IDs.
+func (rpq *ReceivePolicyQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := rpq.Select(receivepolicy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rpq *ReceivePolicyQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rpq *ReceivePolicyQuery) Count(ctx context.Context) (int, error) {
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return rpq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rpq *ReceivePolicyQuery) CountX(ctx context.Context) int {
+	count, err := rpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rpq *ReceivePolicyQuery) Exist(ctx context.Context) (bool, error) {
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return rpq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rpq *ReceivePolicyQuery) ExistX(ctx context.Context) bool {
+	exist, err := rpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReceivePolicyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rpq *ReceivePolicyQuery) Clone() *ReceivePolicyQuery {
+	if rpq == nil {
+		return nil
+	}
+	return &ReceivePolicyQuery{
+		config:      rpq.config,
+		limit:       rpq.limit,
+		offset:      rpq.offset,
+		order:       append([]OrderFunc{}, rpq.order...),
+		predicates:  append([]predicate.ReceivePolicy{}, rpq.predicates...),
+		withWallet:  rpq.withWallet.Clone(),
+		withAccount: rpq.withAccount.Clone(),
+		// clone intermediate query.
+		sql:    rpq.sql.Clone(),
+		path:   rpq.path,
+		unique: rpq.unique,
+	}
+}
+
+// WithWallet tells the query-builder to eager-load the nodes that are connected to
+// the "wallet" edge. The optional arguments are used to configure the query builder of the edge.
+func (rpq *ReceivePolicyQuery) WithWallet(opts ...func(*WalletQuery)) *ReceivePolicyQuery {
+	query := &WalletQuery{config: rpq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rpq.withWallet = query
+	return rpq
+}
+
+// WithAccount tells the query-builder to eager-load the nodes that are connected to
+// the "account" edge. The optional arguments are used to configure the query builder of the edge.
+func (rpq *ReceivePolicyQuery) WithAccount(opts ...func(*AccountQuery)) *ReceivePolicyQuery {
+	query := &AccountQuery{config: rpq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rpq.withAccount = query
+	return rpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WalletID uuid.UUID `json:"wallet_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReceivePolicy.Query().
+//		GroupBy(receivepolicy.FieldWalletID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rpq *ReceivePolicyQuery) GroupBy(field string, fields ...string) *ReceivePolicyGroupBy {
+	grbuild := &ReceivePolicyGroupBy{config: rpq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := rpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return rpq.sqlQuery(ctx), nil
+	}
+	grbuild.label = receivepolicy.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WalletID uuid.UUID `json:"wallet_id,omitempty"`
+//	}
+//
+//	client.ReceivePolicy.Query().
+//		Select(receivepolicy.FieldWalletID).
+//		Scan(ctx, &v)
+func (rpq *ReceivePolicyQuery) Select(fields ...string) *ReceivePolicySelect {
+	rpq.fields = append(rpq.fields, fields...)
+	selbuild := &ReceivePolicySelect{ReceivePolicyQuery: rpq}
+	selbuild.label = receivepolicy.Label
+	selbuild.flds, selbuild.scan = &rpq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (rpq *ReceivePolicyQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range rpq.fields {
+		if !receivepolicy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rpq.path != nil {
+		prev, err := rpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rpq.sql = prev
+	}
+	return nil
+}
+
+func (rpq *ReceivePolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReceivePolicy, error) {
+	var (
+		nodes       = []*ReceivePolicy{}
+		_spec       = rpq.querySpec()
+		loadedTypes = [2]bool{
+			rpq.withWallet != nil,
+			rpq.withAccount != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ReceivePolicy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ReceivePolicy{config: rpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rpq.withWallet; query != nil {
+		if err := rpq.loadWallet(ctx, query, nodes, nil,
+			func(n *ReceivePolicy, e *Wallet) { n.Edges.Wallet = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rpq.withAccount; query != nil {
+		if err := rpq.loadAccount(ctx, query, nodes, nil,
+			func(n *ReceivePolicy, e *Account) { n.Edges.Account = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rpq *ReceivePolicyQuery) loadWallet(ctx context.Context, query *WalletQuery, nodes []*ReceivePolicy, init func(*ReceivePolicy), assign func(*ReceivePolicy, *Wallet)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ReceivePolicy)
+	for i := range nodes {
+		fk := nodes[i].WalletID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(wallet.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rpq *ReceivePolicyQuery) loadAccount(ctx context.Context, query *AccountQuery, nodes []*ReceivePolicy, init func(*ReceivePolicy), assign func(*ReceivePolicy, *Account)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ReceivePolicy)
+	for i := range nodes {
+		if nodes[i].AccountID == nil {
+			continue
+		}
+		fk := *nodes[i].AccountID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "account_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rpq *ReceivePolicyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rpq.querySpec()
+	_spec.Node.Columns = rpq.fields
+	if len(rpq.fields) > 0 {
+		_spec.Unique = rpq.unique != nil && *rpq.unique
+	}
+	return sqlgraph.CountNodes(ctx, rpq.driver, _spec)
+}
+
+func (rpq *ReceivePolicyQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := rpq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (rpq *ReceivePolicyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   receivepolicy.Table,
+			Columns: receivepolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: receivepolicy.FieldID,
+			},
+		},
+		From:   rpq.sql,
+		Unique: true,
+	}
+	if unique := rpq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := rpq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, receivepolicy.FieldID)
+		for i := range fields {
+			if fields[i] != receivepolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rpq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rpq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rpq *ReceivePolicyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rpq.driver.Dialect())
+	t1 := builder.Table(receivepolicy.Table)
+	columns := rpq.fields
+	if len(columns) == 0 {
+		columns = receivepolicy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rpq.sql != nil {
+		selector = rpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rpq.unique != nil && *rpq.unique {
+		selector.Distinct()
+	}
+	for _, p := range rpq.predicates {
+		p(selector)
+	}
+	for _, p := range rpq.order {
+		p(selector)
+	}
+	if offset := rpq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rpq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ReceivePolicyGroupBy is the group-by builder for ReceivePolicy entities.
+type ReceivePolicyGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rpgb *ReceivePolicyGroupBy) Aggregate(fns ...AggregateFunc) *ReceivePolicyGroupBy {
+	rpgb.fns = append(rpgb.fns, fns...)
+	return rpgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (rpgb *ReceivePolicyGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := rpgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	rpgb.sql = query
+	return rpgb.sqlScan(ctx, v)
+}
+
+func (rpgb *ReceivePolicyGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range rpgb.fields {
+		if !receivepolicy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := rpgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rpgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rpgb *ReceivePolicyGroupBy) sqlQuery() *sql.Selector {
+	selector := rpgb.sql.Select()
+	aggregation := make([]string, 0, len(rpgb.fns))
+	for _, fn := range rpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(rpgb.fields)+len(rpgb.fns))
+		for _, f := range rpgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(rpgb.fields...)...)
+}
+
+// ReceivePolicySelect is the builder for selecting fields of ReceivePolicy entities.
+type ReceivePolicySelect struct {
+	*ReceivePolicyQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rps *ReceivePolicySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := rps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	rps.sql = rps.ReceivePolicyQuery.sqlQuery(ctx)
+	return rps.sqlScan(ctx, v)
+}
+
+func (rps *ReceivePolicySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := rps.sql.Query()
+	if err := rps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,735 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// ReceivePolicyUpdate is the builder for updating ReceivePolicy entities.
+type ReceivePolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReceivePolicyMutation
+}
+
+// Where appends a list predicates to the ReceivePolicyUpdate builder.
+func (rpu *ReceivePolicyUpdate) Where(ps ...predicate.ReceivePolicy) *ReceivePolicyUpdate {
+	rpu.mutation.Where(ps...)
+	return rpu
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (rpu *ReceivePolicyUpdate) SetWalletID(u uuid.UUID) *ReceivePolicyUpdate {
+	rpu.mutation.SetWalletID(u)
+	return rpu
+}
+
+// SetAccountID sets the "account_id" field.
+func (rpu *ReceivePolicyUpdate) SetAccountID(u uuid.UUID) *ReceivePolicyUpdate {
+	rpu.mutation.SetAccountID(u)
+	return rpu
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (rpu *ReceivePolicyUpdate) SetNillableAccountID(u *uuid.UUID) *ReceivePolicyUpdate {
+	if u != nil {
+		rpu.SetAccountID(*u)
+	}
+	return rpu
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (rpu *ReceivePolicyUpdate) ClearAccountID() *ReceivePolicyUpdate {
+	rpu.mutation.ClearAccountID()
+	return rpu
+}
+
+// SetPolicy sets the "policy" field.
+func (rpu *ReceivePolicyUpdate) SetPolicy(s string) *ReceivePolicyUpdate {
+	rpu.mutation.SetPolicy(s)
+	return rpu
+}
+
+// SetAllowlist sets the "allowlist" field.
+func (rpu *ReceivePolicyUpdate) SetAllowlist(s []string) *ReceivePolicyUpdate {
+	rpu.mutation.SetAllowlist(s)
+	return rpu
+}
+
+// ClearAllowlist clears the value of the "allowlist" field.
+func (rpu *ReceivePolicyUpdate) ClearAllowlist() *ReceivePolicyUpdate {
+	rpu.mutation.ClearAllowlist()
+	return rpu
+}
+
+// SetBatchWindow sets the "batch_window" field.
+func (rpu *ReceivePolicyUpdate) SetBatchWindow(i int) *ReceivePolicyUpdate {
+	rpu.mutation.ResetBatchWindow()
+	rpu.mutation.SetBatchWindow(i)
+	return rpu
+}
+
+// SetNillableBatchWindow sets the "batch_window" field if the given value is not nil.
+func (rpu *ReceivePolicyUpdate) SetNillableBatchWindow(i *int) *ReceivePolicyUpdate {
+	if i != nil {
+		rpu.SetBatchWindow(*i)
+	}
+	return rpu
+}
+
+// AddBatchWindow adds i to the "batch_window" field.
+func (rpu *ReceivePolicyUpdate) AddBatchWindow(i int) *ReceivePolicyUpdate {
+	rpu.mutation.AddBatchWindow(i)
+	return rpu
+}
+
+// SetLastBatchAt sets the "last_batch_at" field.
+func (rpu *ReceivePolicyUpdate) SetLastBatchAt(t time.Time) *ReceivePolicyUpdate {
+	rpu.mutation.SetLastBatchAt(t)
+	return rpu
+}
+
+// SetNillableLastBatchAt sets the "last_batch_at" field if the given value is not nil.
+func (rpu *ReceivePolicyUpdate) SetNillableLastBatchAt(t *time.Time) *ReceivePolicyUpdate {
+	if t != nil {
+		rpu.SetLastBatchAt(*t)
+	}
+	return rpu
+}
+
+// ClearLastBatchAt clears the value of the "last_batch_at" field.
+func (rpu *ReceivePolicyUpdate) ClearLastBatchAt() *ReceivePolicyUpdate {
+	rpu.mutation.ClearLastBatchAt()
+	return rpu
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (rpu *ReceivePolicyUpdate) SetWallet(w *Wallet) *ReceivePolicyUpdate {
+	return rpu.SetWalletID(w.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (rpu *ReceivePolicyUpdate) SetAccount(a *Account) *ReceivePolicyUpdate {
+	return rpu.SetAccountID(a.ID)
+}
+
+// Mutation returns the ReceivePolicyMutation object of the builder.
+func (rpu *ReceivePolicyUpdate) Mutation() *ReceivePolicyMutation {
+	return rpu.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (rpu *ReceivePolicyUpdate) ClearWallet() *ReceivePolicyUpdate {
+	rpu.mutation.ClearWallet()
+	return rpu
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (rpu *ReceivePolicyUpdate) ClearAccount() *ReceivePolicyUpdate {
+	rpu.mutation.ClearAccount()
+	return rpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rpu *ReceivePolicyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rpu.hooks) == 0 {
+		if err = rpu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = rpu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReceivePolicyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rpu.check(); err != nil {
+				return 0, err
+			}
+			rpu.mutation = mutation
+			affected, err = rpu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rpu.hooks) - 1; i >= 0; i-- {
+			if rpu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rpu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpu *ReceivePolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := rpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rpu *ReceivePolicyUpdate) Exec(ctx context.Context) error {
+	_, err := rpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpu *ReceivePolicyUpdate) ExecX(ctx context.Context) {
+	if err := rpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpu *ReceivePolicyUpdate) check() error {
+	if v, ok := rpu.mutation.Policy(); ok {
+		if err := receivepolicy.PolicyValidator(v); err != nil {
+			return &ValidationError{Name: "policy", err: fmt.Errorf(`ent: validator failed for field "ReceivePolicy.policy": %w`, err)}
+		}
+	}
+	if _, ok := rpu.mutation.WalletID(); rpu.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ReceivePolicy.wallet"`)
+	}
+	return nil
+}
+
+func (rpu *ReceivePolicyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   receivepolicy.Table,
+			Columns: receivepolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: receivepolicy.FieldID,
+			},
+		},
+	}
+	if ps := rpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpu.mutation.Policy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: receivepolicy.FieldPolicy,
+		})
+	}
+	if value, ok := rpu.mutation.Allowlist(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: receivepolicy.FieldAllowlist,
+		})
+	}
+	if rpu.mutation.AllowlistCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: receivepolicy.FieldAllowlist,
+		})
+	}
+	if value, ok := rpu.mutation.BatchWindow(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: receivepolicy.FieldBatchWindow,
+		})
+	}
+	if value, ok := rpu.mutation.AddedBatchWindow(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: receivepolicy.FieldBatchWindow,
+		})
+	}
+	if value, ok := rpu.mutation.LastBatchAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: receivepolicy.FieldLastBatchAt,
+		})
+	}
+	if rpu.mutation.LastBatchAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: receivepolicy.FieldLastBatchAt,
+		})
+	}
+	if rpu.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receivepolicy.WalletTable,
+			Columns: []string{receivepolicy.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpu.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receivepolicy.WalletTable,
+			Columns: []string{receivepolicy.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rpu.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   receivepolicy.AccountTable,
+			Columns: []string{receivepolicy.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpu.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   receivepolicy.AccountTable,
+			Columns: []string{receivepolicy.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{receivepolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ReceivePolicyUpdateOne is the builder for updating a single ReceivePolicy entity.
+type ReceivePolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReceivePolicyMutation
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (rpuo *ReceivePolicyUpdateOne) SetWalletID(u uuid.UUID) *ReceivePolicyUpdateOne {
+	rpuo.mutation.SetWalletID(u)
+	return rpuo
+}
+
+// SetAccountID sets the "account_id" field.
+func (rpuo *ReceivePolicyUpdateOne) SetAccountID(u uuid.UUID) *ReceivePolicyUpdateOne {
+	rpuo.mutation.SetAccountID(u)
+	return rpuo
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (rpuo *ReceivePolicyUpdateOne) SetNillableAccountID(u *uuid.UUID) *ReceivePolicyUpdateOne {
+	if u != nil {
+		rpuo.SetAccountID(*u)
+	}
+	return rpuo
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (rpuo *ReceivePolicyUpdateOne) ClearAccountID() *ReceivePolicyUpdateOne {
+	rpuo.mutation.ClearAccountID()
+	return rpuo
+}
+
+// SetPolicy sets the "policy" field.
+func (rpuo *ReceivePolicyUpdateOne) SetPolicy(s string) *ReceivePolicyUpdateOne {
+	rpuo.mutation.SetPolicy(s)
+	return rpuo
+}
+
+// SetAllowlist sets the "allowlist" field.
+func (rpuo *ReceivePolicyUpdateOne) SetAllowlist(s []string) *ReceivePolicyUpdateOne {
+	rpuo.mutation.SetAllowlist(s)
+	return rpuo
+}
+
+// ClearAllowlist clears the value of the "allowlist" field.
+func (rpuo *ReceivePolicyUpdateOne) ClearAllowlist() *ReceivePolicyUpdateOne {
+	rpuo.mutation.ClearAllowlist()
+	return rpuo
+}
+
+// SetBatchWindow sets the "batch_window" field.
+func (rpuo *ReceivePolicyUpdateOne) SetBatchWindow(i int) *ReceivePolicyUpdateOne {
+	rpuo.mutation.ResetBatchWindow()
+	rpuo.mutation.SetBatchWindow(i)
+	return rpuo
+}
+
+// SetNillableBatchWindow sets the "batch_window" field if the given value is not nil.
+func (rpuo *ReceivePolicyUpdateOne) SetNillableBatchWindow(i *int) *ReceivePolicyUpdateOne {
+	if i != nil {
+		rpuo.SetBatchWindow(*i)
+	}
+	return rpuo
+}
+
+// AddBatchWindow adds i to the "batch_window" field.
+func (rpuo *ReceivePolicyUpdateOne) AddBatchWindow(i int) *ReceivePolicyUpdateOne {
+	rpuo.mutation.AddBatchWindow(i)
+	return rpuo
+}
+
+// SetLastBatchAt sets the "last_batch_at" field.
+func (rpuo *ReceivePolicyUpdateOne) SetLastBatchAt(t time.Time) *ReceivePolicyUpdateOne {
+	rpuo.mutation.SetLastBatchAt(t)
+	return rpuo
+}
+
+// SetNillableLastBatchAt sets the "last_batch_at" field if the given value is not nil.
+func (rpuo *ReceivePolicyUpdateOne) SetNillableLastBatchAt(t *time.Time) *ReceivePolicyUpdateOne {
+	if t != nil {
+		rpuo.SetLastBatchAt(*t)
+	}
+	return rpuo
+}
+
+// ClearLastBatchAt clears the value of the "last_batch_at" field.
+func (rpuo *ReceivePolicyUpdateOne) ClearLastBatchAt() *ReceivePolicyUpdateOne {
+	rpuo.mutation.ClearLastBatchAt()
+	return rpuo
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (rpuo *ReceivePolicyUpdateOne) SetWallet(w *Wallet) *ReceivePolicyUpdateOne {
+	return rpuo.SetWalletID(w.ID)
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (rpuo *ReceivePolicyUpdateOne) SetAccount(a *Account) *ReceivePolicyUpdateOne {
+	return rpuo.SetAccountID(a.ID)
+}
+
+// Mutation returns the ReceivePolicyMutation object of the builder.
+func (rpuo *ReceivePolicyUpdateOne) Mutation() *ReceivePolicyMutation {
+	return rpuo.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (rpuo *ReceivePolicyUpdateOne) ClearWallet() *ReceivePolicyUpdateOne {
+	rpuo.mutation.ClearWallet()
+	return rpuo
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (rpuo *ReceivePolicyUpdateOne) ClearAccount() *ReceivePolicyUpdateOne {
+	rpuo.mutation.ClearAccount()
+	return rpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rpuo *ReceivePolicyUpdateOne) Select(field string, fields ...string) *ReceivePolicyUpdateOne {
+	rpuo.fields = append([]string{field}, fields...)
+	return rpuo
+}
+
+// Save executes the query and returns the updated ReceivePolicy entity.
+func (rpuo *ReceivePolicyUpdateOne) Save(ctx context.Context) (*ReceivePolicy, error) {
+	var (
+		err  error
+		node *ReceivePolicy
+	)
+	if len(rpuo.hooks) == 0 {
+		if err = rpuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = rpuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ReceivePolicyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rpuo.check(); err != nil {
+				return nil, err
+			}
+			rpuo.mutation = mutation
+			node, err = rpuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rpuo.hooks) - 1; i >= 0; i-- {
+			if rpuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rpuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ReceivePolicy)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ReceivePolicyMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpuo *ReceivePolicyUpdateOne) SaveX(ctx context.Context) *ReceivePolicy {
+	node, err := rpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rpuo *ReceivePolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := rpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpuo *ReceivePolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := rpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpuo *ReceivePolicyUpdateOne) check() error {
+	if v, ok := rpuo.mutation.Policy(); ok {
+		if err := receivepolicy.PolicyValidator(v); err != nil {
+			return &ValidationError{Name: "policy", err: fmt.Errorf(`ent: validator failed for field "ReceivePolicy.policy": %w`, err)}
+		}
+	}
+	if _, ok := rpuo.mutation.WalletID(); rpuo.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ReceivePolicy.wallet"`)
+	}
+	return nil
+}
+
+func (rpuo *ReceivePolicyUpdateOne) sqlSave(ctx context.Context) (_node *ReceivePolicy, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   receivepolicy.Table,
+			Columns: receivepolicy.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: receivepolicy.FieldID,
+			},
+		},
+	}
+	id, ok := rpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReceivePolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, receivepolicy.FieldID)
+		for _, f := range fields {
+			if !receivepolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != receivepolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpuo.mutation.Policy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: receivepolicy.FieldPolicy,
+		})
+	}
+	if value, ok := rpuo.mutation.Allowlist(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: receivepolicy.FieldAllowlist,
+		})
+	}
+	if rpuo.mutation.AllowlistCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: receivepolicy.FieldAllowlist,
+		})
+	}
+	if value, ok := rpuo.mutation.BatchWindow(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: receivepolicy.FieldBatchWindow,
+		})
+	}
+	if value, ok := rpuo.mutation.AddedBatchWindow(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: receivepolicy.FieldBatchWindow,
+		})
+	}
+	if value, ok := rpuo.mutation.LastBatchAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: receivepolicy.FieldLastBatchAt,
+		})
+	}
+	if rpuo.mutation.LastBatchAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: receivepolicy.FieldLastBatchAt,
+		})
+	}
+	if rpuo.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receivepolicy.WalletTable,
+			Columns: []string{receivepolicy.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpuo.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receivepolicy.WalletTable,
+			Columns: []string{receivepolicy.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rpuo.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   receivepolicy.AccountTable,
+			Columns: []string{receivepolicy.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rpuo.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   receivepolicy.AccountTable,
+			Columns: []string{receivepolicy.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReceivePolicy{config: rpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{receivepolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
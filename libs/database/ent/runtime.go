@@ -7,8 +7,12 @@ import (
 
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/schema"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhook"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdeadletter"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdelivery"
 	"github.com/google/uuid"
 )
 
@@ -30,8 +34,12 @@ func init() {
 	accountDescWork := accountFields[5].Descriptor()
 	// account.DefaultWork holds the default value on creation for the work field.
 	account.DefaultWork = accountDescWork.Default.(bool)
+	// accountDescNeedsResync is the schema descriptor for needs_resync field.
+	accountDescNeedsResync := accountFields[6].Descriptor()
+	// account.DefaultNeedsResync holds the default value on creation for the needs_resync field.
+	account.DefaultNeedsResync = accountDescNeedsResync.Default.(bool)
 	// accountDescCreatedAt is the schema descriptor for created_at field.
-	accountDescCreatedAt := accountFields[6].Descriptor()
+	accountDescCreatedAt := accountFields[7].Descriptor()
 	// account.DefaultCreatedAt holds the default value on creation for the created_at field.
 	account.DefaultCreatedAt = accountDescCreatedAt.Default.(func() time.Time)
 	// accountDescID is the schema descriptor for id field.
@@ -60,6 +68,24 @@ func init() {
 	blockDescID := blockFields[0].Descriptor()
 	// block.DefaultID holds the default value on creation for the id field.
 	block.DefaultID = blockDescID.Default.(func() uuid.UUID)
+	receivepolicyFields := schema.ReceivePolicy{}.Fields()
+	_ = receivepolicyFields
+	// receivepolicyDescPolicy is the schema descriptor for policy field.
+	receivepolicyDescPolicy := receivepolicyFields[3].Descriptor()
+	// receivepolicy.PolicyValidator is a validator for the "policy" field. It is called by the builders before save.
+	receivepolicy.PolicyValidator = receivepolicyDescPolicy.Validators[0].(func(string) error)
+	// receivepolicyDescBatchWindow is the schema descriptor for batch_window field.
+	receivepolicyDescBatchWindow := receivepolicyFields[5].Descriptor()
+	// receivepolicy.DefaultBatchWindow holds the default value on creation for the batch_window field.
+	receivepolicy.DefaultBatchWindow = receivepolicyDescBatchWindow.Default.(int)
+	// receivepolicyDescCreatedAt is the schema descriptor for created_at field.
+	receivepolicyDescCreatedAt := receivepolicyFields[7].Descriptor()
+	// receivepolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	receivepolicy.DefaultCreatedAt = receivepolicyDescCreatedAt.Default.(func() time.Time)
+	// receivepolicyDescID is the schema descriptor for id field.
+	receivepolicyDescID := receivepolicyFields[0].Descriptor()
+	// receivepolicy.DefaultID holds the default value on creation for the id field.
+	receivepolicy.DefaultID = receivepolicyDescID.Default.(func() uuid.UUID)
 	walletFields := schema.Wallet{}.Fields()
 	_ = walletFields
 	// walletDescSeed is the schema descriptor for seed field.
@@ -86,4 +112,66 @@ func init() {
 	walletDescID := walletFields[0].Descriptor()
 	// wallet.DefaultID holds the default value on creation for the id field.
 	wallet.DefaultID = walletDescID.Default.(func() uuid.UUID)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[2].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[3].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[5].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookFields[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() uuid.UUID)
+	webhookdeadletterFields := schema.WebhookDeadLetter{}.Fields()
+	_ = webhookdeadletterFields
+	// webhookdeadletterDescEvent is the schema descriptor for event field.
+	webhookdeadletterDescEvent := webhookdeadletterFields[2].Descriptor()
+	// webhookdeadletter.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	webhookdeadletter.EventValidator = webhookdeadletterDescEvent.Validators[0].(func(string) error)
+	// webhookdeadletterDescLastError is the schema descriptor for last_error field.
+	webhookdeadletterDescLastError := webhookdeadletterFields[5].Descriptor()
+	// webhookdeadletter.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	webhookdeadletter.LastErrorValidator = webhookdeadletterDescLastError.Validators[0].(func(string) error)
+	// webhookdeadletterDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeadletterDescCreatedAt := webhookdeadletterFields[6].Descriptor()
+	// webhookdeadletter.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdeadletter.DefaultCreatedAt = webhookdeadletterDescCreatedAt.Default.(func() time.Time)
+	// webhookdeadletterDescID is the schema descriptor for id field.
+	webhookdeadletterDescID := webhookdeadletterFields[0].Descriptor()
+	// webhookdeadletter.DefaultID holds the default value on creation for the id field.
+	webhookdeadletter.DefaultID = webhookdeadletterDescID.Default.(func() uuid.UUID)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescEvent is the schema descriptor for event field.
+	webhookdeliveryDescEvent := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	webhookdelivery.EventValidator = webhookdeliveryDescEvent.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	webhookdelivery.LastErrorValidator = webhookdeliveryDescLastError.Validators[0].(func(string) error)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
 }
@@ -34,6 +34,8 @@ func (Account) Fields() []ent.Field {
 		field.Int("account_index").Nillable().Optional(),
 		field.String("private_key").MaxLen(512).Nillable().Optional(),
 		field.Bool("work").Default(true),
+		// Set when the node's view of the account diverged from what we published, cleared by account_resync
+		field.Bool("needs_resync").Default(false),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
 }
@@ -50,6 +52,11 @@ func (Account) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
 			}),
+		edge.To("receive_policy", ReceivePolicy.Type).
+			Unique().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
 	}
 }
 
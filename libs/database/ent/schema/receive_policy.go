@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ReceivePolicy holds the schema definition for the ReceivePolicy entity.
+type ReceivePolicy struct {
+	ent.Schema
+}
+
+// Annotations of the ReceivePolicy.
+func (ReceivePolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "receive_policies"},
+	}
+}
+
+// Fields of the ReceivePolicy.
+func (ReceivePolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("wallet_id", uuid.UUID{}),
+		// Set for an account's own policy, which overrides the wallet's
+		field.UUID("account_id", uuid.UUID{}).Nillable().Optional().Unique(),
+		// enabled, disabled, allowlist or deferred
+		field.String("policy").MaxLen(16),
+		// Senders received from with the allowlist policy
+		field.JSON("allowlist", []string{}).Optional(),
+		// Seconds between batches with the deferred policy
+		field.Int("batch_window").Default(3600),
+		field.Time("last_batch_at").Nillable().Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ReceivePolicy.
+func (ReceivePolicy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("wallet", Wallet.Type).
+			Ref("receive_policies").
+			Field("wallet_id").
+			Required().
+			Unique(),
+		edge.From("account", Account.Type).
+			Ref("receive_policy").
+			Field("account_id").
+			Unique(),
+	}
+}
+
+// Indexes of the ReceivePolicy.
+func (ReceivePolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("wallet_id"),
+	}
+}
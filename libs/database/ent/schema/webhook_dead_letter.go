@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WebhookDeadLetter holds the schema definition for the WebhookDeadLetter entity.
+// Deliveries that ran out of attempts end up here
+type WebhookDeadLetter struct {
+	ent.Schema
+}
+
+// Annotations of the WebhookDeadLetter.
+func (WebhookDeadLetter) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "webhook_dead_letters"},
+	}
+}
+
+// Fields of the WebhookDeadLetter.
+func (WebhookDeadLetter) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("webhook_id", uuid.UUID{}),
+		field.String("event").MaxLen(64).Immutable(),
+		field.Text("payload").Immutable(),
+		field.Int("attempts").Immutable(),
+		field.String("last_error").MaxLen(1024).Nillable().Optional().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the WebhookDeadLetter.
+func (WebhookDeadLetter) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("dead_letters").
+			Field("webhook_id").
+			Required().
+			Unique(),
+	}
+}
@@ -16,8 +16,16 @@ type Tx struct {
 	Account *AccountClient
 	// Block is the client for interacting with the Block builders.
 	Block *BlockClient
+	// ReceivePolicy is the client for interacting with the ReceivePolicy builders.
+	ReceivePolicy *ReceivePolicyClient
 	// Wallet is the client for interacting with the Wallet builders.
 	Wallet *WalletClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDeadLetter is the client for interacting with the WebhookDeadLetter builders.
+	WebhookDeadLetter *WebhookDeadLetterClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -155,7 +163,11 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.Account = NewAccountClient(tx.config)
 	tx.Block = NewBlockClient(tx.config)
+	tx.ReceivePolicy = NewReceivePolicyClient(tx.config)
 	tx.Wallet = NewWalletClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDeadLetter = NewWebhookDeadLetterClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhook"
 	"github.com/google/uuid"
 )
 
@@ -36,9 +37,13 @@ type Wallet struct {
 type WalletEdges struct {
 	// Accounts holds the value of the accounts edge.
 	Accounts []*Account `json:"accounts,omitempty"`
+	// Webhook holds the value of the webhook edge.
+	Webhook *Webhook `json:"webhook,omitempty"`
+	// ReceivePolicies holds the value of the receive_policies edge.
+	ReceivePolicies []*ReceivePolicy `json:"receive_policies,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // AccountsOrErr returns the Accounts value or an error if the edge
@@ -50,6 +55,28 @@ func (e WalletEdges) AccountsOrErr() ([]*Account, error) {
 	return nil, &NotLoadedError{edge: "accounts"}
 }
 
+// WebhookOrErr returns the Webhook value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WalletEdges) WebhookOrErr() (*Webhook, error) {
+	if e.loadedTypes[1] {
+		if e.Webhook == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: webhook.Label}
+		}
+		return e.Webhook, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook"}
+}
+
+// ReceivePoliciesOrErr returns the ReceivePolicies value or an error if the edge
+// was not loaded in eager-loading.
+func (e WalletEdges) ReceivePoliciesOrErr() ([]*ReceivePolicy, error) {
+	if e.loadedTypes[2] {
+		return e.ReceivePolicies, nil
+	}
+	return nil, &NotLoadedError{edge: "receive_policies"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Wallet) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
@@ -125,6 +152,16 @@ func (w *Wallet) QueryAccounts() *AccountQuery {
 	return (&WalletClient{config: w.config}).QueryAccounts(w)
 }
 
+// QueryWebhook queries the "webhook" edge of the Wallet entity.
+func (w *Wallet) QueryWebhook() *WebhookQuery {
+	return (&WalletClient{config: w.config}).QueryWebhook(w)
+}
+
+// QueryReceivePolicies queries the "receive_policies" edge of the Wallet entity.
+func (w *Wallet) QueryReceivePolicies() *ReceivePolicyQuery {
+	return (&WalletClient{config: w.config}).QueryReceivePolicies(w)
+}
+
 // Update returns a builder for updating this Wallet.
 // Note that you need to call Wallet.Unwrap() before calling this method if this Wallet
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -25,6 +25,10 @@ const (
 	FieldCreatedAt = "created_at"
 	// EdgeAccounts holds the string denoting the accounts edge name in mutations.
 	EdgeAccounts = "accounts"
+	// EdgeWebhook holds the string denoting the webhook edge name in mutations.
+	EdgeWebhook = "webhook"
+	// EdgeReceivePolicies holds the string denoting the receive_policies edge name in mutations.
+	EdgeReceivePolicies = "receive_policies"
 	// Table holds the table name of the wallet in the database.
 	Table = "wallets"
 	// AccountsTable is the table that holds the accounts relation/edge.
@@ -34,6 +38,20 @@ const (
 	AccountsInverseTable = "accounts"
 	// AccountsColumn is the table column denoting the accounts relation/edge.
 	AccountsColumn = "wallet_id"
+	// WebhookTable is the table that holds the webhook relation/edge.
+	WebhookTable = "webhooks"
+	// WebhookInverseTable is the table name for the Webhook entity.
+	// It exists in this package in order to avoid circular dependency with the "webhook" package.
+	WebhookInverseTable = "webhooks"
+	// WebhookColumn is the table column denoting the webhook relation/edge.
+	WebhookColumn = "wallet_id"
+	// ReceivePoliciesTable is the table that holds the receive_policies relation/edge.
+	ReceivePoliciesTable = "receive_policies"
+	// ReceivePoliciesInverseTable is the table name for the ReceivePolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "receivepolicy" package.
+	ReceivePoliciesInverseTable = "receive_policies"
+	// ReceivePoliciesColumn is the table column denoting the receive_policies relation/edge.
+	ReceivePoliciesColumn = "wallet_id"
 )
 
 // Columns holds all SQL columns for wallet fields.
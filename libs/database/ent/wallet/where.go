@@ -449,6 +449,62 @@ func HasAccountsWith(preds ...predicate.Account) predicate.Wallet {
 	})
 }
 
+// HasWebhook applies the HasEdge predicate on the "webhook" edge.
+func HasWebhook() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WebhookTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, WebhookTable, WebhookColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhookWith applies the HasEdge predicate on the "webhook" edge with a given conditions (other predicates).
+func HasWebhookWith(preds ...predicate.Webhook) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WebhookInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, WebhookTable, WebhookColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReceivePolicies applies the HasEdge predicate on the "receive_policies" edge.
+func HasReceivePolicies() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ReceivePoliciesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReceivePoliciesTable, ReceivePoliciesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceivePoliciesWith applies the HasEdge predicate on the "receive_policies" edge with a given conditions (other predicates).
+func HasReceivePoliciesWith(preds ...predicate.ReceivePolicy) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ReceivePoliciesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReceivePoliciesTable, ReceivePoliciesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Wallet) predicate.Wallet {
 	return predicate.Wallet(func(s *sql.Selector) {
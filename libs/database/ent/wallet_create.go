@@ -11,7 +11,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhook"
 	"github.com/google/uuid"
 )
 
@@ -113,6 +115,40 @@ func (wc *WalletCreate) AddAccounts(a ...*Account) *WalletCreate {
 	return wc.AddAccountIDs(ids...)
 }
 
+// SetWebhookID sets the "webhook" edge to the Webhook entity by ID.
+func (wc *WalletCreate) SetWebhookID(id uuid.UUID) *WalletCreate {
+	wc.mutation.SetWebhookID(id)
+	return wc
+}
+
+// SetNillableWebhookID sets the "webhook" edge to the Webhook entity by ID if the given value is not nil.
+func (wc *WalletCreate) SetNillableWebhookID(id *uuid.UUID) *WalletCreate {
+	if id != nil {
+		wc = wc.SetWebhookID(*id)
+	}
+	return wc
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wc *WalletCreate) SetWebhook(w *Webhook) *WalletCreate {
+	return wc.SetWebhookID(w.ID)
+}
+
+// AddReceivePolicyIDs adds the "receive_policies" edge to the ReceivePolicy entity by IDs.
+func (wc *WalletCreate) AddReceivePolicyIDs(ids ...uuid.UUID) *WalletCreate {
+	wc.mutation.AddReceivePolicyIDs(ids...)
+	return wc
+}
+
+// AddReceivePolicies adds the "receive_policies" edges to the ReceivePolicy entity.
+func (wc *WalletCreate) AddReceivePolicies(r ...*ReceivePolicy) *WalletCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return wc.AddReceivePolicyIDs(ids...)
+}
+
 // Mutation returns the WalletMutation object of the builder.
 func (wc *WalletCreate) Mutation() *WalletMutation {
 	return wc.mutation
@@ -327,6 +363,44 @@ func (wc *WalletCreate) createSpec() (*Wallet, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := wc.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   wallet.WebhookTable,
+			Columns: []string{wallet.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.ReceivePoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.ReceivePoliciesTable,
+			Columns: []string{wallet.ReceivePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: receivepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
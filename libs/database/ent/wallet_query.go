@@ -13,20 +13,24 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhook"
 	"github.com/google/uuid"
 )
 
 // WalletQuery is the builder for querying Wallet entities.
 type WalletQuery struct {
 	config
-	limit        *int
-	offset       *int
-	unique       *bool
-	order        []OrderFunc
-	fields       []string
-	predicates   []predicate.Wallet
-	withAccounts *AccountQuery
+	limit               *int
+	offset              *int
+	unique              *bool
+	order               []OrderFunc
+	fields              []string
+	predicates          []predicate.Wallet
+	withAccounts        *AccountQuery
+	withWebhook         *WebhookQuery
+	withReceivePolicies *ReceivePolicyQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -85,6 +89,50 @@ func (wq *WalletQuery) QueryAccounts() *AccountQuery {
 	return query
 }
 
+// QueryWebhook chains the current query on the "webhook" edge.
+func (wq *WalletQuery) QueryWebhook() *WebhookQuery {
+	query := &WebhookQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, selector),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, wallet.WebhookTable, wallet.WebhookColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReceivePolicies chains the current query on the "receive_policies" edge.
+func (wq *WalletQuery) QueryReceivePolicies() *ReceivePolicyQuery {
+	query := &ReceivePolicyQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, selector),
+			sqlgraph.To(receivepolicy.Table, receivepolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.ReceivePoliciesTable, wallet.ReceivePoliciesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Wallet entity from the query.
 // Returns a *NotFoundError when no Wallet was found.
 func (wq *WalletQuery) First(ctx context.Context) (*Wallet, error) {
@@ -261,12 +309,14 @@ func (wq *WalletQuery) Clone() *WalletQuery {
 		return nil
 	}
 	return &WalletQuery{
-		config:       wq.config,
-		limit:        wq.limit,
-		offset:       wq.offset,
-		order:        append([]OrderFunc{}, wq.order...),
-		predicates:   append([]predicate.Wallet{}, wq.predicates...),
-		withAccounts: wq.withAccounts.Clone(),
+		config:              wq.config,
+		limit:               wq.limit,
+		offset:              wq.offset,
+		order:               append([]OrderFunc{}, wq.order...),
+		predicates:          append([]predicate.Wallet{}, wq.predicates...),
+		withAccounts:        wq.withAccounts.Clone(),
+		withWebhook:         wq.withWebhook.Clone(),
+		withReceivePolicies: wq.withReceivePolicies.Clone(),
 		// clone intermediate query.
 		sql:    wq.sql.Clone(),
 		path:   wq.path,
@@ -285,6 +335,28 @@ func (wq *WalletQuery) WithAccounts(opts ...func(*AccountQuery)) *WalletQuery {
 	return wq
 }
 
+// WithWebhook tells the query-builder to eager-load the nodes that are connected to
+// the "webhook" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WalletQuery) WithWebhook(opts ...func(*WebhookQuery)) *WalletQuery {
+	query := &WebhookQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withWebhook = query
+	return wq
+}
+
+// WithReceivePolicies tells the query-builder to eager-load the nodes that are connected to
+// the "receive_policies" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WalletQuery) WithReceivePolicies(opts ...func(*ReceivePolicyQuery)) *WalletQuery {
+	query := &ReceivePolicyQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withReceivePolicies = query
+	return wq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -353,8 +425,10 @@ func (wq *WalletQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Walle
 	var (
 		nodes       = []*Wallet{}
 		_spec       = wq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			wq.withAccounts != nil,
+			wq.withWebhook != nil,
+			wq.withReceivePolicies != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
@@ -382,6 +456,19 @@ func (wq *WalletQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Walle
 			return nil, err
 		}
 	}
+	if query := wq.withWebhook; query != nil {
+		if err := wq.loadWebhook(ctx, query, nodes, nil,
+			func(n *Wallet, e *Webhook) { n.Edges.Webhook = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wq.withReceivePolicies; query != nil {
+		if err := wq.loadReceivePolicies(ctx, query, nodes,
+			func(n *Wallet) { n.Edges.ReceivePolicies = []*ReceivePolicy{} },
+			func(n *Wallet, e *ReceivePolicy) { n.Edges.ReceivePolicies = append(n.Edges.ReceivePolicies, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -412,6 +499,57 @@ func (wq *WalletQuery) loadAccounts(ctx context.Context, query *AccountQuery, no
 	}
 	return nil
 }
+func (wq *WalletQuery) loadWebhook(ctx context.Context, query *WebhookQuery, nodes []*Wallet, init func(*Wallet), assign func(*Wallet, *Webhook)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Wallet)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.Where(predicate.Webhook(func(s *sql.Selector) {
+		s.Where(sql.InValues(wallet.WebhookColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WalletID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (wq *WalletQuery) loadReceivePolicies(ctx context.Context, query *ReceivePolicyQuery, nodes []*Wallet, init func(*Wallet), assign func(*Wallet, *ReceivePolicy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Wallet)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.ReceivePolicy(func(s *sql.Selector) {
+		s.Where(sql.InValues(wallet.ReceivePoliciesColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WalletID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (wq *WalletQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := wq.querySpec()
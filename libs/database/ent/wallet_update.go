@@ -12,7 +12,9 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhook"
 	"github.com/google/uuid"
 )
 
@@ -98,6 +100,40 @@ func (wu *WalletUpdate) AddAccounts(a ...*Account) *WalletUpdate {
 	return wu.AddAccountIDs(ids...)
 }
 
+// SetWebhookID sets the "webhook" edge to the Webhook entity by ID.
+func (wu *WalletUpdate) SetWebhookID(id uuid.UUID) *WalletUpdate {
+	wu.mutation.SetWebhookID(id)
+	return wu
+}
+
+// SetNillableWebhookID sets the "webhook" edge to the Webhook entity by ID if the given value is not nil.
+func (wu *WalletUpdate) SetNillableWebhookID(id *uuid.UUID) *WalletUpdate {
+	if id != nil {
+		wu = wu.SetWebhookID(*id)
+	}
+	return wu
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wu *WalletUpdate) SetWebhook(w *Webhook) *WalletUpdate {
+	return wu.SetWebhookID(w.ID)
+}
+
+// AddReceivePolicyIDs adds the "receive_policies" edge to the ReceivePolicy entity by IDs.
+func (wu *WalletUpdate) AddReceivePolicyIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.AddReceivePolicyIDs(ids...)
+	return wu
+}
+
+// AddReceivePolicies adds the "receive_policies" edges to the ReceivePolicy entity.
+func (wu *WalletUpdate) AddReceivePolicies(r ...*ReceivePolicy) *WalletUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return wu.AddReceivePolicyIDs(ids...)
+}
+
 // Mutation returns the WalletMutation object of the builder.
 func (wu *WalletUpdate) Mutation() *WalletMutation {
 	return wu.mutation
@@ -124,6 +160,33 @@ func (wu *WalletUpdate) RemoveAccounts(a ...*Account) *WalletUpdate {
 	return wu.RemoveAccountIDs(ids...)
 }
 
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (wu *WalletUpdate) ClearWebhook() *WalletUpdate {
+	wu.mutation.ClearWebhook()
+	return wu
+}
+
+// ClearReceivePolicies clears all "receive_policies" edges to the ReceivePolicy entity.
+func (wu *WalletUpdate) ClearReceivePolicies() *WalletUpdate {
+	wu.mutation.ClearReceivePolicies()
+	return wu
+}
+
+// RemoveReceivePolicyIDs removes the "receive_policies" edge to ReceivePolicy entities by IDs.
+func (wu *WalletUpdate) RemoveReceivePolicyIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.RemoveReceivePolicyIDs(ids...)
+	return wu
+}
+
+// RemoveReceivePolicies removes "receive_policies" edges to ReceivePolicy entities.
+func (wu *WalletUpdate) RemoveReceivePolicies(r ...*ReceivePolicy) *WalletUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return wu.RemoveReceivePolicyIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (wu *WalletUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -305,6 +368,95 @@ func (wu *WalletUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if wu.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   wallet.WebhookTable,
+			Columns: []string{wallet.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   wallet.WebhookTable,
+			Columns: []string{wallet.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wu.mutation.ReceivePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.ReceivePoliciesTable,
+			Columns: []string{wallet.ReceivePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: receivepolicy.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedReceivePoliciesIDs(); len(nodes) > 0 && !wu.mutation.ReceivePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.ReceivePoliciesTable,
+			Columns: []string{wallet.ReceivePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: receivepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.ReceivePoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.ReceivePoliciesTable,
+			Columns: []string{wallet.ReceivePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: receivepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{wallet.Label}
@@ -393,6 +545,40 @@ func (wuo *WalletUpdateOne) AddAccounts(a ...*Account) *WalletUpdateOne {
 	return wuo.AddAccountIDs(ids...)
 }
 
+// SetWebhookID sets the "webhook" edge to the Webhook entity by ID.
+func (wuo *WalletUpdateOne) SetWebhookID(id uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.SetWebhookID(id)
+	return wuo
+}
+
+// SetNillableWebhookID sets the "webhook" edge to the Webhook entity by ID if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableWebhookID(id *uuid.UUID) *WalletUpdateOne {
+	if id != nil {
+		wuo = wuo.SetWebhookID(*id)
+	}
+	return wuo
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wuo *WalletUpdateOne) SetWebhook(w *Webhook) *WalletUpdateOne {
+	return wuo.SetWebhookID(w.ID)
+}
+
+// AddReceivePolicyIDs adds the "receive_policies" edge to the ReceivePolicy entity by IDs.
+func (wuo *WalletUpdateOne) AddReceivePolicyIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.AddReceivePolicyIDs(ids...)
+	return wuo
+}
+
+// AddReceivePolicies adds the "receive_policies" edges to the ReceivePolicy entity.
+func (wuo *WalletUpdateOne) AddReceivePolicies(r ...*ReceivePolicy) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return wuo.AddReceivePolicyIDs(ids...)
+}
+
 // Mutation returns the WalletMutation object of the builder.
 func (wuo *WalletUpdateOne) Mutation() *WalletMutation {
 	return wuo.mutation
@@ -419,6 +605,33 @@ func (wuo *WalletUpdateOne) RemoveAccounts(a ...*Account) *WalletUpdateOne {
 	return wuo.RemoveAccountIDs(ids...)
 }
 
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (wuo *WalletUpdateOne) ClearWebhook() *WalletUpdateOne {
+	wuo.mutation.ClearWebhook()
+	return wuo
+}
+
+// ClearReceivePolicies clears all "receive_policies" edges to the ReceivePolicy entity.
+func (wuo *WalletUpdateOne) ClearReceivePolicies() *WalletUpdateOne {
+	wuo.mutation.ClearReceivePolicies()
+	return wuo
+}
+
+// RemoveReceivePolicyIDs removes the "receive_policies" edge to ReceivePolicy entities by IDs.
+func (wuo *WalletUpdateOne) RemoveReceivePolicyIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.RemoveReceivePolicyIDs(ids...)
+	return wuo
+}
+
+// RemoveReceivePolicies removes "receive_policies" edges to ReceivePolicy entities.
+func (wuo *WalletUpdateOne) RemoveReceivePolicies(r ...*ReceivePolicy) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return wuo.RemoveReceivePolicyIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (wuo *WalletUpdateOne) Select(field string, fields ...string) *WalletUpdateOne {
@@ -630,6 +843,95 @@ func (wuo *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if wuo.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   wallet.WebhookTable,
+			Columns: []string{wallet.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   wallet.WebhookTable,
+			Columns: []string{wallet.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wuo.mutation.ReceivePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.ReceivePoliciesTable,
+			Columns: []string{wallet.ReceivePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: receivepolicy.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedReceivePoliciesIDs(); len(nodes) > 0 && !wuo.mutation.ReceivePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.ReceivePoliciesTable,
+			Columns: []string{wallet.ReceivePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: receivepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.ReceivePoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.ReceivePoliciesTable,
+			Columns: []string{wallet.ReceivePoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: receivepolicy.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Wallet{config: wuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
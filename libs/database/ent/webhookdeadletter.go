@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhook"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdeadletter"
+	"github.com/google/uuid"
+)
+
+// WebhookDeadLetter is the model entity for the WebhookDeadLetter schema.
+type WebhookDeadLetter struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WebhookID holds the value of the "webhook_id" field.
+	WebhookID uuid.UUID `json:"webhook_id,omitempty"`
+	// Event holds the value of the "event" field.
+	Event string `json:"event,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload string `json:"payload,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError *string `json:"last_error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookDeadLetterQuery when eager-loading is set.
+	Edges WebhookDeadLetterEdges `json:"edges"`
+}
+
+// WebhookDeadLetterEdges holds the relations/edges for other nodes in the graph.
+type WebhookDeadLetterEdges struct {
+	// Webhook holds the value of the webhook edge.
+	Webhook *Webhook `json:"webhook,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WebhookOrErr returns the Webhook value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookDeadLetterEdges) WebhookOrErr() (*Webhook, error) {
+	if e.loadedTypes[0] {
+		if e.Webhook == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: webhook.Label}
+		}
+		return e.Webhook, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookDeadLetter) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookdeadletter.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case webhookdeadletter.FieldEvent, webhookdeadletter.FieldPayload, webhookdeadletter.FieldLastError:
+			values[i] = new(sql.NullString)
+		case webhookdeadletter.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case webhookdeadletter.FieldID, webhookdeadletter.FieldWebhookID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WebhookDeadLetter", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookDeadLetter fields.
+func (wdl *WebhookDeadLetter) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookdeadletter.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				wdl.ID = *value
+			}
+		case webhookdeadletter.FieldWebhookID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field webhook_id", values[i])
+			} else if value != nil {
+				wdl.WebhookID = *value
+			}
+		case webhookdeadletter.FieldEvent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event", values[i])
+			} else if value.Valid {
+				wdl.Event = value.String
+			}
+		case webhookdeadletter.FieldPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value.Valid {
+				wdl.Payload = value.String
+			}
+		case webhookdeadletter.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				wdl.Attempts = int(value.Int64)
+			}
+		case webhookdeadletter.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				wdl.LastError = new(string)
+				*wdl.LastError = value.String
+			}
+		case webhookdeadletter.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wdl.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryWebhook queries the "webhook" edge of the WebhookDeadLetter entity.
+func (wdl *WebhookDeadLetter) QueryWebhook() *WebhookQuery {
+	return (&WebhookDeadLetterClient{config: wdl.config}).QueryWebhook(wdl)
+}
+
+// Update returns a builder for updating this WebhookDeadLetter.
+// Note that you need to call WebhookDeadLetter.Unwrap() before calling this method if this WebhookDeadLetter
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wdl *WebhookDeadLetter) Update() *WebhookDeadLetterUpdateOne {
+	return (&WebhookDeadLetterClient{config: wdl.config}).UpdateOne(wdl)
+}
+
+// Unwrap unwraps the WebhookDeadLetter entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wdl *WebhookDeadLetter) Unwrap() *WebhookDeadLetter {
+	_tx, ok := wdl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebhookDeadLetter is not a transactional entity")
+	}
+	wdl.config.driver = _tx.drv
+	return wdl
+}
+
+// String implements the fmt.Stringer.
+func (wdl *WebhookDeadLetter) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookDeadLetter(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wdl.ID))
+	builder.WriteString("webhook_id=")
+	builder.WriteString(fmt.Sprintf("%v", wdl.WebhookID))
+	builder.WriteString(", ")
+	builder.WriteString("event=")
+	builder.WriteString(wdl.Event)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(wdl.Payload)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", wdl.Attempts))
+	builder.WriteString(", ")
+	if v := wdl.LastError; v != nil {
+		builder.WriteString("last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wdl.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookDeadLetters is a parsable slice of WebhookDeadLetter.
+type WebhookDeadLetters []*WebhookDeadLetter
+
+func (wdl WebhookDeadLetters) config(cfg config) {
+	for _i := range wdl {
+		wdl[_i].config = cfg
+	}
+}
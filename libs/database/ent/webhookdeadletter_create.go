@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhook"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdeadletter"
+	"github.com/google/uuid"
+)
+
+// WebhookDeadLetterCreate is the builder for creating a WebhookDeadLetter entity.
+type WebhookDeadLetterCreate struct {
+	config
+	mutation *WebhookDeadLetterMutation
+	hooks    []Hook
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdlc *WebhookDeadLetterCreate) SetWebhookID(u uuid.UUID) *WebhookDeadLetterCreate {
+	wdlc.mutation.SetWebhookID(u)
+	return wdlc
+}
+
+// SetEvent sets the "event" field.
+func (wdlc *WebhookDeadLetterCreate) SetEvent(s string) *WebhookDeadLetterCreate {
+	wdlc.mutation.SetEvent(s)
+	return wdlc
+}
+
+// SetPayload sets the "payload" field.
+func (wdlc *WebhookDeadLetterCreate) SetPayload(s string) *WebhookDeadLetterCreate {
+	wdlc.mutation.SetPayload(s)
+	return wdlc
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdlc *WebhookDeadLetterCreate) SetAttempts(i int) *WebhookDeadLetterCreate {
+	wdlc.mutation.SetAttempts(i)
+	return wdlc
+}
+
+// SetLastError sets the "last_error" field.
+func (wdlc *WebhookDeadLetterCreate) SetLastError(s string) *WebhookDeadLetterCreate {
+	wdlc.mutation.SetLastError(s)
+	return wdlc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wdlc *WebhookDeadLetterCreate) SetNillableLastError(s *string) *WebhookDeadLetterCreate {
+	if s != nil {
+		wdlc.SetLastError(*s)
+	}
+	return wdlc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wdlc *WebhookDeadLetterCreate) SetCreatedAt(t time.Time) *WebhookDeadLetterCreate {
+	wdlc.mutation.SetCreatedAt(t)
+	return wdlc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wdlc *WebhookDeadLetterCreate) SetNillableCreatedAt(t *time.Time) *WebhookDeadLetterCreate {
+	if t != nil {
+		wdlc.SetCreatedAt(*t)
+	}
+	return wdlc
+}
+
+// SetID sets the "id" field.
+func (wdlc *WebhookDeadLetterCreate) SetID(u uuid.UUID) *WebhookDeadLetterCreate {
+	wdlc.mutation.SetID(u)
+	return wdlc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wdlc *WebhookDeadLetterCreate) SetNillableID(u *uuid.UUID) *WebhookDeadLetterCreate {
+	if u != nil {
+		wdlc.SetID(*u)
+	}
+	return wdlc
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wdlc *WebhookDeadLetterCreate) SetWebhook(w *Webhook) *WebhookDeadLetterCreate {
+	return wdlc.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeadLetterMutation object of the builder.
+func (wdlc *WebhookDeadLetterCreate) Mutation() *WebhookDeadLetterMutation {
+	return wdlc.mutation
+}
+
+// Save creates the WebhookDeadLetter in the database.
+func (wdlc *WebhookDeadLetterCreate) Save(ctx context.Context) (*WebhookDeadLetter, error) {
+	var (
+		err  error
+		node *WebhookDeadLetter
+	)
+	wdlc.defaults()
+	if len(wdlc.hooks) == 0 {
+		if err = wdlc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wdlc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeadLetterMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wdlc.check(); err != nil {
+				return nil, err
+			}
+			wdlc.mutation = mutation
+			if node, err = wdlc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wdlc.hooks) - 1; i >= 0; i-- {
+			if wdlc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wdlc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wdlc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WebhookDeadLetter)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WebhookDeadLetterMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdlc *WebhookDeadLetterCreate) SaveX(ctx context.Context) *WebhookDeadLetter {
+	v, err := wdlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdlc *WebhookDeadLetterCreate) Exec(ctx context.Context) error {
+	_, err := wdlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdlc *WebhookDeadLetterCreate) ExecX(ctx context.Context) {
+	if err := wdlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdlc *WebhookDeadLetterCreate) defaults() {
+	if _, ok := wdlc.mutation.CreatedAt(); !ok {
+		v := webhookdeadletter.DefaultCreatedAt()
+		wdlc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wdlc.mutation.ID(); !ok {
+		v := webhookdeadletter.DefaultID()
+		wdlc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdlc *WebhookDeadLetterCreate) check() error {
+	if _, ok := wdlc.mutation.WebhookID(); !ok {
+		return &ValidationError{Name: "webhook_id", err: errors.New(`ent: missing required field "WebhookDeadLetter.webhook_id"`)}
+	}
+	if _, ok := wdlc.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "WebhookDeadLetter.event"`)}
+	}
+	if v, ok := wdlc.mutation.Event(); ok {
+		if err := webhookdeadletter.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "WebhookDeadLetter.event": %w`, err)}
+		}
+	}
+	if _, ok := wdlc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "WebhookDeadLetter.payload"`)}
+	}
+	if _, ok := wdlc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "WebhookDeadLetter.attempts"`)}
+	}
+	if v, ok := wdlc.mutation.LastError(); ok {
+		if err := webhookdeadletter.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "WebhookDeadLetter.last_error": %w`, err)}
+		}
+	}
+	if _, ok := wdlc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebhookDeadLetter.created_at"`)}
+	}
+	if _, ok := wdlc.mutation.WebhookID(); !ok {
+		return &ValidationError{Name: "webhook", err: errors.New(`ent: missing required edge "WebhookDeadLetter.webhook"`)}
+	}
+	return nil
+}
+
+func (wdlc *WebhookDeadLetterCreate) sqlSave(ctx context.Context) (*WebhookDeadLetter, error) {
+	_node, _spec := wdlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wdlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (wdlc *WebhookDeadLetterCreate) createSpec() (*WebhookDeadLetter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookDeadLetter{config: wdlc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: webhookdeadletter.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhookdeadletter.FieldID,
+			},
+		}
+	)
+	if id, ok := wdlc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wdlc.mutation.Event(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdeadletter.FieldEvent,
+		})
+		_node.Event = value
+	}
+	if value, ok := wdlc.mutation.Payload(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdeadletter.FieldPayload,
+		})
+		_node.Payload = value
+	}
+	if value, ok := wdlc.mutation.Attempts(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdeadletter.FieldAttempts,
+		})
+		_node.Attempts = value
+	}
+	if value, ok := wdlc.mutation.LastError(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdeadletter.FieldLastError,
+		})
+		_node.LastError = &value
+	}
+	if value, ok := wdlc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdeadletter.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := wdlc.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdeadletter.WebhookTable,
+			Columns: []string{webhookdeadletter.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WebhookID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WebhookDeadLetterCreateBulk is the builder for creating many WebhookDeadLetter entities in bulk.
+type WebhookDeadLetterCreateBulk struct {
+	config
+	builders []*WebhookDeadLetterCreate
+}
+
+// Save creates the WebhookDeadLetter entities in the database.
+func (wdlcb *WebhookDeadLetterCreateBulk) Save(ctx context.Context) ([]*WebhookDeadLetter, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wdlcb.builders))
+	nodes := make([]*WebhookDeadLetter, len(wdlcb.builders))
+	mutators := make([]Mutator, len(wdlcb.builders))
+	for i := range wdlcb.builders {
+		func(i int, root context.Context) {
+			builder := wdlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookDeadLetterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wdlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wdlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wdlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdlcb *WebhookDeadLetterCreateBulk) SaveX(ctx context.Context) []*WebhookDeadLetter {
+	v, err := wdlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdlcb *WebhookDeadLetterCreateBulk) Exec(ctx context.Context) error {
+	_, err := wdlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdlcb *WebhookDeadLetterCreateBulk) ExecX(ctx context.Context) {
+	if err := wdlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdeadletter"
+)
+
+// WebhookDeadLetterDelete is the builder for deleting a WebhookDeadLetter entity.
+type WebhookDeadLetterDelete struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeadLetterMutation
+}
+
+// Where appends a list predicates to the WebhookDeadLetterDelete builder.
+func (wdld *WebhookDeadLetterDelete) Where(ps ...predicate.WebhookDeadLetter) *WebhookDeadLetterDelete {
+	wdld.mutation.Where(ps...)
+	return wdld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wdld *WebhookDeadLetterDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wdld.hooks) == 0 {
+		affected, err = wdld.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeadLetterMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wdld.mutation = mutation
+			affected, err = wdld.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wdld.hooks) - 1; i >= 0; i-- {
+			if wdld.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wdld.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wdld.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdld *WebhookDeadLetterDelete) ExecX(ctx context.Context) int {
+	n, err := wdld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wdld *WebhookDeadLetterDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: webhookdeadletter.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhookdeadletter.FieldID,
+			},
+		},
+	}
+	if ps := wdld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wdld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// WebhookDeadLetterDeleteOne is the builder for deleting a single WebhookDeadLetter entity.
+type WebhookDeadLetterDeleteOne struct {
+	wdld *WebhookDeadLetterDelete
+}
+
+// Exec executes the deletion query.
+func (wdldo *WebhookDeadLetterDeleteOne) Exec(ctx context.Context) error {
+	n, err := wdldo.wdld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{webhookdeadletter.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdldo *WebhookDeadLetterDeleteOne) ExecX(ctx context.Context) {
+	wdldo.wdld.ExecX(ctx)
+}
@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhook"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdeadletter"
+	"github.com/google/uuid"
+)
+
+// WebhookDeadLetterQuery is the builder for querying WebhookDeadLetter entities.
+type WebhookDeadLetterQuery struct {
+	config
+	limit       *int
+	offset      *int
+	unique      *bool
+	order       []OrderFunc
+	fields      []string
+	predicates  []predicate.WebhookDeadLetter
+	withWebhook *WebhookQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WebhookDeadLetterQuery builder.
+func (wdlq *WebhookDeadLetterQuery) Where(ps ...predicate.WebhookDeadLetter) *WebhookDeadLetterQuery {
+	wdlq.predicates = append(wdlq.predicates, ps...)
+	return wdlq
+}
+
+// Limit adds a limit step to the query.
+func (wdlq *WebhookDeadLetterQuery) Limit(limit int) *WebhookDeadLetterQuery {
+	wdlq.limit = &limit
+	return wdlq
+}
+
+// Offset adds an offset step to the query.
+func (wdlq *WebhookDeadLetterQuery) Offset(offset int) *WebhookDeadLetterQuery {
+	wdlq.offset = &offset
+	return wdlq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wdlq *WebhookDeadLetterQuery) Unique(unique bool) *WebhookDeadLetterQuery {
+	wdlq.unique = &unique
+	return wdlq
+}
+
+// Order adds an order step to the query.
+func (wdlq *WebhookDeadLetterQuery) Order(o ...OrderFunc) *WebhookDeadLetterQuery {
+	wdlq.order = append(wdlq.order, o...)
+	return wdlq
+}
+
+// QueryWebhook chains the current query on the "webhook" edge.
+func (wdlq *WebhookDeadLetterQuery) QueryWebhook() *WebhookQuery {
+	query := &WebhookQuery{config: wdlq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wdlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wdlq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdeadletter.Table, webhookdeadletter.FieldID, selector),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdeadletter.WebhookTable, webhookdeadletter.WebhookColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wdlq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WebhookDeadLetter entity from the query.
+// Returns a *NotFoundError when no WebhookDeadLetter was found.
+func (wdlq *WebhookDeadLetterQuery) First(ctx context.Context) (*WebhookDeadLetter, error) {
+	nodes, err := wdlq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webhookdeadletter.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wdlq *WebhookDeadLetterQuery) FirstX(ctx context.Context) *WebhookDeadLetter {
+	node, err := wdlq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WebhookDeadLetter ID from the query.
+// Returns a *NotFoundError when no WebhookDeadLetter ID was found.
+func (wdlq *WebhookDeadLetterQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wdlq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webhookdeadletter.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wdlq *WebhookDeadLetterQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := wdlq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WebhookDeadLetter entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WebhookDeadLetter entity is found.
+// Returns a *NotFoundError when no WebhookDeadLetter entities are found.
+func (wdlq *WebhookDeadLetterQuery) Only(ctx context.Context) (*WebhookDeadLetter, error) {
+	nodes, err := wdlq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webhookdeadletter.Label}
+	default:
+		return nil, &NotSingularError{webhookdeadletter.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wdlq *WebhookDeadLetterQuery) OnlyX(ctx context.Context) *WebhookDeadLetter {
+	node, err := wdlq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WebhookDeadLetter ID in the query.
+// Returns a *NotSingularError when more than one WebhookDeadLetter ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wdlq *WebhookDeadLetterQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wdlq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webhookdeadletter.Label}
+	default:
+		err = &NotSingularError{webhookdeadletter.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wdlq *WebhookDeadLetterQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := wdlq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WebhookDeadLetters.
+func (wdlq *WebhookDeadLetterQuery) All(ctx context.Context) ([]*WebhookDeadLetter, error) {
+	if err := wdlq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wdlq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wdlq *WebhookDeadLetterQuery) AllX(ctx context.Context) []*WebhookDeadLetter {
+	nodes, err := wdlq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WebhookDeadLetter IDs.
+func (wdlq *WebhookDeadLetterQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := wdlq.Select(webhookdeadletter.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wdlq *WebhookDeadLetterQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wdlq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wdlq *WebhookDeadLetterQuery) Count(ctx context.Context) (int, error) {
+	if err := wdlq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wdlq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wdlq *WebhookDeadLetterQuery) CountX(ctx context.Context) int {
+	count, err := wdlq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wdlq *WebhookDeadLetterQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wdlq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wdlq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wdlq *WebhookDeadLetterQuery) ExistX(ctx context.Context) bool {
+	exist, err := wdlq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WebhookDeadLetterQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wdlq *WebhookDeadLetterQuery) Clone() *WebhookDeadLetterQuery {
+	if wdlq == nil {
+		return nil
+	}
+	return &WebhookDeadLetterQuery{
+		config:      wdlq.config,
+		limit:       wdlq.limit,
+		offset:      wdlq.offset,
+		order:       append([]OrderFunc{}, wdlq.order...),
+		predicates:  append([]predicate.WebhookDeadLetter{}, wdlq.predicates...),
+		withWebhook: wdlq.withWebhook.Clone(),
+		// clone intermediate query.
+		sql:    wdlq.sql.Clone(),
+		path:   wdlq.path,
+		unique: wdlq.unique,
+	}
+}
+
+// WithWebhook tells the query-builder to eager-load the nodes that are connected to
+// the "webhook" edge. The optional arguments are used to configure the query builder of the edge.
+func (wdlq *WebhookDeadLetterQuery) WithWebhook(opts ...func(*WebhookQuery)) *WebhookDeadLetterQuery {
+	query := &WebhookQuery{config: wdlq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wdlq.withWebhook = query
+	return wdlq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WebhookID uuid.UUID `json:"webhook_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WebhookDeadLetter.Query().
+//		GroupBy(webhookdeadletter.FieldWebhookID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wdlq *WebhookDeadLetterQuery) GroupBy(field string, fields ...string) *WebhookDeadLetterGroupBy {
+	grbuild := &WebhookDeadLetterGroupBy{config: wdlq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wdlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wdlq.sqlQuery(ctx), nil
+	}
+	grbuild.label = webhookdeadletter.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WebhookID uuid.UUID `json:"webhook_id,omitempty"`
+//	}
+//
+//	client.WebhookDeadLetter.Query().
+//		Select(webhookdeadletter.FieldWebhookID).
+//		Scan(ctx, &v)
+func (wdlq *WebhookDeadLetterQuery) Select(fields ...string) *WebhookDeadLetterSelect {
+	wdlq.fields = append(wdlq.fields, fields...)
+	selbuild := &WebhookDeadLetterSelect{WebhookDeadLetterQuery: wdlq}
+	selbuild.label = webhookdeadletter.Label
+	selbuild.flds, selbuild.scan = &wdlq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (wdlq *WebhookDeadLetterQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range wdlq.fields {
+		if !webhookdeadletter.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wdlq.path != nil {
+		prev, err := wdlq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wdlq.sql = prev
+	}
+	return nil
+}
+
+func (wdlq *WebhookDeadLetterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WebhookDeadLetter, error) {
+	var (
+		nodes       = []*WebhookDeadLetter{}
+		_spec       = wdlq.querySpec()
+		loadedTypes = [1]bool{
+			wdlq.withWebhook != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*WebhookDeadLetter).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &WebhookDeadLetter{config: wdlq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wdlq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wdlq.withWebhook; query != nil {
+		if err := wdlq.loadWebhook(ctx, query, nodes, nil,
+			func(n *WebhookDeadLetter, e *Webhook) { n.Edges.Webhook = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wdlq *WebhookDeadLetterQuery) loadWebhook(ctx context.Context, query *WebhookQuery, nodes []*WebhookDeadLetter, init func(*WebhookDeadLetter), assign func(*WebhookDeadLetter, *Webhook)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*WebhookDeadLetter)
+	for i := range nodes {
+		fk := nodes[i].WebhookID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(webhook.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "webhook_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (wdlq *WebhookDeadLetterQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wdlq.querySpec()
+	_spec.Node.Columns = wdlq.fields
+	if len(wdlq.fields) > 0 {
+		_spec.Unique = wdlq.unique != nil && *wdlq.unique
+	}
+	return sqlgraph.CountNodes(ctx, wdlq.driver, _spec)
+}
+
+func (wdlq *WebhookDeadLetterQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wdlq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (wdlq *WebhookDeadLetterQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookdeadletter.Table,
+			Columns: webhookdeadletter.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhookdeadletter.FieldID,
+			},
+		},
+		From:   wdlq.sql,
+		Unique: true,
+	}
+	if unique := wdlq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := wdlq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdeadletter.FieldID)
+		for i := range fields {
+			if fields[i] != webhookdeadletter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wdlq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wdlq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wdlq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wdlq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wdlq *WebhookDeadLetterQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wdlq.driver.Dialect())
+	t1 := builder.Table(webhookdeadletter.Table)
+	columns := wdlq.fields
+	if len(columns) == 0 {
+		columns = webhookdeadletter.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wdlq.sql != nil {
+		selector = wdlq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wdlq.unique != nil && *wdlq.unique {
+		selector.Distinct()
+	}
+	for _, p := range wdlq.predicates {
+		p(selector)
+	}
+	for _, p := range wdlq.order {
+		p(selector)
+	}
+	if offset := wdlq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wdlq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WebhookDeadLetterGroupBy is the group-by builder for WebhookDeadLetter entities.
+type WebhookDeadLetterGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wdlgb *WebhookDeadLetterGroupBy) Aggregate(fns ...AggregateFunc) *WebhookDeadLetterGroupBy {
+	wdlgb.fns = append(wdlgb.fns, fns...)
+	return wdlgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wdlgb *WebhookDeadLetterGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wdlgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wdlgb.sql = query
+	return wdlgb.sqlScan(ctx, v)
+}
+
+func (wdlgb *WebhookDeadLetterGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wdlgb.fields {
+		if !webhookdeadletter.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wdlgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wdlgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wdlgb *WebhookDeadLetterGroupBy) sqlQuery() *sql.Selector {
+	selector := wdlgb.sql.Select()
+	aggregation := make([]string, 0, len(wdlgb.fns))
+	for _, fn := range wdlgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(wdlgb.fields)+len(wdlgb.fns))
+		for _, f := range wdlgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(wdlgb.fields...)...)
+}
+
+// WebhookDeadLetterSelect is the builder for selecting fields of WebhookDeadLetter entities.
+type WebhookDeadLetterSelect struct {
+	*WebhookDeadLetterQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wdls *WebhookDeadLetterSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := wdls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wdls.sql = wdls.WebhookDeadLetterQuery.sqlQuery(ctx)
+	return wdls.sqlScan(ctx, v)
+}
+
+func (wdls *WebhookDeadLetterSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wdls.sql.Query()
+	if err := wdls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhook"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdeadletter"
+	"github.com/google/uuid"
+)
+
+// WebhookDeadLetterUpdate is the builder for updating WebhookDeadLetter entities.
+type WebhookDeadLetterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeadLetterMutation
+}
+
+// Where appends a list predicates to the WebhookDeadLetterUpdate builder.
+func (wdlu *WebhookDeadLetterUpdate) Where(ps ...predicate.WebhookDeadLetter) *WebhookDeadLetterUpdate {
+	wdlu.mutation.Where(ps...)
+	return wdlu
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdlu *WebhookDeadLetterUpdate) SetWebhookID(u uuid.UUID) *WebhookDeadLetterUpdate {
+	wdlu.mutation.SetWebhookID(u)
+	return wdlu
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wdlu *WebhookDeadLetterUpdate) SetWebhook(w *Webhook) *WebhookDeadLetterUpdate {
+	return wdlu.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeadLetterMutation object of the builder.
+func (wdlu *WebhookDeadLetterUpdate) Mutation() *WebhookDeadLetterMutation {
+	return wdlu.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (wdlu *WebhookDeadLetterUpdate) ClearWebhook() *WebhookDeadLetterUpdate {
+	wdlu.mutation.ClearWebhook()
+	return wdlu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdlu *WebhookDeadLetterUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wdlu.hooks) == 0 {
+		if err = wdlu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = wdlu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeadLetterMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wdlu.check(); err != nil {
+				return 0, err
+			}
+			wdlu.mutation = mutation
+			affected, err = wdlu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wdlu.hooks) - 1; i >= 0; i-- {
+			if wdlu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wdlu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wdlu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdlu *WebhookDeadLetterUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdlu *WebhookDeadLetterUpdate) Exec(ctx context.Context) error {
+	_, err := wdlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdlu *WebhookDeadLetterUpdate) ExecX(ctx context.Context) {
+	if err := wdlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdlu *WebhookDeadLetterUpdate) check() error {
+	if _, ok := wdlu.mutation.WebhookID(); wdlu.mutation.WebhookCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "WebhookDeadLetter.webhook"`)
+	}
+	return nil
+}
+
+func (wdlu *WebhookDeadLetterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookdeadletter.Table,
+			Columns: webhookdeadletter.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhookdeadletter.FieldID,
+			},
+		},
+	}
+	if ps := wdlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if wdlu.mutation.LastErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhookdeadletter.FieldLastError,
+		})
+	}
+	if wdlu.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdeadletter.WebhookTable,
+			Columns: []string{webhookdeadletter.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wdlu.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdeadletter.WebhookTable,
+			Columns: []string{webhookdeadletter.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wdlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdeadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WebhookDeadLetterUpdateOne is the builder for updating a single WebhookDeadLetter entity.
+type WebhookDeadLetterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookDeadLetterMutation
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdluo *WebhookDeadLetterUpdateOne) SetWebhookID(u uuid.UUID) *WebhookDeadLetterUpdateOne {
+	wdluo.mutation.SetWebhookID(u)
+	return wdluo
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wdluo *WebhookDeadLetterUpdateOne) SetWebhook(w *Webhook) *WebhookDeadLetterUpdateOne {
+	return wdluo.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeadLetterMutation object of the builder.
+func (wdluo *WebhookDeadLetterUpdateOne) Mutation() *WebhookDeadLetterMutation {
+	return wdluo.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (wdluo *WebhookDeadLetterUpdateOne) ClearWebhook() *WebhookDeadLetterUpdateOne {
+	wdluo.mutation.ClearWebhook()
+	return wdluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wdluo *WebhookDeadLetterUpdateOne) Select(field string, fields ...string) *WebhookDeadLetterUpdateOne {
+	wdluo.fields = append([]string{field}, fields...)
+	return wdluo
+}
+
+// Save executes the query and returns the updated WebhookDeadLetter entity.
+func (wdluo *WebhookDeadLetterUpdateOne) Save(ctx context.Context) (*WebhookDeadLetter, error) {
+	var (
+		err  error
+		node *WebhookDeadLetter
+	)
+	if len(wdluo.hooks) == 0 {
+		if err = wdluo.check(); err != nil {
+			return nil, err
+		}
+		node, err = wdluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeadLetterMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wdluo.check(); err != nil {
+				return nil, err
+			}
+			wdluo.mutation = mutation
+			node, err = wdluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wdluo.hooks) - 1; i >= 0; i-- {
+			if wdluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wdluo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wdluo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WebhookDeadLetter)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WebhookDeadLetterMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdluo *WebhookDeadLetterUpdateOne) SaveX(ctx context.Context) *WebhookDeadLetter {
+	node, err := wdluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wdluo *WebhookDeadLetterUpdateOne) Exec(ctx context.Context) error {
+	_, err := wdluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdluo *WebhookDeadLetterUpdateOne) ExecX(ctx context.Context) {
+	if err := wdluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdluo *WebhookDeadLetterUpdateOne) check() error {
+	if _, ok := wdluo.mutation.WebhookID(); wdluo.mutation.WebhookCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "WebhookDeadLetter.webhook"`)
+	}
+	return nil
+}
+
+func (wdluo *WebhookDeadLetterUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDeadLetter, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookdeadletter.Table,
+			Columns: webhookdeadletter.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhookdeadletter.FieldID,
+			},
+		},
+	}
+	id, ok := wdluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookDeadLetter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wdluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdeadletter.FieldID)
+		for _, f := range fields {
+			if !webhookdeadletter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdeadletter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wdluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if wdluo.mutation.LastErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhookdeadletter.FieldLastError,
+		})
+	}
+	if wdluo.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdeadletter.WebhookTable,
+			Columns: []string{webhookdeadletter.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wdluo.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdeadletter.WebhookTable,
+			Columns: []string{webhookdeadletter.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WebhookDeadLetter{config: wdluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wdluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdeadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -125,3 +125,42 @@ func (r *redisManager) Hdel(key string, field string) error {
 	err := r.Client.HDel(ctx, key, field).Err()
 	return err
 }
+
+// setnx - Redis SET NX, true if the key was set
+func (r *redisManager) SetNX(key string, value string, expiry time.Duration) (bool, error) {
+	val, err := r.Client.SetNX(ctx, key, value, expiry).Result()
+	return val, err
+}
+
+// rpush - Redis RPUSH
+func (r *redisManager) Rpush(key string, value string) error {
+	err := r.Client.RPush(ctx, key, value).Err()
+	return err
+}
+
+// blpop - Redis BLPOP on a single key, returns redis.Nil after timeout
+func (r *redisManager) Blpop(key string, timeout time.Duration) (string, error) {
+	val, err := r.Client.BLPop(ctx, timeout, key).Result()
+	if err != nil {
+		return "", err
+	}
+	// [key, value]
+	return val[1], nil
+}
+
+// publish - Redis PUBLISH
+func (r *redisManager) Publish(channel string, message string) error {
+	err := r.Client.Publish(ctx, channel, message).Err()
+	return err
+}
+
+// subscribe - Redis SUBSCRIBE, messages come in on the returned PubSub's Channel until it's closed
+func (r *redisManager) Subscribe(channel string) (*redis.PubSub, error) {
+	pubsub := r.Client.Subscribe(ctx, channel)
+	// Wait for the subscription so nothing published after this returns is missed
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+	return pubsub, nil
+}
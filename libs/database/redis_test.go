@@ -3,6 +3,7 @@ package database
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/go-redis/redis/v9"
 	"github.com/stretchr/testify/assert"
@@ -131,3 +132,52 @@ func TestHgetall(t *testing.T) {
 		assert.Contains(t, []string{v, v2}, val)
 	}
 }
+
+func TestSetNX(t *testing.T) {
+	// Mock redis client
+	os.Setenv("MOCK_REDIS", "true")
+	defer os.Unsetenv("MOCK_REDIS")
+	k := "setnxkey"
+	defer GetRedisDB().Del(k)
+	set, err := GetRedisDB().SetNX(k, "v", 0)
+	assert.Equal(t, nil, err)
+	assert.True(t, set)
+	set, err = GetRedisDB().SetNX(k, "v2", 0)
+	assert.Equal(t, nil, err)
+	assert.False(t, set)
+	val, _ := GetRedisDB().Get(k)
+	assert.Equal(t, "v", val)
+}
+
+func TestRpushBlpop(t *testing.T) {
+	// Mock redis client
+	os.Setenv("MOCK_REDIS", "true")
+	defer os.Unsetenv("MOCK_REDIS")
+	k := "list"
+	assert.Nil(t, GetRedisDB().Rpush(k, "1"))
+	assert.Nil(t, GetRedisDB().Rpush(k, "2"))
+	val, err := GetRedisDB().Blpop(k, time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", val)
+	val, err = GetRedisDB().Blpop(k, time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, "2", val)
+	_, err = GetRedisDB().Blpop(k, time.Millisecond*100)
+	assert.ErrorIs(t, err, redis.Nil)
+}
+
+func TestPublishSubscribe(t *testing.T) {
+	// Mock redis client
+	os.Setenv("MOCK_REDIS", "true")
+	defer os.Unsetenv("MOCK_REDIS")
+	pubsub, err := GetRedisDB().Subscribe("channel")
+	assert.Nil(t, err)
+	defer pubsub.Close()
+	assert.Nil(t, GetRedisDB().Publish("channel", "hello"))
+	select {
+	case msg := <-pubsub.Channel():
+		assert.Equal(t, "hello", msg.Payload)
+	case <-time.After(time.Second):
+		t.Fatal("no message")
+	}
+}
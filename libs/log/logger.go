@@ -3,6 +3,7 @@ package log
 import (
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/charmbracelet/log"
 )
@@ -11,8 +12,11 @@ var infoLogger *log.Logger
 var warnLogger *log.Logger
 var errorLogger *log.Logger
 var fatalLogger *log.Logger
+var loggersMutex sync.Mutex
 
 func getLogger(level log.Level) *log.Logger {
+	loggersMutex.Lock()
+	defer loggersMutex.Unlock()
 	if level == log.FatalLevel {
 		if fatalLogger == nil {
 			fatalLogger = log.New(os.Stderr)
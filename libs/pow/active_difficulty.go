@@ -0,0 +1,69 @@
+package pow
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
+
+// Anything that can report the network's active difficulty multiplier, e.g. the node RPC client
+type ActiveDifficultySource interface {
+	ActiveDifficultyMultiplier(ctx context.Context) (float64, error)
+}
+
+// The current active difficulty multiplier, never below 1
+func (p *PippinPow) ActiveMultiplier() float64 {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.activeMultiplier < 1 {
+		return 1
+	}
+	return p.activeMultiplier
+}
+
+// Sets the active multiplier, clamped between 1 and the ceiling
+// A ceiling below 1 means no ceiling
+func (p *PippinPow) SetActiveMultiplier(multiplier float64, ceiling float64) {
+	if multiplier < 1 || math.IsNaN(multiplier) {
+		multiplier = 1
+	}
+	if ceiling >= 1 && multiplier > ceiling {
+		multiplier = ceiling
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.activeMultiplier = multiplier
+}
+
+// Raises a base multiplier by the network's active difficulty
+func (p *PippinPow) AdjustMultiplier(base int) int {
+	return int(math.Ceil(float64(base) * p.ActiveMultiplier()))
+}
+
+// Fetches the active difficulty once and stores it
+func (p *PippinPow) UpdateActiveDifficulty(ctx context.Context, source ActiveDifficultySource, ceiling float64) error {
+	multiplier, err := source.ActiveDifficultyMultiplier(ctx)
+	if err != nil {
+		return err
+	}
+	p.SetActiveMultiplier(multiplier, ceiling)
+	return nil
+}
+
+// Polls the active difficulty every interval until the context is done
+func (p *PippinPow) StartActiveDifficultyPoller(ctx context.Context, source ActiveDifficultySource, interval time.Duration, ceiling float64) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := p.UpdateActiveDifficulty(ctx, source, ceiling); err != nil {
+			log.Warnf("Unable to update active difficulty %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package pow
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDifficultySource struct {
+	multiplier float64
+	err        error
+}
+
+func (m *mockDifficultySource) ActiveDifficultyMultiplier(ctx context.Context) (float64, error) {
+	return m.multiplier, m.err
+}
+
+func TestActiveDifficulty(t *testing.T) {
+	p := NewPippinPow([]string{}, "", "", 30, 1)
+	// Defaults to 1
+	assert.Equal(t, float64(1), p.ActiveMultiplier())
+	assert.Equal(t, 64, p.AdjustMultiplier(64))
+
+	// Raises the multiplier for new blocks
+	assert.Nil(t, p.UpdateActiveDifficulty(context.Background(), &mockDifficultySource{multiplier: 1.5}, 4))
+	assert.Equal(t, 1.5, p.ActiveMultiplier())
+	assert.Equal(t, 96, p.AdjustMultiplier(64))
+	assert.Equal(t, 2, p.AdjustMultiplier(1))
+
+	// Capped at the ceiling
+	assert.Nil(t, p.UpdateActiveDifficulty(context.Background(), &mockDifficultySource{multiplier: 10}, 4))
+	assert.Equal(t, float64(4), p.ActiveMultiplier())
+
+	// Never below 1
+	assert.Nil(t, p.UpdateActiveDifficulty(context.Background(), &mockDifficultySource{multiplier: 0.5}, 4))
+	assert.Equal(t, float64(1), p.ActiveMultiplier())
+
+	// Errors keep the previous value
+	p.SetActiveMultiplier(2, 0)
+	assert.NotNil(t, p.UpdateActiveDifficulty(context.Background(), &mockDifficultySource{err: errors.New("node down")}, 4))
+	assert.Equal(t, float64(2), p.ActiveMultiplier())
+}
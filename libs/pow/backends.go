@@ -0,0 +1,245 @@
+package pow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/pow/models"
+	"github.com/appditto/pippin_nano_wallet/libs/pow/net"
+)
+
+var ErrNoWorkPeers = errors.New("no work peers available")
+var ErrNoCachedWork = errors.New("no cached work")
+
+// The healthiest work peers at once, first valid work wins and the others get work_cancel
+type peersProvider struct {
+	pow *PippinPow
+}
+
+func (pp *peersProvider) Name() string {
+	return ProviderPeers
+}
+
+func (pp *peersProvider) Available(req *WorkRequest) bool {
+	return len(pp.pow.selectPeers()) > 0
+}
+
+func (pp *peersProvider) GenerateWork(parent context.Context, req *WorkRequest) (string, error) {
+	peers := pp.pow.selectPeers()
+	if len(peers) < 1 {
+		return "", ErrNoWorkPeers
+	}
+	ctx, cancel := context.WithCancel(parent)
+	// Stop waiting on the others before sending work cancel, so how they answer it doesn't count against them
+	defer func() {
+		cancel()
+		for _, peer := range peers {
+			pp.pow.peerRequests.Add(1)
+			go func(peer string) {
+				defer pp.pow.peerRequests.Done()
+				WorkCancelAPIRequest(peer, req.Hash)
+			}(peer)
+		}
+	}()
+
+	difficulty := DifficultyToString(DifficultyFromMultiplier(req.Multiplier))
+	results := make(chan providerResult, len(peers))
+	for _, peer := range peers {
+		pp.pow.peerRequests.Add(1)
+		go func(peer string) {
+			defer pp.pow.peerRequests.Done()
+			work, err := pp.pow.workGenerateAPIRequest(ctx, peer, req.Hash, req.Multiplier, difficulty, req.Validate)
+			results <- providerResult{provider: peer, work: work, err: err}
+		}(peer)
+	}
+	var lastErr error
+	for range peers {
+		select {
+		case result := <-results:
+			if result.err == nil {
+				return result.work, nil
+			}
+			lastErr = result.err
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+	return "", lastErr
+}
+
+// BoomPoW, with the request's key or the configured one
+type boompowProvider struct {
+	pow *PippinPow
+}
+
+func (bp *boompowProvider) Name() string {
+	return ProviderBoompow
+}
+
+func (bp *boompowProvider) key(req *WorkRequest) string {
+	if req.BpowKey != "" {
+		return req.BpowKey
+	}
+	return bp.pow.bpowKey
+}
+
+func (bp *boompowProvider) Available(req *WorkRequest) bool {
+	return bp.pow.bpowUrl != "" && bp.key(req) != ""
+}
+
+func (bp *boompowProvider) GenerateWork(ctx context.Context, req *WorkRequest) (string, error) {
+	return net.MakeBoompowWorkGenerateRequest(ctx, bp.pow.bpowUrl, bp.key(req), req.Hash, req.Multiplier, req.BlockAward)
+}
+
+// GPU or CPU, bounded by max_work_processes
+type localProvider struct {
+	pow *PippinPow
+}
+
+func (lp *localProvider) Name() string {
+	return ProviderLocal
+}
+
+// Always there, fails with ErrLocalWorkDisabled when max_work_processes is 0
+func (lp *localProvider) Available(req *WorkRequest) bool {
+	return true
+}
+
+func (lp *localProvider) GenerateWork(ctx context.Context, req *WorkRequest) (string, error) {
+	return lp.pow.generateWorkLocally(ctx, req.Hash, req.Multiplier)
+}
+
+// Sends requests to the node, the rpc client does it failing over between the healthy nodes
+type NodeClient interface {
+	MakeRequest(ctx context.Context, request interface{}) ([]byte, error)
+}
+
+// work_generate on the node, which uses its own work peers or computes it
+type NodeProvider struct {
+	client NodeClient
+}
+
+func NewNodeProvider(client NodeClient) *NodeProvider {
+	return &NodeProvider{client: client}
+}
+
+func (np *NodeProvider) Name() string {
+	return ProviderNode
+}
+
+func (np *NodeProvider) Available(req *WorkRequest) bool {
+	return np.client != nil
+}
+
+func (np *NodeProvider) GenerateWork(ctx context.Context, req *WorkRequest) (string, error) {
+	response, err := np.client.MakeRequest(ctx, models.WorkGenerateRequest{
+		WorkBaseRequest: models.WorkBaseRequest{
+			Action: "work_generate",
+			Hash:   req.Hash,
+		},
+		Difficulty: DifficultyToString(DifficultyFromMultiplier(req.Multiplier)),
+	})
+	if ctx.Err() != nil {
+		// Stop the node working on it
+		go np.client.MakeRequest(context.Background(), models.WorkBaseRequest{
+			Action: "work_cancel",
+			Hash:   req.Hash,
+		})
+		return "", ctx.Err()
+	} else if err != nil {
+		return "", err
+	}
+	var resp models.WorkGenerateResponse
+	if err := json.Unmarshal(response, &resp); err != nil {
+		return "", err
+	} else if resp.Work == "" {
+		return "", errors.New("no work from node")
+	}
+	return resp.Work, nil
+}
+
+// Sends work_generate to the node through client, as the node provider
+func (p *PippinPow) EnableNodeWorkGenerate(client NodeClient) {
+	p.RegisterProvider(NewNodeProvider(client))
+}
+
+// How long generated or precomputed work is kept
+const workCacheTTL = time.Hour
+
+// Cached entries are pruned past this size
+const workCacheMaxSize = 10000
+
+type cachedWork struct {
+	work    string
+	created time.Time
+}
+
+type workCache struct {
+	entries map[string]cachedWork
+	mutex   sync.Mutex
+}
+
+func newWorkCache() *workCache {
+	return &workCache{entries: make(map[string]cachedWork)}
+}
+
+func (c *workCache) put(hash string, work string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if len(c.entries) >= workCacheMaxSize {
+		for cachedHash, entry := range c.entries {
+			if time.Since(entry.created) > workCacheTTL {
+				delete(c.entries, cachedHash)
+			}
+		}
+	}
+	if len(c.entries) >= workCacheMaxSize {
+		return
+	}
+	c.entries[strings.ToUpper(hash)] = cachedWork{work: work, created: time.Now()}
+}
+
+func (c *workCache) get(hash string) (string, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, ok := c.entries[strings.ToUpper(hash)]
+	if !ok || time.Since(entry.created) > workCacheTTL {
+		return "", false
+	}
+	return entry.work, true
+}
+
+// Work generated before, or precomputed with CacheWork, when it meets the difficulty
+type cacheProvider struct {
+	cache *workCache
+}
+
+func (cp *cacheProvider) Name() string {
+	return ProviderCache
+}
+
+func (cp *cacheProvider) Available(req *WorkRequest) bool {
+	work, ok := cp.cache.get(req.Hash)
+	return ok && IsWorkValid(req.Hash, req.Multiplier, work)
+}
+
+func (cp *cacheProvider) GenerateWork(ctx context.Context, req *WorkRequest) (string, error) {
+	work, ok := cp.cache.get(req.Hash)
+	if !ok || !IsWorkValid(req.Hash, req.Multiplier, work) {
+		return "", ErrNoCachedWork
+	}
+	return work, nil
+}
+
+// Keeps work for hash, e.g. precomputed for the next block of an account
+func (p *PippinPow) CacheWork(hash string, work string) {
+	p.mutex.Lock()
+	p.registerDefaultProviders()
+	cache := p.cache
+	p.mutex.Unlock()
+	cache.put(hash, work)
+}
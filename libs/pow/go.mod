@@ -5,7 +5,7 @@ go 1.22.1
 require (
 	github.com/appditto/pippin_nano_wallet/libs/log v0.0.0-20240625194645-fc95391f0316
 	github.com/appditto/pippin_nano_wallet/libs/utils v0.0.0-20240624161726-32e13926afe3
-	github.com/bbedward/nanopow v0.0.0-20240624234946-89fdce04d413
+	github.com/bbedward/go-opencl v0.0.0-20220912170320-f150bf21e6e1
 	github.com/jarcoal/httpmock v1.2.0
 	github.com/mitchellh/mapstructure v1.5.0
 	github.com/stretchr/testify v1.9.0
@@ -13,10 +13,8 @@ require (
 )
 
 require (
-	github.com/bbedward/go-opencl v0.0.0-20220912170320-f150bf21e6e1 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	golang.org/x/exp/errors v0.0.0-20240613232115-7f521ea00fb8 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
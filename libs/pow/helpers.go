@@ -3,17 +3,51 @@ package pow
 import (
 	"encoding/binary"
 	"encoding/hex"
+	"errors"
+	"math"
 	"strconv"
 
-	"github.com/bbedward/nanopow"
+	"github.com/appditto/pippin_nano_wallet/libs/pow/models"
+	"github.com/appditto/pippin_nano_wallet/libs/pow/nanopow"
 	"golang.org/x/crypto/blake2b"
 )
 
+// Work thresholds from the nano protocol, since epoch v2
+// See: https://docs.nano.org/releases/network-upgrades/#epoch-v2
+const (
+	// Send and change blocks
+	SendThreshold = uint64(0xfffffff800000000)
+	// Receive, open and epoch blocks
+	ReceiveThreshold = uint64(0xfffffe0000000000)
+	// Every block on an account that is still on epoch v1
+	EpochV1Threshold = uint64(0xffffffc000000000)
+)
+
 const (
 	baseMaxUint64  = uint64(1<<64 - 1)
-	baseDifficulty = baseMaxUint64 - uint64(0xfffffe0000000000)
+	baseDifficulty = baseMaxUint64 - ReceiveThreshold
 )
 
+// The threshold the network requires for a block, epoch is the epoch version the block is on
+// Banano only has the one threshold
+func ThresholdForBlock(subtype string, epoch int, banano bool) uint64 {
+	if banano {
+		return ReceiveThreshold
+	} else if epoch < 2 {
+		return EpochV1Threshold
+	}
+	switch subtype {
+	case "send", "change":
+		return SendThreshold
+	}
+	return ReceiveThreshold
+}
+
+// Same as ThresholdForBlock, as a multiplier of the base threshold
+func MultiplierForBlock(subtype string, epoch int, banano bool) int {
+	return MultiplierFromDifficulty(ThresholdForBlock(subtype, epoch, banano))
+}
+
 // This is a helper to convert work multiplier to difficulty string representation
 // BoomPoW takes a multiplier while the node/other work servers take the string
 // Our base is banano or nano's receive, which would be 1x
@@ -37,30 +71,83 @@ func MultiplierFromDifficulty(difficulty uint64) int {
 	return int(baseDifficulty / (baseMaxUint64 - difficulty))
 }
 
-func IsWorkValid(previous string, difficultyMultiplier int, w string) bool {
-	difficult := DifficultyFromMultiplier(difficultyMultiplier)
-	previousEnc, err := hex.DecodeString(previous)
-	if err != nil {
-		return false
+var ErrInvalidWorkHash = errors.New("invalid hash")
+var ErrInvalidWork = errors.New("invalid work")
+
+// The difficulty work achieves on a 32 byte hash, both hex encoded
+func WorkDifficulty(hash string, work string) (uint64, error) {
+	hashEnc, err := hex.DecodeString(hash)
+	if err != nil || len(hashEnc) != 32 {
+		return 0, ErrInvalidWorkHash
+	}
+	workEnc, err := hex.DecodeString(work)
+	if err != nil || len(workEnc) != 8 {
+		return 0, ErrInvalidWork
 	}
-	wEnc, err := hex.DecodeString(w)
+	reverse(workEnc)
+	h, err := blake2b.New(8, nil)
 	if err != nil {
-		return false
+		return 0, err
 	}
+	h.Write(workEnc)
+	h.Write(hashEnc)
+	return binary.LittleEndian.Uint64(h.Sum(nil)), nil
+}
 
-	hash, err := blake2b.New(8, nil)
-	if err != nil {
-		return false
+// How many times harder difficulty is than base, as the node reports it
+func MultiplierFromDifficultyFloat(difficulty uint64, base uint64) float64 {
+	return (float64(baseMaxUint64-base) + 1) / (float64(baseMaxUint64-difficulty) + 1)
+}
+
+// Inverse of MultiplierFromDifficultyFloat
+func DifficultyFromMultiplierFloat(multiplier float64, base uint64) (uint64, error) {
+	if multiplier <= 0 || math.IsNaN(multiplier) || math.IsInf(multiplier, 0) {
+		return 0, errors.New("invalid multiplier")
+	}
+	reverse := (float64(baseMaxUint64-base) + 1) / multiplier
+	if reverse >= float64(baseMaxUint64) {
+		return 0, nil
 	}
+	return baseMaxUint64 - uint64(reverse) + 1, nil
+}
 
-	n := make([]byte, 8)
-	copy(n, wEnc[:])
+// Validates work like the node's work_validate, difficulty is optional
+// Multipliers are relative to the send threshold, or the only threshold in banano mode
+func ValidateWork(hash string, work string, difficulty uint64, banano bool) (*models.WorkValidateResponse, error) {
+	achieved, err := WorkDifficulty(hash, work)
+	if err != nil {
+		return nil, err
+	}
+	base := SendThreshold
+	if banano {
+		base = ReceiveThreshold
+	}
+	resp := &models.WorkValidateResponse{
+		ValidAll:     boolToString(achieved >= base),
+		ValidReceive: boolToString(achieved >= ReceiveThreshold),
+		Difficulty:   DifficultyToString(achieved),
+		Multiplier:   strconv.FormatFloat(MultiplierFromDifficultyFloat(achieved, base), 'f', -1, 64),
+	}
+	if difficulty > 0 {
+		resp.Valid = boolToString(achieved >= difficulty)
+	}
+	return resp, nil
+}
 
-	reverse(n)
-	hash.Write(n)
-	hash.Write(previousEnc[:])
+func boolToString(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
 
-	return binary.LittleEndian.Uint64(hash.Sum(nil)) >= difficult
+// Work that isn't exactly 8 bytes or a hash that isn't 32 bytes is never valid
+func IsWorkValid(previous string, difficultyMultiplier int, w string) bool {
+	difficulty, err := WorkDifficulty(previous, w)
+	if err != nil {
+		return false
+	}
+	return difficulty >= DifficultyFromMultiplier(difficultyMultiplier)
 }
 
 func reverse(v []byte) {
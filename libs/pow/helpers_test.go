@@ -3,7 +3,7 @@ package pow
 import (
 	"testing"
 
-	"github.com/bbedward/nanopow"
+	"github.com/appditto/pippin_nano_wallet/libs/pow/nanopow"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -13,6 +13,27 @@ func TestDifficultyFromMultiplier(t *testing.T) {
 	assert.Equal(t, uint64(0xfffffff800000000), DifficultyFromMultiplier(64))
 }
 
+func TestThresholdForBlock(t *testing.T) {
+	assert.Equal(t, SendThreshold, ThresholdForBlock("send", 2, false))
+	assert.Equal(t, SendThreshold, ThresholdForBlock("change", 2, false))
+	assert.Equal(t, ReceiveThreshold, ThresholdForBlock("receive", 2, false))
+	assert.Equal(t, ReceiveThreshold, ThresholdForBlock("open", 2, false))
+	assert.Equal(t, ReceiveThreshold, ThresholdForBlock("epoch", 2, false))
+	assert.Equal(t, EpochV1Threshold, ThresholdForBlock("send", 1, false))
+	assert.Equal(t, EpochV1Threshold, ThresholdForBlock("receive", 1, false))
+	assert.Equal(t, ReceiveThreshold, ThresholdForBlock("send", 2, true))
+	assert.Equal(t, ReceiveThreshold, ThresholdForBlock("send", 1, true))
+}
+
+func TestMultiplierForBlock(t *testing.T) {
+	assert.Equal(t, 64, MultiplierForBlock("send", 2, false))
+	assert.Equal(t, 64, MultiplierForBlock("change", 2, false))
+	assert.Equal(t, 1, MultiplierForBlock("receive", 2, false))
+	assert.Equal(t, 8, MultiplierForBlock("receive", 1, false))
+	assert.Equal(t, 1, MultiplierForBlock("send", 2, true))
+	assert.Equal(t, EpochV1Threshold, DifficultyFromMultiplier(MultiplierForBlock("send", 1, false)))
+}
+
 func TestDifficultToString(t *testing.T) {
 	assert.Equal(t, "fffffe0000000000", DifficultyToString(uint64(0xfffffe0000000000)))
 	assert.Equal(t, "fffffff800000000", DifficultyToString(uint64(0xfffffff800000000)))
@@ -43,6 +64,59 @@ func TestIsWorkValid(t *testing.T) {
 	hash = "03DDDFF29D3FF3DC41B5374A10A70B49F7AA41E42461511D6A64F346F9C8421E"
 	workResult = "00000000002d7708"
 	assert.False(t, IsWorkValid(hash, 1, workResult))
+
+	// Wrong lengths are never valid instead of being padded or truncated
+	assert.False(t, IsWorkValid("3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3", 1, "205452237a9b01"))
+	assert.False(t, IsWorkValid("3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3", 1, "205452237a9b01f400"))
+	assert.False(t, IsWorkValid("3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3A", 1, "205452237a9b01f4"))
+	assert.False(t, IsWorkValid("3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3", 1, ""))
+}
+
+func TestDifficultyFromMultiplierFloat(t *testing.T) {
+	difficulty, err := DifficultyFromMultiplierFloat(1, SendThreshold)
+	assert.Nil(t, err)
+	assert.Equal(t, SendThreshold, difficulty)
+	difficulty, err = DifficultyFromMultiplierFloat(1.0/64, SendThreshold)
+	assert.Nil(t, err)
+	assert.Equal(t, ReceiveThreshold, difficulty)
+	assert.Equal(t, float64(64), MultiplierFromDifficultyFloat(SendThreshold, ReceiveThreshold))
+	_, err = DifficultyFromMultiplierFloat(0, SendThreshold)
+	assert.NotNil(t, err)
+}
+
+func TestValidateWork(t *testing.T) {
+	hash := "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3"
+	difficulty, err := WorkDifficulty(hash, "205452237a9b01f4")
+	assert.Nil(t, err)
+	assert.Equal(t, "ffffffff287741bf", DifficultyToString(difficulty))
+
+	resp, err := ValidateWork(hash, "205452237a9b01f4", 0, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "", resp.Valid)
+	assert.Equal(t, "1", resp.ValidAll)
+	assert.Equal(t, "1", resp.ValidReceive)
+	assert.Equal(t, "ffffffff287741bf", resp.Difficulty)
+	assert.Equal(t, "9.501974378880858", resp.Multiplier)
+
+	resp, err = ValidateWork(hash, "205452237a9b01f4", 0xffffffffffff0000, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "0", resp.Valid)
+
+	// Bad work for this hash
+	resp, err = ValidateWork(hash, "0000000000000000", SendThreshold, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "0", resp.Valid)
+	assert.Equal(t, "0", resp.ValidAll)
+
+	// Lengths are checked
+	_, err = ValidateWork("3F93C5CD", "205452237a9b01f4", 0, false)
+	assert.ErrorIs(t, err, ErrInvalidWorkHash)
+	_, err = ValidateWork(hash, "2054", 0, false)
+	assert.ErrorIs(t, err, ErrInvalidWork)
+	_, err = ValidateWork(hash, "205452237a9b01f4ff", 0, false)
+	assert.ErrorIs(t, err, ErrInvalidWork)
+	_, err = ValidateWork(hash, "zz5452237a9b01f4", 0, false)
+	assert.ErrorIs(t, err, ErrInvalidWork)
 }
 
 func TestReverse(t *testing.T) {
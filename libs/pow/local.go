@@ -0,0 +1,51 @@
+package pow
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+
+	"github.com/appditto/pippin_nano_wallet/libs/pow/nanopow"
+)
+
+var ErrLocalWorkDisabled = errors.New("local work generation is disabled")
+
+var localWorkers []nanopow.WorkerGenerator
+var localWorkersOnce sync.Once
+
+// Same workers nanopow uses by default, GPU if compiled with -tags cl, plus CPU threads
+func getLocalWorkers() []nanopow.WorkerGenerator {
+	localWorkersOnce.Do(func() {
+		threads := runtime.NumCPU()
+		device, gpuErr := nanopow.GetDevice()
+		if gpuErr == nil {
+			gpu, err := nanopow.NewWorkerGPU(device)
+			if err == nil {
+				localWorkers = append(localWorkers, gpu)
+				if threads < 8 {
+					return
+				}
+				threads /= 2
+			}
+		}
+		cpu, err := nanopow.NewWorkerCPUThread(uint64(threads))
+		if err == nil {
+			localWorkers = append(localWorkers, cpu)
+		}
+	})
+	return localWorkers
+}
+
+// Runs the local workers until they find work or ctx is done
+func generateWorkCancellable(ctx context.Context, root []byte, difficulty uint64) (nanopow.Work, error) {
+	workers := getLocalWorkers()
+	if len(workers) < 1 {
+		return nanopow.Work{}, nanopow.ErrNoDefaultPoolAvailable
+	}
+	nctx := nanopow.NewContext()
+	for _, worker := range workers {
+		go worker.GenerateWork(nctx, root, difficulty)
+	}
+	return nctx.Result(ctx)
+}
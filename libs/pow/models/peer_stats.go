@@ -0,0 +1,9 @@
+package models
+
+type PeerStats struct {
+	Url              string `json:"url" mapstructure:"url"`
+	Requests         int64  `json:"requests" mapstructure:"requests"`
+	Failures         int64  `json:"failures" mapstructure:"failures"`
+	AverageLatencyMs int64  `json:"average_latency_ms" mapstructure:"average_latency_ms"`
+	CircuitOpen      bool   `json:"circuit_open" mapstructure:"circuit_open"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodePeerStats(t *testing.T) {
+	stats := PeerStats{
+		Url:              "http://localhost:5555",
+		Requests:         10,
+		Failures:         2,
+		AverageLatencyMs: 150,
+		CircuitOpen:      false,
+	}
+	encoded, err := json.Marshal(stats)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"url":"http://localhost:5555","requests":10,"failures":2,"average_latency_ms":150,"circuit_open":false}`, string(encoded))
+}
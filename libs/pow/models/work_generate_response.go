@@ -1,5 +1,8 @@
 package models
 
 type WorkGenerateResponse struct {
-	Work string `json:"work" mapstructure:"work"`
+	Work       string `json:"work" mapstructure:"work"`
+	Difficulty string `json:"difficulty,omitempty" mapstructure:"difficulty,omitempty"`
+	Multiplier string `json:"multiplier,omitempty" mapstructure:"multiplier,omitempty"`
+	Hash       string `json:"hash,omitempty" mapstructure:"hash,omitempty"`
 }
@@ -23,3 +23,11 @@ func TestMapStructureDecodeWorkGenerateResponse(t *testing.T) {
 	mapstructure.Decode(request, &decoded)
 	assert.Equal(t, "def", decoded.Work)
 }
+
+func TestEncodeWorkGenerateResponse(t *testing.T) {
+	encoded, _ := json.Marshal(WorkGenerateResponse{Work: "1234"})
+	assert.Equal(t, `{"work":"1234"}`, string(encoded))
+
+	encoded, _ = json.Marshal(WorkGenerateResponse{Work: "1234", Difficulty: "fffffff800000000", Multiplier: "1", Hash: "abc"})
+	assert.Equal(t, `{"work":"1234","difficulty":"fffffff800000000","multiplier":"1","hash":"abc"}`, string(encoded))
+}
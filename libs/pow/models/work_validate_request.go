@@ -0,0 +1,7 @@
+package models
+
+type WorkValidateRequest struct {
+	WorkBaseRequest
+	Work       string `json:"work" mapstructure:"work"`
+	Difficulty string `json:"difficulty,omitempty" mapstructure:"difficulty,omitempty"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWorkValidateRequest(t *testing.T) {
+	encoded := `{"action":"work_validate","hash":"abc","work":"def","difficulty":"fffffff800000000"}`
+	var decoded WorkValidateRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "work_validate", decoded.Action)
+	assert.Equal(t, "abc", decoded.Hash)
+	assert.Equal(t, "def", decoded.Work)
+	assert.Equal(t, "fffffff800000000", decoded.Difficulty)
+}
@@ -0,0 +1,10 @@
+package models
+
+// Same shape as the node, valid is only set when a difficulty was given
+type WorkValidateResponse struct {
+	Valid        string `json:"valid,omitempty" mapstructure:"valid,omitempty"`
+	ValidAll     string `json:"valid_all" mapstructure:"valid_all"`
+	ValidReceive string `json:"valid_receive" mapstructure:"valid_receive"`
+	Difficulty   string `json:"difficulty" mapstructure:"difficulty"`
+	Multiplier   string `json:"multiplier" mapstructure:"multiplier"`
+}
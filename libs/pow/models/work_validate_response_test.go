@@ -0,0 +1,25 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWorkValidateResponse(t *testing.T) {
+	response := WorkValidateResponse{
+		ValidAll:     "1",
+		ValidReceive: "1",
+		Difficulty:   "fffffff93c41ec94",
+		Multiplier:   "1.182623871097636",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"valid_all":"1","valid_receive":"1","difficulty":"fffffff93c41ec94","multiplier":"1.182623871097636"}`, string(encoded))
+
+	response.Valid = "0"
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"valid":"0","valid_all":"1","valid_receive":"1","difficulty":"fffffff93c41ec94","multiplier":"1.182623871097636"}`, string(encoded))
+}
@@ -0,0 +1,67 @@
+// Forked from github.com/bbedward/nanopow, whose Context can't be cancelled twice
+// and is closed under workers that are about to report a result
+package nanopow
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+)
+
+// Workers report to a Context until it's cancelled, it can be cancelled any number of times
+type Context struct {
+	results chan uint64
+	stops   chan struct{}
+	once    sync.Once
+}
+
+func NewContext() *Context {
+	return &Context{
+		results: make(chan uint64, 64),
+		stops:   make(chan struct{}),
+	}
+}
+
+func (c *Context) workerStop() <-chan struct{} {
+	return c.stops
+}
+
+// Results past the first few are dropped, only one is ever read
+func (c *Context) workerResult(i uint64) {
+	select {
+	case c.results <- i:
+	default:
+	}
+}
+
+// Stops the workers
+func (c *Context) Cancel() {
+	c.once.Do(func() {
+		close(c.stops)
+	})
+}
+
+// Waits for the first result and stops the workers, a result that's ready wins over ctx being done
+func (c *Context) Result(ctx context.Context) (Work, error) {
+	defer c.Cancel()
+	select {
+	case r := <-c.results:
+		return toWork(r), nil
+	case <-ctx.Done():
+		select {
+		case r := <-c.results:
+			return toWork(r), nil
+		default:
+			return Work{}, ctx.Err()
+		}
+	}
+}
+
+func toWork(r uint64) (result Work) {
+	binary.BigEndian.PutUint64(result[:], r)
+	return result
+}
+
+type WorkerGenerator interface {
+	GenerateWork(ctx *Context, root []byte, difficulty uint64) (err error)
+}
@@ -0,0 +1,53 @@
+package nanopow
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCPUWork(t *testing.T) {
+	// Low enough to find quickly
+	hash, difficulty := make([]byte, 32), uint64(0xf000000000000000)
+	rand.New(rand.NewSource(1)).Read(hash)
+
+	cpu, err := NewWorkerCPUThread(2)
+	assert.Nil(t, err)
+	ctx := NewContext()
+	assert.Nil(t, cpu.GenerateWork(ctx, hash, difficulty))
+	work, err := ctx.Result(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, IsValid(hash, difficulty, work))
+}
+
+func TestContextCancel(t *testing.T) {
+	// Cancelling is safe any number of times, and workers reporting afterwards don't block
+	ctx := NewContext()
+	ctx.Cancel()
+	ctx.Cancel()
+	for i := 0; i < 100; i++ {
+		ctx.workerResult(uint64(i))
+	}
+	<-ctx.workerStop()
+
+	// A ready result wins over a cancelled ctx
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	ctx = NewContext()
+	ctx.workerResult(1)
+	work, err := ctx.Result(cancelled)
+	assert.Nil(t, err)
+	assert.Equal(t, Work{0, 0, 0, 0, 0, 0, 0, 1}, work)
+
+	// Otherwise it gives up and stops the workers
+	ctx = NewContext()
+	_, err = ctx.Result(cancelled)
+	assert.ErrorIs(t, err, context.Canceled)
+	<-ctx.workerStop()
+}
+
+func TestCalculateDifficulty(t *testing.T) {
+	assert.Equal(t, uint64(18446743798831644672), CalculateDifficulty(0))
+}
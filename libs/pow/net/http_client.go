@@ -16,7 +16,7 @@ import (
 func MakeRequest(ctx context.Context, url string, request interface{}, authorization string) ([]byte, error) {
 	requestBody, _ := json.Marshal(request)
 	// HTTP post
-	httpRequest, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(requestBody))
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(requestBody))
 	if err != nil {
 		log.Errorf("Error building request %s", err)
 		return nil, err
@@ -25,7 +25,6 @@ func MakeRequest(ctx context.Context, url string, request interface{}, authoriza
 	if authorization != "" {
 		httpRequest.Header.Add("Authorization", authorization)
 	}
-	httpRequest.WithContext(ctx)
 	client := &http.Client{}
 	resp, err := client.Do(httpRequest)
 	if err != nil {
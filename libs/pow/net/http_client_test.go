@@ -2,9 +2,12 @@ package net
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
@@ -97,3 +100,20 @@ func TestBoompowWorkGenerate(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "abcd1234", resp)
 }
+
+func TestWorkGenerateCancelled(t *testing.T) {
+	// A peer that never answers
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(time.Millisecond*100, cancel)
+	start := time.Now()
+	_, err := MakeWorkGenerateRequest(ctx, server.URL, "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3", "fffffff800000000")
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,158 @@
+package pow
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/pow/models"
+)
+
+// Consecutive failures before a peer's circuit opens
+const peerBreakerThreshold = 3
+
+// How long a peer with an open circuit is skipped, after that it gets one request to prove itself
+const peerBreakerCooldown = time.Minute
+
+// Weight of the newest sample in the latency moving average
+const peerLatencyWeight = 0.3
+
+type peerHealth struct {
+	requests            int64
+	failures            int64
+	latency             time.Duration
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+func (h *peerHealth) circuitOpen(now time.Time) bool {
+	return h.consecutiveFailures >= peerBreakerThreshold && now.Before(h.openUntil)
+}
+
+// Lower is better, failing peers look slower than they are
+// Peers without a latency sample yet are scored at unmeasured, so they still get tried
+func (h *peerHealth) score(unmeasured time.Duration) float64 {
+	latency := h.latency
+	if latency == 0 {
+		latency = unmeasured
+	}
+	if h.requests == 0 {
+		return float64(latency)
+	}
+	errorRate := float64(h.failures) / float64(h.requests)
+	return float64(latency) * (1 + 4*errorRate)
+}
+
+// Must hold the mutex
+func (p *PippinPow) getPeerHealth(url string) *peerHealth {
+	if p.peerHealth == nil {
+		p.peerHealth = make(map[string]*peerHealth)
+	}
+	health, ok := p.peerHealth[url]
+	if !ok {
+		health = &peerHealth{}
+		p.peerHealth[url] = health
+	}
+	return health
+}
+
+func (p *PippinPow) recordPeerSuccess(url string, latency time.Duration) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	health := p.getPeerHealth(url)
+	health.requests++
+	if health.latency == 0 {
+		health.latency = latency
+	} else {
+		health.latency = time.Duration(peerLatencyWeight*float64(latency) + (1-peerLatencyWeight)*float64(health.latency))
+	}
+	health.consecutiveFailures = 0
+}
+
+func (p *PippinPow) recordPeerFailure(url string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	health := p.getPeerHealth(url)
+	health.requests++
+	health.failures++
+	health.consecutiveFailures++
+	if health.consecutiveFailures >= peerBreakerThreshold {
+		health.openUntil = time.Now().Add(peerBreakerCooldown)
+	}
+}
+
+// Peers to send a work request to, healthiest first
+// Skips peers with an open circuit and keeps the best PeerFanout of them, 0 means all
+func (p *PippinPow) selectPeers() []string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	now := time.Now()
+	var selected []string
+	for _, peer := range p.WorkPeers {
+		if !p.getPeerHealth(peer).circuitOpen(now) {
+			selected = append(selected, peer)
+		}
+	}
+	// New peers are expected to be about as fast as the median of the measured ones
+	var latencies []time.Duration
+	for _, peer := range selected {
+		if latency := p.peerHealth[peer].latency; latency > 0 {
+			latencies = append(latencies, latency)
+		}
+	}
+	var unmeasured time.Duration
+	if len(latencies) > 0 {
+		slices.Sort(latencies)
+		unmeasured = latencies[(len(latencies)-1)/2]
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		a, b := p.peerHealth[selected[i]], p.peerHealth[selected[j]]
+		if a.score(unmeasured) != b.score(unmeasured) {
+			return a.score(unmeasured) < b.score(unmeasured)
+		}
+		// On a tie the peer we know is that fast goes first
+		return a.latency > 0 && b.latency == 0
+	})
+	if p.PeerFanout > 0 && len(selected) > p.PeerFanout {
+		selected = selected[:p.PeerFanout]
+	}
+	return selected
+}
+
+// Stats of every configured work peer
+func (p *PippinPow) PeerStats() []models.PeerStats {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	now := time.Now()
+	stats := []models.PeerStats{}
+	for _, peer := range p.WorkPeers {
+		health := p.getPeerHealth(peer)
+		stats = append(stats, models.PeerStats{
+			Url:              peer,
+			Requests:         health.requests,
+			Failures:         health.failures,
+			AverageLatencyMs: health.latency.Milliseconds(),
+			CircuitOpen:      health.circuitOpen(now),
+		})
+	}
+	return stats
+}
+
+// Adds a work peer at runtime, its stats start from scratch
+func (p *PippinPow) AddWorkPeer(url string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if slices.Contains(p.WorkPeers, url) {
+		return
+	}
+	p.WorkPeers = append(p.WorkPeers, url)
+	delete(p.peerHealth, url)
+}
+
+// Removes every work peer
+func (p *PippinPow) ClearWorkPeers() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.WorkPeers = []string{}
+	p.peerHealth = make(map[string]*peerHealth)
+}
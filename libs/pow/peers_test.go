@@ -0,0 +1,147 @@
+package pow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerSelection(t *testing.T) {
+	p := NewPippinPow([]string{"http://peer1", "http://peer2", "http://peer3"}, "", "", 30, 1)
+	// Untried peers all get requests
+	assert.Equal(t, []string{"http://peer1", "http://peer2", "http://peer3"}, p.selectPeers())
+
+	// Fastest first
+	p.recordPeerSuccess("http://peer1", time.Millisecond*500)
+	p.recordPeerSuccess("http://peer2", time.Millisecond*100)
+	p.recordPeerSuccess("http://peer3", time.Millisecond*200)
+	assert.Equal(t, []string{"http://peer2", "http://peer3", "http://peer1"}, p.selectPeers())
+
+	// Only the best N
+	p.PeerFanout = 2
+	assert.Equal(t, []string{"http://peer2", "http://peer3"}, p.selectPeers())
+
+	// Errors count against a peer
+	p.recordPeerFailure("http://peer2")
+	p.recordPeerSuccess("http://peer2", time.Millisecond*100)
+	assert.Equal(t, []string{"http://peer3", "http://peer2"}, p.selectPeers())
+
+	// Circuit opens after consecutive failures
+	for i := 0; i < peerBreakerThreshold; i++ {
+		p.recordPeerFailure("http://peer3")
+	}
+	assert.Equal(t, []string{"http://peer2", "http://peer1"}, p.selectPeers())
+	stats := p.PeerStats()
+	assert.Len(t, stats, 3)
+	assert.Equal(t, "http://peer3", stats[2].Url)
+	assert.Equal(t, int64(4), stats[2].Requests)
+	assert.Equal(t, int64(3), stats[2].Failures)
+	assert.True(t, stats[2].CircuitOpen)
+	assert.Equal(t, int64(100), stats[1].AverageLatencyMs)
+
+	// Half open after the cooldown
+	p.PeerFanout = 0
+	p.mutex.Lock()
+	p.peerHealth["http://peer3"].openUntil = time.Now().Add(-time.Second)
+	p.mutex.Unlock()
+	assert.Contains(t, p.selectPeers(), "http://peer3")
+
+	// Add and clear at runtime
+	p.AddWorkPeer("http://peer4")
+	p.AddWorkPeer("http://peer4")
+	assert.Len(t, p.PeerStats(), 4)
+	p.ClearWorkPeers()
+	assert.Len(t, p.PeerStats(), 0)
+	assert.Len(t, p.selectPeers(), 0)
+
+	// A new peer is tried as if it was as fast as the median, without pushing out a faster one
+	p = NewPippinPow([]string{"http://new", "http://fast", "http://slow"}, "", "", 30, 1)
+	p.recordPeerSuccess("http://fast", time.Millisecond*100)
+	p.recordPeerSuccess("http://slow", time.Millisecond*300)
+	p.PeerFanout = 2
+	assert.Equal(t, []string{"http://fast", "http://new"}, p.selectPeers())
+	p.PeerFanout = 1
+	assert.Equal(t, []string{"http://fast"}, p.selectPeers())
+
+	// Failing without ever answering doesn't make a peer look fast
+	p.recordPeerFailure("http://new")
+	p.PeerFanout = 0
+	assert.Equal(t, []string{"http://fast", "http://slow", "http://new"}, p.selectPeers())
+}
+
+func TestWorkGenerateMetaRecordsPeerHealth(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://goodpeer",
+		func(req *http.Request) (*http.Response, error) {
+			time.Sleep(time.Millisecond * 50)
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"work": "goodwork",
+			})
+		},
+	)
+	httpmock.RegisterResponder("POST", "http://badpeer",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	p := NewPippinPow([]string{"http://badpeer", "http://goodpeer"}, "", "", 30, 1)
+	for i := 0; i < peerBreakerThreshold; i++ {
+		work, err := p.WorkGenerateMeta(context.Background(), "abcdef", 1, false, false, "")
+		assert.Nil(t, err)
+		assert.Equal(t, "goodwork", work)
+	}
+	p.peerRequests.Wait()
+	assert.True(t, p.PeerStats()[0].CircuitOpen)
+	// The bad peer is skipped now
+	assert.Equal(t, []string{"http://goodpeer"}, p.selectPeers())
+}
+
+func TestLosingPeersAreNotPenalized(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://fastpeer",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"work": "fastwork",
+			})
+		},
+	)
+	// Answers work_generate with an error once it gets work_cancel
+	cancelled := make(chan struct{}, peerBreakerThreshold)
+	httpmock.RegisterResponder("POST", "http://slowpeer",
+		func(req *http.Request) (*http.Response, error) {
+			var body map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&body)
+			if body["action"] == "work_cancel" {
+				cancelled <- struct{}{}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{})
+			}
+			<-cancelled
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "Cancelled",
+			})
+		},
+	)
+
+	p := NewPippinPow([]string{"http://fastpeer", "http://slowpeer"}, "", "", 30, 1)
+	for i := 0; i < peerBreakerThreshold; i++ {
+		work, err := p.WorkGenerateMeta(context.Background(), "abcdef", 1, false, false, "")
+		assert.Nil(t, err)
+		assert.Equal(t, "fastwork", work)
+	}
+	p.peerRequests.Wait()
+	stats := p.PeerStats()
+	assert.Equal(t, int64(0), stats[1].Failures)
+	assert.False(t, stats[1].CircuitOpen)
+}
@@ -11,16 +11,29 @@ import (
 	"github.com/appditto/pippin_nano_wallet/libs/log"
 	"github.com/appditto/pippin_nano_wallet/libs/pow/net"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
-	"github.com/bbedward/nanopow"
 )
 
 type PippinPow struct {
-	WorkPeers        []string
+	WorkPeers []string
+	// How many of the healthiest peers get each request, 0 sends to every peer
+	PeerFanout       int
+	peerHealth       map[string]*peerHealth
 	workPeersFailing bool
 	bpowKey          string
 	bpowUrl          string
 	timeout          time.Duration
-	mutex            sync.Mutex
+	activeMultiplier float64
+	// How providers are combined, race when empty
+	Strategy string
+	// Registered providers by name and the order they're used in
+	providers     map[string]WorkProvider
+	providerOrder []string
+	cache         *workCache
+	// Bounds how many local work generations run at once, nil when local work is disabled
+	localSlots chan struct{}
+	// Provider and peer requests, and work_cancels, still running after the race was decided
+	peerRequests sync.WaitGroup
+	mutex        sync.Mutex
 }
 
 func (p *PippinPow) WorkPeersFailing() bool {
@@ -37,10 +50,15 @@ func (p *PippinPow) SetWorkPeersFailing(failing bool) {
 
 // workPeers is an array of URLs to send work_generate requests to
 // bpowKey and bpowUrl are optional, bpowUrl will default to boompow.banano.cc/graphql
-func NewPippinPow(workPeers []string, bpowKey string, bpowUrl string, workTimeout int) *PippinPow {
+// maxWorkProcesses is how many local work generations can run at once, 0 disables local work
+func NewPippinPow(workPeers []string, bpowKey string, bpowUrl string, workTimeout int, maxWorkProcesses int) *PippinPow {
 	if bpowUrl == "" {
 		bpowUrl = "https://boompow.banano.cc/graphql"
 	}
+	var localSlots chan struct{}
+	if maxWorkProcesses > 0 {
+		localSlots = make(chan struct{}, maxWorkProcesses)
+	}
 	return &PippinPow{
 		WorkPeers: workPeers,
 		// If peers are failing we will generate local pow no matter what
@@ -48,39 +66,42 @@ func NewPippinPow(workPeers []string, bpowKey string, bpowUrl string, workTimeou
 		bpowUrl:          bpowUrl,
 		bpowKey:          bpowKey,
 		timeout:          time.Duration(workTimeout) * time.Second,
+		localSlots:       localSlots,
 	}
 }
 
-// Makes a request to configured array of work peers
-func (p *PippinPow) workGenerateAPIRequest(ctx context.Context, url string, hash string, difficultyMultiplier int, difficulty string, validate bool, out chan *string) {
+// Waits for requests and work_cancels that were still running when their WorkGenerateMeta returned
+func (p *PippinPow) Wait() {
+	p.peerRequests.Wait()
+}
+
+// Makes a work_generate request to a work peer
+func (p *PippinPow) workGenerateAPIRequest(ctx context.Context, url string, hash string, difficultyMultiplier int, difficulty string, validate bool) (string, error) {
+	start := time.Now()
 	resp, err := net.MakeWorkGenerateRequest(ctx, url, hash, difficulty)
 	if err == nil && resp.Work != "" {
 		// Validate work
 		if IsWorkValid(hash, difficultyMultiplier, resp.Work) || !validate {
-			p.SetWorkPeersFailing(false)
-			WriteChannelSafe(out, resp.Work)
-		} else {
-			log.Errorf("Received invalid work %s for %s from %s", resp.Work, hash, url)
+			p.recordPeerSuccess(url, time.Since(start))
+			return resp.Work, nil
 		}
+		log.Errorf("Received invalid work %s for %s from %s", resp.Work, hash, url)
+		err = ErrInvalidWork
+	} else if errors.Is(ctx.Err(), context.Canceled) {
+		// Somebody else won, errors or no work in answer to work_cancel aren't the peer's fault
+		return "", ctx.Err()
+	} else if err == nil {
+		err = fmt.Errorf("no work from %s", url)
 	}
+	p.recordPeerFailure(url)
+	return "", err
 }
 
-// Makes a request to BoomPoW
-func (p *PippinPow) workGenerateBpowRequest(ctx context.Context, hash string, difficulty int, validate bool, blockAward bool, bpowKey string, out chan *string) {
-	resp, err := net.MakeBoompowWorkGenerateRequest(ctx, p.bpowUrl, bpowKey, hash, difficulty, blockAward)
-	if err == nil && resp != "" {
-		// Validate work
-		if IsWorkValid(hash, difficulty, resp) || !validate {
-			p.SetWorkPeersFailing(false)
-			WriteChannelSafe(out, resp)
-		} else {
-			log.Errorf("Received invalid work %s for %s from boompow", resp, hash)
-		}
+// Use GPU or CPU to generate work, stops when ctx is done
+func (p *PippinPow) generateWorkLocally(ctx context.Context, hash string, difficultyMultiplier int) (string, error) {
+	if p.localSlots == nil {
+		return "", ErrLocalWorkDisabled
 	}
-}
-
-// Use GPU or CPU to generate work
-func (p *PippinPow) generateWorkLocally(hash string, difficultyMultiplier int) (string, error) {
 	// Generate work locally
 	if !utils.Validate64HexHash(hash) {
 		return "", errors.New("invalid hash")
@@ -89,7 +110,14 @@ func (p *PippinPow) generateWorkLocally(hash string, difficultyMultiplier int) (
 	if err != nil {
 		return "", err
 	}
-	res, err := nanopow.GenerateWork(decoded, DifficultyFromMultiplier(difficultyMultiplier))
+	// Wait for a free slot in the pool
+	select {
+	case p.localSlots <- struct{}{}:
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+	defer func() { <-p.localSlots }()
+	res, err := generateWorkCancellable(ctx, decoded, DifficultyFromMultiplier(difficultyMultiplier))
 
 	if err != nil {
 		return "", err
@@ -97,105 +125,44 @@ func (p *PippinPow) generateWorkLocally(hash string, difficultyMultiplier int) (
 	return WorkToString(res), nil
 }
 
-// Will use OpenCL if compiled with -tags cl, otherwise pure golang implementation
-func (p *PippinPow) workGenerateLocal(ctx context.Context, hash string, difficultyMultiplier int, validate bool, out chan *string) {
-	// ! TODO - work out a way to cancel
-	work, err := p.generateWorkLocally(hash, difficultyMultiplier)
-	if err == nil {
-		if IsWorkValid(hash, difficultyMultiplier, work) || !validate {
-			WriteChannelSafe(out, work)
-		} else {
-			log.Errorf("Received invalid work %s for %s from local", work, hash)
-		}
-	}
-}
-
 // Makes a work cancel request
 func WorkCancelAPIRequest(url string, hash string) {
 	net.MakeWorkCancelRequest(context.Background(), url, hash)
 }
 
 // The main entry point for Pippin WorkGenerate
-// Invokes work_generate requests to every peer simultaneously including BoomPoW, depending on configuration
-// Returns the first valid work response, sends cancel to everybody else
+// Asks the registered work providers according to Strategy, by default every peer and BoomPoW simultaneously
 // If no peers or boompow configured, uses local PoW
 // If all peers fail, will use local PoW until peers are responsive again
-func (p *PippinPow) WorkGenerateMeta(hash string, difficultyMultiplier int, validate bool, blockAward bool, bpowKey string) (string, error) {
-
-	// 1 hard coded valid work is just for higher level integration tests so we don't need to calculate real work
-	if hash == "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3" {
-		return "205452237a9b01f4", nil
+// Gives up as soon as ctx is done
+func (p *PippinPow) WorkGenerateMeta(ctx context.Context, hash string, difficultyMultiplier int, validate bool, blockAward bool, bpowKey string) (string, error) {
+	req := &WorkRequest{
+		Hash:       hash,
+		Multiplier: difficultyMultiplier,
+		Validate:   validate,
+		BlockAward: blockAward,
+		BpowKey:    bpowKey,
 	}
-
-	ctx := context.Background()
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	chanSize := len(p.WorkPeers)
-	if p.bpowUrl != "" && (bpowKey != "" || p.bpowKey != "") {
-		chanSize++
+	providers := p.availableProviders(req)
+	if len(providers) < 1 {
+		return "", ErrNoWorkProvider
 	}
-	resultChan := make(chan *string, chanSize)
-	defer close(resultChan)
 
-	difficultyUint := DifficultyFromMultiplier(difficultyMultiplier)
-	difficultyStr := DifficultyToString(difficultyUint)
-	runningLocally := false
-
-	if (len(p.WorkPeers) < 1 && p.bpowKey == "" && bpowKey == "") || p.WorkPeersFailing() {
-		// Local pow
-		runningLocally = true
-		go p.workGenerateLocal(ctx, hash, difficultyMultiplier, validate, resultChan)
-	}
-	for _, peer := range p.WorkPeers {
-		go p.workGenerateAPIRequest(ctx, peer, hash, difficultyMultiplier, difficultyStr, validate, resultChan)
+	var work string
+	var err error
+	switch p.Strategy {
+	case StrategyFallback:
+		work, err = p.fallbackProviders(ctx, req, providers)
+	case StrategyPrimary:
+		work, err = p.primaryProviders(ctx, req, providers)
+	default:
+		work, err = p.raceProviders(ctx, req, providers)
 	}
-	if p.bpowUrl != "" {
-		key := bpowKey
-		if key == "" && p.bpowKey != "" {
-			key = p.bpowKey
-		}
-		if key != "" {
-			go p.workGenerateBpowRequest(ctx, hash, difficultyMultiplier, validate, blockAward, key, resultChan)
-		}
+	if err != nil {
+		return "", err
 	}
-
-	select {
-	case result := <-resultChan:
-		// Send work cancel
-		for _, peer := range p.WorkPeers {
-			go WorkCancelAPIRequest(peer, hash)
-		}
-		return *result, nil
-	case <-time.After(p.timeout):
-		// Send work cancel
-		for _, peer := range p.WorkPeers {
-			go WorkCancelAPIRequest(peer, hash)
-		}
-		// See if our peers are failing
-		// Generate local pow if it didnt run locally
-		if !runningLocally {
-			p.SetWorkPeersFailing(true)
-			work, err := p.generateWorkLocally(hash, difficultyMultiplier)
-			if err == nil {
-				return work, nil
-			}
-		}
-		return "", errors.New("Unable to generate work - timed out")
+	if validate {
+		p.CacheWork(hash, work)
 	}
-}
-
-// Recovers from writing to close channel
-func WriteChannelSafe(out chan *string, msg string) (err error) {
-	defer func() {
-		// recover from panic caused by writing to a closed channel
-		if r := recover(); r != nil {
-			err = fmt.Errorf("%v", r)
-			return
-		}
-	}()
-
-	out <- &msg // write on possibly closed channel
-
-	return err
+	return work, nil
 }
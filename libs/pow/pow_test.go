@@ -1,6 +1,7 @@
 package pow
 
 import (
+	"context"
 	"net/http"
 	"os"
 	"testing"
@@ -31,19 +32,40 @@ func testMainWrapper(m *testing.M) int {
 		utils.GetEnv("BPOW_KEY", ""),
 		utils.GetEnv("BPOW_URL", ""),
 		30,
+		1,
 	)
 	return m.Run()
 }
 
 func TestWorkGenerateLocal(t *testing.T) {
 	// Test local pow generation
-	work, err := PPow.generateWorkLocally("09263b65752d05ce4df5aeed849ffc2be5bf47026abb4fa5879359ae571ba9c8", 1)
+	work, err := PPow.generateWorkLocally(context.Background(), "09263b65752d05ce4df5aeed849ffc2be5bf47026abb4fa5879359ae571ba9c8", 1)
 	assert.Nil(t, err)
 	assert.Len(t, work, 16)
 
-	work, err = PPow.generateWorkLocally("abcdefg", 1)
+	work, err = PPow.generateWorkLocally(context.Background(), "abcdefg", 1)
 	assert.NotNil(t, err)
 	assert.ErrorContains(t, err, "invalid hash")
+
+	// Cancelling stops generation, a multiplier this high would take forever
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	start := time.Now()
+	_, err = PPow.generateWorkLocally(ctx, "09263b65752d05ce4df5aeed849ffc2be5bf47026abb4fa5879359ae571ba9c8", 1000000)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+
+	// The slot was released
+	work, err = PPow.generateWorkLocally(context.Background(), "09263b65752d05ce4df5aeed849ffc2be5bf47026abb4fa5879359ae571ba9c8", 1)
+	assert.Nil(t, err)
+	assert.Len(t, work, 16)
+
+	// Disabled with no processes
+	disabled := NewPippinPow([]string{}, "", "", 30, 0)
+	_, err = disabled.generateWorkLocally(context.Background(), "09263b65752d05ce4df5aeed849ffc2be5bf47026abb4fa5879359ae571ba9c8", 1)
+	assert.ErrorIs(t, err, ErrLocalWorkDisabled)
+	_, err = disabled.WorkGenerateMeta(context.Background(), "09263b65752d05ce4df5aeed849ffc2be5bf47026abb4fa5879359ae571ba9c8", 1, true, false, "")
+	assert.ErrorIs(t, err, ErrLocalWorkDisabled)
 }
 
 // We want to test that we can invoke to numerous peers and get the first response
@@ -97,7 +119,7 @@ func TestWorkGenerateMeta(t *testing.T) {
 		},
 	)
 
-	result, err := PPow.WorkGenerateMeta("abc", 1, false, true, "bad")
+	result, err := PPow.WorkGenerateMeta(context.Background(), "abc", 1, false, true, "bad")
 	assert.Nil(t, err)
 	assert.Equal(t, "abcd1234", result)
 
@@ -122,7 +144,7 @@ func TestWorkGenerateMeta(t *testing.T) {
 		},
 	)
 
-	result, err = PPow.WorkGenerateMeta("abcdef", 1, false, true, "bad")
+	result, err = PPow.WorkGenerateMeta(context.Background(), "abcdef", 1, false, true, "bad")
 	assert.Nil(t, err)
 	assert.Equal(t, "5555", result)
 
@@ -145,7 +167,7 @@ func TestWorkGenerateMeta(t *testing.T) {
 		},
 	)
 
-	result, err = PPow.WorkGenerateMeta("abcdef", 1, false, true, "bad")
+	result, err = PPow.WorkGenerateMeta(context.Background(), "abcdef", 1, false, true, "bad")
 	assert.Nil(t, err)
 	assert.Equal(t, "8888", result)
 
@@ -170,27 +192,28 @@ func TestWorkGenerateMeta(t *testing.T) {
 		},
 	)
 
-	result, err = PPow.WorkGenerateMeta("abcdef", 1, false, true, "bad")
+	result, err = PPow.WorkGenerateMeta(context.Background(), "abcdef", 1, false, true, "bad")
 	assert.NotNil(t, err)
 	assert.ErrorContains(t, err, "Unable to generate work")
 
 	// BoomPoW only one that works with this request
-	result, err = PPow.WorkGenerateMeta("boompowaccepted", 1, false, true, "")
+	result, err = PPow.WorkGenerateMeta(context.Background(), "boompowaccepted", 1, false, true, "")
 	assert.Nil(t, err)
 	assert.Equal(t, "boompowwork", result)
 
 	// Test BoomPoW overriding key
-	result, err = PPow.WorkGenerateMeta("boompowaccepted", 1, false, true, "overridden")
+	result, err = PPow.WorkGenerateMeta(context.Background(), "boompowaccepted", 1, false, true, "overridden")
 	assert.Nil(t, err)
 	assert.Equal(t, "customkey", result)
 
 	// Test with local pow (no peers, no boompow configured)
 	ppow := &PippinPow{
-		WorkPeers: []string{},
-		timeout:   30 * time.Second,
+		WorkPeers:  []string{},
+		timeout:    30 * time.Second,
+		localSlots: make(chan struct{}, 1),
 	}
 
-	result, err = ppow.WorkGenerateMeta("09263b65752d05ce4df5aeed849ffc2be5bf47026abb4fa5879359ae571ba9c8", 1, true, true, "")
+	result, err = ppow.WorkGenerateMeta(context.Background(), "09263b65752d05ce4df5aeed849ffc2be5bf47026abb4fa5879359ae571ba9c8", 1, true, true, "")
 	assert.Nil(t, err)
 	assert.Len(t, result, 16)
 }
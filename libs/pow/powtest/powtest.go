@@ -0,0 +1,128 @@
+// Work provider for tests, so they don't need to compute real work
+// Only test code imports this, production code never registers it
+package powtest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+)
+
+// Real work for KnownHash, valid up to about 9x the send threshold
+const KnownHash = "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3"
+const KnownWork = "205452237a9b01f4"
+
+// Doesn't meet any threshold
+const InvalidWork = "0000000000000000"
+
+const ProviderName = "test"
+
+var ErrUnknownHash = errors.New("no test work for hash")
+
+// Answers with work from a fixed table, can be made slow, failing or return invalid work
+type Provider struct {
+	work map[string]string
+	// Waited before answering, unless the request is cancelled first
+	delay time.Duration
+	// Returned instead of work when set
+	err error
+	// Answers with InvalidWork
+	invalid bool
+	calls   int
+	mutex   sync.Mutex
+}
+
+// Knows KnownHash
+func NewProvider() *Provider {
+	return &Provider{
+		work: map[string]string{KnownHash: KnownWork},
+	}
+}
+
+func (tp *Provider) Name() string {
+	return ProviderName
+}
+
+func (tp *Provider) Available(req *pow.WorkRequest) bool {
+	return true
+}
+
+func (tp *Provider) GenerateWork(ctx context.Context, req *pow.WorkRequest) (string, error) {
+	tp.mutex.Lock()
+	tp.calls++
+	delay, err, invalid := tp.delay, tp.err, tp.invalid
+	work, ok := tp.work[strings.ToUpper(req.Hash)]
+	tp.mutex.Unlock()
+
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+	if err != nil {
+		return "", err
+	} else if invalid {
+		return InvalidWork, nil
+	} else if !ok {
+		return "", ErrUnknownHash
+	}
+	return work, nil
+}
+
+// Work to answer with for hash
+func (tp *Provider) SetWork(hash string, work string) {
+	tp.mutex.Lock()
+	defer tp.mutex.Unlock()
+	tp.work[strings.ToUpper(hash)] = work
+}
+
+func (tp *Provider) SetDelay(delay time.Duration) {
+	tp.mutex.Lock()
+	defer tp.mutex.Unlock()
+	tp.delay = delay
+}
+
+func (tp *Provider) SetError(err error) {
+	tp.mutex.Lock()
+	defer tp.mutex.Unlock()
+	tp.err = err
+}
+
+func (tp *Provider) SetInvalid(invalid bool) {
+	tp.mutex.Lock()
+	defer tp.mutex.Unlock()
+	tp.invalid = invalid
+}
+
+// Back to answering right away with the table
+func (tp *Provider) Reset() {
+	tp.mutex.Lock()
+	defer tp.mutex.Unlock()
+	tp.delay = 0
+	tp.err = nil
+	tp.invalid = false
+}
+
+// How many requests it got
+func (tp *Provider) Calls() int {
+	tp.mutex.Lock()
+	defer tp.mutex.Unlock()
+	return tp.calls
+}
+
+// A PippinPow that only asks provider, with extra providers after it in order, e.g. pow.ProviderLocal
+func NewPippinPow(provider *Provider, fallbacks ...string) *pow.PippinPow {
+	p := pow.NewPippinPow([]string{}, "", "", 30, 1)
+	p.Strategy = pow.StrategyFallback
+	p.RegisterProvider(provider)
+	if err := p.UseProviders(append([]string{provider.Name()}, fallbacks...)); err != nil {
+		panic(err)
+	}
+	return p
+}
@@ -0,0 +1,64 @@
+package powtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/stretchr/testify/assert"
+)
+
+const otherHash = "09263B65752D05CE4DF5AEED849FFC2BE5BF47026ABB4FA5879359AE571BA9C8"
+
+func TestProvider(t *testing.T) {
+	provider := NewProvider()
+	p := NewPippinPow(provider)
+
+	work, err := p.WorkGenerateMeta(context.Background(), KnownHash, 1, true, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, KnownWork, work)
+
+	// Unknown hashes fail without a fallback
+	_, err = p.WorkGenerateMeta(context.Background(), otherHash, 1, true, false, "")
+	assert.ErrorIs(t, err, ErrUnknownHash)
+	provider.SetWork(otherHash, "abcdef0123456789")
+	work, err = p.WorkGenerateMeta(context.Background(), otherHash, 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "abcdef0123456789", work)
+
+	// Failing
+	provider.SetError(errors.New("broken"))
+	_, err = p.WorkGenerateMeta(context.Background(), KnownHash, 1, true, false, "")
+	assert.ErrorContains(t, err, "broken")
+	provider.Reset()
+
+	// Invalid work is rejected
+	provider.SetInvalid(true)
+	_, err = p.WorkGenerateMeta(context.Background(), KnownHash, 1, true, false, "")
+	assert.ErrorIs(t, err, pow.ErrInvalidWork)
+	provider.Reset()
+
+	// Slow, the request gives up first
+	provider.SetDelay(time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	start := time.Now()
+	_, err = p.WorkGenerateMeta(ctx, KnownHash, 1, true, false, "")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+	provider.Reset()
+
+	assert.Equal(t, 6, provider.Calls())
+}
+
+func TestFallback(t *testing.T) {
+	provider := NewProvider()
+	p := NewPippinPow(provider, pow.ProviderLocal)
+
+	// Local computes what the table doesn't have
+	work, err := p.WorkGenerateMeta(context.Background(), otherHash, 1, true, false, "")
+	assert.Nil(t, err)
+	assert.True(t, pow.IsWorkValid(otherHash, 1, work))
+}
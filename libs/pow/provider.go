@@ -0,0 +1,235 @@
+package pow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
+
+// A backend that can generate work
+type WorkProvider interface {
+	// Name used in the work_providers config
+	Name() string
+	// Whether the provider can take this request, e.g. boompow needs a key
+	Available(req *WorkRequest) bool
+	GenerateWork(ctx context.Context, req *WorkRequest) (string, error)
+}
+
+type WorkRequest struct {
+	Hash       string
+	Multiplier int
+	Validate   bool
+	BlockAward bool
+	BpowKey    string
+}
+
+const (
+	ProviderCache   = "cache"
+	ProviderPeers   = "peers"
+	ProviderBoompow = "boompow"
+	ProviderNode    = "node"
+	ProviderLocal   = "local"
+)
+
+const (
+	// Every provider at once, first valid work wins
+	StrategyRace = "race"
+	// One provider at a time in order, the next one when it fails or times out
+	StrategyFallback = "fallback"
+	// The first provider, the others race when it fails or times out
+	StrategyPrimary = "primary"
+)
+
+// Order providers are used in unless configured otherwise
+var DefaultProviderOrder = []string{ProviderCache, ProviderPeers, ProviderBoompow, ProviderNode, ProviderLocal}
+
+var ErrNoWorkProvider = errors.New("no work provider available")
+var ErrUnknownWorkProvider = errors.New("unknown work provider")
+var ErrWorkTimeout = errors.New("Unable to generate work - timed out")
+
+// Must hold the mutex
+func (p *PippinPow) registerDefaultProviders() {
+	if p.providers != nil {
+		return
+	}
+	p.cache = newWorkCache()
+	p.providers = make(map[string]WorkProvider)
+	p.providerOrder = DefaultProviderOrder
+	for _, provider := range []WorkProvider{
+		&cacheProvider{cache: p.cache},
+		&peersProvider{pow: p},
+		&boompowProvider{pow: p},
+		&localProvider{pow: p},
+	} {
+		p.providers[provider.Name()] = provider
+	}
+}
+
+// Adds a provider or replaces the one with the same name
+// Providers that aren't part of the order are appended to it
+func (p *PippinPow) RegisterProvider(provider WorkProvider) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.registerDefaultProviders()
+	p.providers[provider.Name()] = provider
+	if !slices.Contains(p.providerOrder, provider.Name()) {
+		p.providerOrder = append(slices.Clone(p.providerOrder), provider.Name())
+	}
+}
+
+// Uses only these providers, in this order
+func (p *PippinPow) UseProviders(names []string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.registerDefaultProviders()
+	for _, name := range names {
+		if _, ok := p.providers[name]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownWorkProvider, name)
+		}
+	}
+	p.providerOrder = slices.Clone(names)
+	return nil
+}
+
+// Registered providers that can take req, in order
+func (p *PippinPow) availableProviders(req *WorkRequest) []WorkProvider {
+	p.mutex.Lock()
+	p.registerDefaultProviders()
+	ordered := []WorkProvider{}
+	for _, name := range p.providerOrder {
+		if provider, ok := p.providers[name]; ok {
+			ordered = append(ordered, provider)
+		}
+	}
+	p.mutex.Unlock()
+
+	available := []WorkProvider{}
+	for _, provider := range ordered {
+		if provider.Available(req) {
+			available = append(available, provider)
+		}
+	}
+	return available
+}
+
+type providerResult struct {
+	provider string
+	work     string
+	err      error
+}
+
+// Runs the providers concurrently, returns the first valid work
+// Gives up once all of them failed, or after timeout when it's not 0
+func (p *PippinPow) firstResult(parent context.Context, req *WorkRequest, providers []WorkProvider, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+	var timer <-chan time.Time
+	if timeout > 0 {
+		timer = time.After(timeout)
+	}
+
+	results := make(chan providerResult, len(providers))
+	for _, provider := range providers {
+		p.peerRequests.Add(1)
+		go func(provider WorkProvider) {
+			defer p.peerRequests.Done()
+			work, err := provider.GenerateWork(ctx, req)
+			if err == nil && req.Validate && !IsWorkValid(req.Hash, req.Multiplier, work) {
+				log.Errorf("Received invalid work %s for %s from %s", work, req.Hash, provider.Name())
+				err = ErrInvalidWork
+			}
+			results <- providerResult{provider: provider.Name(), work: work, err: err}
+		}(provider)
+	}
+
+	var lastErr error
+	for range providers {
+		select {
+		case result := <-results:
+			if result.err == nil {
+				if result.provider != ProviderLocal && result.provider != ProviderCache {
+					p.SetWorkPeersFailing(false)
+				}
+				return result.work, nil
+			}
+			lastErr = result.err
+		case <-parent.Done():
+			return "", parent.Err()
+		case <-timer:
+			return "", ErrWorkTimeout
+		}
+	}
+	return "", fmt.Errorf("Unable to generate work: %w", lastErr)
+}
+
+// Local work can take long, it's the last resort so it doesn't get a timeout
+func (p *PippinPow) providerTimeout(provider WorkProvider) time.Duration {
+	if provider.Name() == ProviderLocal {
+		return 0
+	}
+	return p.timeout
+}
+
+// Every provider at once, local only joins when nothing else is available or the others have been failing
+// If the others fail or time out local is used, until they answer again
+func (p *PippinPow) raceProviders(ctx context.Context, req *WorkRequest, providers []WorkProvider) (string, error) {
+	var local WorkProvider
+	racers := []WorkProvider{}
+	for _, provider := range providers {
+		if provider.Name() == ProviderCache {
+			// Answers right away, no need to bother anybody else
+			return p.firstResult(ctx, req, []WorkProvider{provider}, 0)
+		} else if provider.Name() == ProviderLocal {
+			local = provider
+		} else {
+			racers = append(racers, provider)
+		}
+	}
+	runningLocally := local != nil && (len(racers) < 1 || p.WorkPeersFailing())
+	if runningLocally {
+		racers = append(racers, local)
+	}
+	var timeout time.Duration
+	if !runningLocally {
+		timeout = p.timeout
+	}
+
+	work, err := p.firstResult(ctx, req, racers, timeout)
+	if err == nil || ctx.Err() != nil || runningLocally || local == nil {
+		return work, err
+	}
+	p.SetWorkPeersFailing(true)
+	work, localErr := p.firstResult(ctx, req, []WorkProvider{local}, 0)
+	if localErr != nil {
+		return "", err
+	}
+	return work, nil
+}
+
+// One provider at a time, in order
+func (p *PippinPow) fallbackProviders(ctx context.Context, req *WorkRequest, providers []WorkProvider) (string, error) {
+	var err error
+	for _, provider := range providers {
+		var work string
+		work, err = p.firstResult(ctx, req, []WorkProvider{provider}, p.providerTimeout(provider))
+		if err == nil || ctx.Err() != nil {
+			return work, err
+		}
+		log.Infof("Work provider %s failed for %s, trying the next one: %v", provider.Name(), req.Hash, err)
+	}
+	return "", err
+}
+
+// The first provider, then the others racing
+func (p *PippinPow) primaryProviders(ctx context.Context, req *WorkRequest, providers []WorkProvider) (string, error) {
+	work, err := p.firstResult(ctx, req, providers[:1], p.providerTimeout(providers[0]))
+	if err == nil || ctx.Err() != nil || len(providers) < 2 {
+		return work, err
+	}
+	log.Infof("Primary work provider %s failed for %s: %v", providers[0].Name(), req.Hash, err)
+	return p.raceProviders(ctx, req, providers[1:])
+}
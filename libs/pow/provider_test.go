@@ -0,0 +1,184 @@
+package pow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/pow/models"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeProvider struct {
+	name  string
+	work  string
+	err   error
+	delay time.Duration
+	calls int
+	mutex sync.Mutex
+}
+
+func (fp *fakeProvider) Name() string {
+	return fp.name
+}
+
+func (fp *fakeProvider) Available(req *WorkRequest) bool {
+	return true
+}
+
+func (fp *fakeProvider) GenerateWork(ctx context.Context, req *WorkRequest) (string, error) {
+	fp.mutex.Lock()
+	fp.calls++
+	fp.mutex.Unlock()
+	select {
+	case <-time.After(fp.delay):
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+	return fp.work, fp.err
+}
+
+func (fp *fakeProvider) Calls() int {
+	fp.mutex.Lock()
+	defer fp.mutex.Unlock()
+	return fp.calls
+}
+
+func newProviderPow(strategy string, providers ...*fakeProvider) *PippinPow {
+	p := NewPippinPow([]string{}, "", "", 1, 0)
+	p.Strategy = strategy
+	names := []string{}
+	for _, provider := range providers {
+		p.RegisterProvider(provider)
+		names = append(names, provider.name)
+	}
+	p.UseProviders(names)
+	return p
+}
+
+func TestRaceStrategy(t *testing.T) {
+	slow := &fakeProvider{name: "slow", work: "slow", delay: time.Millisecond * 200}
+	fast := &fakeProvider{name: "fast", work: "fast"}
+	p := newProviderPow(StrategyRace, slow, fast)
+	work, err := p.WorkGenerateMeta(context.Background(), "abcdef", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "fast", work)
+	// Both were asked
+	assert.Eventually(t, func() bool { return slow.Calls() == 1 }, time.Second, time.Millisecond*10)
+
+	// Everybody failing
+	fast.err = errors.New("broken")
+	slow.err = errors.New("broken")
+	_, err = p.WorkGenerateMeta(context.Background(), "abcdef", 1, false, false, "")
+	assert.ErrorContains(t, err, "Unable to generate work")
+}
+
+func TestFallbackStrategy(t *testing.T) {
+	broken := &fakeProvider{name: "broken", err: errors.New("broken")}
+	// Longer than the 1 second timeout
+	hanging := &fakeProvider{name: "hanging", work: "hanging", delay: time.Second * 5}
+	working := &fakeProvider{name: "working", work: "working"}
+	unused := &fakeProvider{name: "unused", work: "unused"}
+	p := newProviderPow(StrategyFallback, broken, hanging, working, unused)
+	work, err := p.WorkGenerateMeta(context.Background(), "abcdef", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "working", work)
+	assert.Equal(t, 1, broken.Calls())
+	assert.Equal(t, 1, hanging.Calls())
+	assert.Equal(t, 0, unused.Calls())
+}
+
+func TestPrimaryStrategy(t *testing.T) {
+	primary := &fakeProvider{name: "primary", work: "primary"}
+	secondary := &fakeProvider{name: "secondary", work: "secondary"}
+	p := newProviderPow(StrategyPrimary, primary, secondary)
+	work, err := p.WorkGenerateMeta(context.Background(), "abcdef", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "primary", work)
+	assert.Equal(t, 0, secondary.Calls())
+
+	primary.err = errors.New("broken")
+	work, err = p.WorkGenerateMeta(context.Background(), "abcdef", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "secondary", work)
+}
+
+func TestUseProviders(t *testing.T) {
+	p := NewPippinPow([]string{}, "", "", 30, 1)
+	assert.ErrorIs(t, p.UseProviders([]string{ProviderPeers, ProviderNode}), ErrUnknownWorkProvider)
+	p.EnableNodeWorkGenerate(&testNodeClient{})
+	assert.Nil(t, p.UseProviders([]string{ProviderPeers, ProviderNode}))
+
+	// Nothing left that can take it
+	assert.Nil(t, p.UseProviders([]string{ProviderPeers}))
+	_, err := p.WorkGenerateMeta(context.Background(), "abcdef", 1, false, false, "")
+	assert.ErrorIs(t, err, ErrNoWorkProvider)
+}
+
+// Answers like a node would, the rpc client's failover isn't needed here
+type testNodeClient struct {
+	actions chan string
+	work    string
+}
+
+func (nc *testNodeClient) MakeRequest(ctx context.Context, request interface{}) ([]byte, error) {
+	var action string
+	switch r := request.(type) {
+	case models.WorkGenerateRequest:
+		action = r.Action
+	case models.WorkBaseRequest:
+		action = r.Action
+	}
+	nc.actions <- action
+	if nc.work == "" {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return json.Marshal(map[string]interface{}{"work": nc.work})
+}
+
+func TestNodeProvider(t *testing.T) {
+	// node_work_generate without anything else
+	client := &testNodeClient{actions: make(chan string, 2), work: "fromnode"}
+	p := NewPippinPow([]string{}, "", "", 30, 0)
+	p.EnableNodeWorkGenerate(client)
+	work, err := p.WorkGenerateMeta(context.Background(), "abcdef", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "fromnode", work)
+	assert.Equal(t, "work_generate", <-client.actions)
+
+	// Given up on, the node is told to stop
+	client.work = ""
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	_, err = p.WorkGenerateMeta(ctx, "abcdef", 1, false, false, "")
+	assert.NotNil(t, err)
+	assert.Equal(t, "work_generate", <-client.actions)
+	assert.Equal(t, "work_cancel", <-client.actions)
+}
+
+func TestCacheProvider(t *testing.T) {
+	hash := "09263b65752d05ce4df5aeed849ffc2be5bf47026abb4fa5879359ae571ba9c8"
+	p := NewPippinPow([]string{}, "", "", 30, 1)
+	work, err := p.WorkGenerateMeta(context.Background(), hash, 1, true, false, "")
+	assert.Nil(t, err)
+
+	// Only the cache can answer now
+	assert.Nil(t, p.UseProviders([]string{ProviderCache}))
+	cached, err := p.WorkGenerateMeta(context.Background(), hash, 1, true, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, work, cached)
+
+	// Unless the work doesn't meet the difficulty
+	if !IsWorkValid(hash, 64, work) {
+		_, err = p.WorkGenerateMeta(context.Background(), hash, 64, true, false, "")
+		assert.ErrorIs(t, err, ErrNoWorkProvider)
+	}
+
+	// Precomputed work is checked too
+	p.CacheWork("ABCDEF", "precomputed")
+	assert.False(t, (&cacheProvider{cache: p.cache}).Available(&WorkRequest{Hash: "abcdef", Multiplier: 1}))
+}
@@ -0,0 +1,377 @@
+package workserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/pow/models"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+)
+
+var ErrCancelled = errors.New("Cancelled")
+
+const (
+	priorityHigh = iota
+	priorityLow
+)
+
+type job struct {
+	hash       string
+	multiplier int
+	priority   int
+	running    bool
+	waiters    int
+	ctx        context.Context
+	cancel     context.CancelFunc
+	done       chan struct{}
+	work       string
+	err        error
+}
+
+// Implements the nano-work-server protocol on top of PippinPow, so nodes and wallets can use pippin as a work peer
+// Requests are queued, sends and changes go before receives
+// Concurrent requests for the same hash share one job, work_cancel stops it whether queued or running
+type WorkServer struct {
+	pow     *pow.PippinPow
+	banano  bool
+	key     string
+	workers int
+	// One queue per priority
+	queues [2][]*job
+	// Every queued or running job by hash
+	jobs map[string][]*job
+	wake chan struct{}
+	// Every job's context derives from it, Close cancels it
+	ctx    context.Context
+	cancel context.CancelFunc
+	mutex  sync.Mutex
+}
+
+// key is optional, when set requests need it in the Authorization header
+func NewWorkServer(p *pow.PippinPow, banano bool, key string, workers int) *WorkServer {
+	if workers < 1 {
+		workers = 1
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &WorkServer{
+		pow:     p,
+		banano:  banano,
+		key:     key,
+		workers: workers,
+		jobs:    make(map[string][]*job),
+		wake:    make(chan struct{}, 1),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Processes the queue until ctx is done or the server is closed
+// Returns once the running jobs have stopped
+func (s *WorkServer) Start(ctx context.Context) {
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.Close()
+		case <-s.ctx.Done():
+		}
+	}()
+	var wg sync.WaitGroup
+	for i := 0; i < s.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.work()
+		}()
+	}
+	wg.Wait()
+}
+
+// Cancels every queued and running job, new requests are cancelled right away
+func (s *WorkServer) Close() {
+	s.cancel()
+	s.mutex.Lock()
+	var queued []*job
+	for _, queue := range s.queues {
+		queued = append(queued, queue...)
+	}
+	s.mutex.Unlock()
+	for _, j := range queued {
+		s.cancelJob(j)
+	}
+}
+
+func (s *WorkServer) work() {
+	for {
+		j := s.next()
+		if j == nil {
+			select {
+			case <-s.wake:
+				continue
+			case <-s.ctx.Done():
+				return
+			}
+		}
+		work, err := s.pow.WorkGenerateMeta(j.ctx, j.hash, j.multiplier, true, false, "")
+		if j.ctx.Err() != nil {
+			err = ErrCancelled
+		}
+		s.finish(j, work, err)
+	}
+}
+
+// Pops the next job, highest priority first
+func (s *WorkServer) next() *job {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for priority := range s.queues {
+		if len(s.queues[priority]) > 0 {
+			j := s.queues[priority][0]
+			s.queues[priority] = s.queues[priority][1:]
+			j.running = true
+			return j
+		}
+	}
+	return nil
+}
+
+func (s *WorkServer) finish(j *job, work string, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.removeJob(j)
+	j.work = work
+	j.err = err
+	j.cancel()
+	close(j.done)
+}
+
+// Must hold the mutex
+func (s *WorkServer) removeJob(j *job) {
+	jobs := s.jobs[j.hash]
+	for i, other := range jobs {
+		if other == j {
+			jobs = append(jobs[:i], jobs[i+1:]...)
+			break
+		}
+	}
+	if len(jobs) == 0 {
+		delete(s.jobs, j.hash)
+	} else {
+		s.jobs[j.hash] = jobs
+	}
+	if !j.running {
+		queue := s.queues[j.priority]
+		for i, other := range queue {
+			if other == j {
+				s.queues[j.priority] = append(queue[:i], queue[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// Queues work for hash, or joins a job that is already working on it at the same or higher difficulty
+func (s *WorkServer) enqueue(hash string, multiplier int, priority int) *job {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.ctx.Err() != nil {
+		// Closed, nobody would work on it
+		done := make(chan struct{})
+		close(done)
+		return &job{hash: hash, err: ErrCancelled, done: done}
+	}
+	for _, j := range s.jobs[hash] {
+		if j.multiplier >= multiplier {
+			j.waiters++
+			return j
+		}
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	j := &job{
+		hash:       hash,
+		multiplier: multiplier,
+		priority:   priority,
+		waiters:    1,
+		ctx:        ctx,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+	}
+	s.jobs[hash] = append(s.jobs[hash], j)
+	s.queues[priority] = append(s.queues[priority], j)
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+	return j
+}
+
+// A requester went away, the job is cancelled when nobody is waiting on it anymore
+func (s *WorkServer) leave(j *job) {
+	s.mutex.Lock()
+	j.waiters--
+	abandoned := j.waiters < 1
+	s.mutex.Unlock()
+	if abandoned {
+		s.cancelJob(j)
+	}
+}
+
+func (s *WorkServer) cancelJob(j *job) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if j.running {
+		// The worker finishes it
+		j.cancel()
+		return
+	}
+	select {
+	case <-j.done:
+		return
+	default:
+	}
+	s.removeJob(j)
+	j.err = ErrCancelled
+	j.cancel()
+	close(j.done)
+}
+
+// Cancels every queued or running job for hash
+func (s *WorkServer) Cancel(hash string) {
+	s.mutex.Lock()
+	jobs := append([]*job{}, s.jobs[hash]...)
+	s.mutex.Unlock()
+	for _, j := range jobs {
+		s.cancelJob(j)
+	}
+}
+
+// Generates work for hash at difficulty, waits until it's done, cancelled or ctx is done
+func (s *WorkServer) Generate(ctx context.Context, hash string, difficulty uint64) (string, error) {
+	// Multipliers are whole numbers, round up so the work always meets difficulty
+	multiplier := pow.MultiplierFromDifficulty(difficulty)
+	if pow.DifficultyFromMultiplier(multiplier) < difficulty {
+		multiplier++
+	}
+	priority := priorityLow
+	if s.banano || difficulty >= pow.SendThreshold {
+		priority = priorityHigh
+	}
+	j := s.enqueue(hash, multiplier, priority)
+	select {
+	case <-j.done:
+		return j.work, j.err
+	case <-ctx.Done():
+		s.leave(j)
+		return "", ctx.Err()
+	}
+}
+
+// The threshold everything is relative to, like the node's network_minimum
+func (s *WorkServer) baseDifficulty() uint64 {
+	if s.banano {
+		return pow.ReceiveThreshold
+	}
+	return pow.SendThreshold
+}
+
+// Difficulty from the request, either as difficulty or as multiplier of the base
+func (s *WorkServer) parseDifficulty(rawRequest map[string]interface{}) (uint64, error) {
+	if difficulty, ok := rawRequest["difficulty"].(string); ok && difficulty != "" {
+		return strconv.ParseUint(difficulty, 16, 64)
+	}
+	if multiplierStr, ok := rawRequest["multiplier"].(string); ok && multiplierStr != "" {
+		multiplier, err := strconv.ParseFloat(multiplierStr, 64)
+		if err != nil {
+			return 0, err
+		}
+		return pow.DifficultyFromMultiplierFloat(multiplier, s.baseDifficulty())
+	}
+	return s.baseDifficulty(), nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(response)
+}
+
+func writeError(w http.ResponseWriter, status int, err string) {
+	writeJSON(w, status, map[string]string{"error": err})
+}
+
+func (s *WorkServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	if s.key != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(s.key)) != 1 {
+		writeError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	var rawRequest map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&rawRequest); err != nil {
+		writeError(w, http.StatusBadRequest, "Unable to parse json")
+		return
+	}
+	hash, _ := rawRequest["hash"].(string)
+	if !utils.Validate64HexHash(hash) {
+		writeError(w, http.StatusBadRequest, "Invalid hash")
+		return
+	}
+
+	switch rawRequest["action"] {
+	case "work_generate":
+		difficulty, err := s.parseDifficulty(rawRequest)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid difficulty")
+			return
+		}
+		work, err := s.Generate(r.Context(), hash, difficulty)
+		if errors.Is(err, ErrCancelled) {
+			writeError(w, http.StatusOK, ErrCancelled.Error())
+			return
+		} else if err != nil {
+			log.Errorf("Work server unable to generate work for %s: %v", hash, err)
+			writeError(w, http.StatusInternalServerError, "Failed to generate work")
+			return
+		}
+		validated, err := pow.ValidateWork(hash, work, 0, s.banano)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "Failed to generate work")
+			return
+		}
+		writeJSON(w, http.StatusOK, models.WorkGenerateResponse{
+			Work:       work,
+			Difficulty: validated.Difficulty,
+			Multiplier: validated.Multiplier,
+			Hash:       hash,
+		})
+	case "work_cancel":
+		s.Cancel(hash)
+		writeJSON(w, http.StatusOK, map[string]string{})
+	case "work_validate":
+		work, _ := rawRequest["work"].(string)
+		var difficulty uint64
+		if _, ok := rawRequest["difficulty"]; ok {
+			var err error
+			if difficulty, err = s.parseDifficulty(rawRequest); err != nil {
+				writeError(w, http.StatusBadRequest, "Invalid difficulty")
+				return
+			}
+		}
+		resp, err := pow.ValidateWork(hash, work, difficulty, s.banano)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid work")
+			return
+		}
+		writeJSON(w, http.StatusOK, resp)
+	default:
+		writeError(w, http.StatusBadRequest, "Unknown action")
+	}
+}
@@ -0,0 +1,208 @@
+package workserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/pow/powtest"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const knownHash = powtest.KnownHash
+const otherHash = "09263B65752D05CE4DF5AEED849FFC2BE5BF47026ABB4FA5879359AE571BA9C8"
+
+func request(t *testing.T, s *WorkServer, body map[string]interface{}, authorization string) (int, map[string]interface{}) {
+	encoded, _ := json.Marshal(body)
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(encoded))
+	req.Header.Set("Content-Type", "application/json")
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Nil(t, json.Unmarshal(respBody, &respJson))
+	return resp.StatusCode, respJson
+}
+
+func TestWorkServerProtocol(t *testing.T) {
+	provider := powtest.NewProvider()
+	s := NewWorkServer(powtest.NewPippinPow(provider), false, "secret", 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Start(ctx)
+
+	// Authentication
+	status, resp := request(t, s, map[string]interface{}{"action": "work_generate", "hash": knownHash}, "")
+	assert.Equal(t, 401, status)
+	assert.Equal(t, "Unauthorized", resp["error"])
+
+	// Generate
+	status, resp = request(t, s, map[string]interface{}{"action": "work_generate", "hash": knownHash}, "secret")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "205452237a9b01f4", resp["work"])
+	assert.Equal(t, "ffffffff287741bf", resp["difficulty"])
+	assert.Equal(t, "9.501974378880858", resp["multiplier"])
+	assert.Equal(t, knownHash, resp["hash"])
+
+	// Bad input
+	status, resp = request(t, s, map[string]interface{}{"action": "work_generate", "hash": "1234"}, "secret")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "Invalid hash", resp["error"])
+	status, resp = request(t, s, map[string]interface{}{"action": "work_generate", "hash": knownHash, "difficulty": "xyz"}, "secret")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "Invalid difficulty", resp["error"])
+	status, resp = request(t, s, map[string]interface{}{"action": "work_sign", "hash": knownHash}, "secret")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "Unknown action", resp["error"])
+
+	// Validate
+	status, resp = request(t, s, map[string]interface{}{"action": "work_validate", "hash": knownHash, "work": "205452237a9b01f4", "difficulty": "ffffffffffff0000"}, "secret")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "0", resp["valid"])
+	assert.Equal(t, "1", resp["valid_all"])
+	assert.Equal(t, "1", resp["valid_receive"])
+	status, resp = request(t, s, map[string]interface{}{"action": "work_validate", "hash": knownHash, "work": "2054"}, "secret")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "Invalid work", resp["error"])
+
+	// Work source failing or returning invalid work
+	provider.SetError(errors.New("broken"))
+	status, resp = request(t, s, map[string]interface{}{"action": "work_generate", "hash": knownHash}, "secret")
+	assert.Equal(t, 500, status)
+	assert.Equal(t, "Failed to generate work", resp["error"])
+	provider.Reset()
+	provider.SetInvalid(true)
+	status, _ = request(t, s, map[string]interface{}{"action": "work_generate", "hash": knownHash}, "secret")
+	assert.Equal(t, 500, status)
+	provider.Reset()
+
+	// Cancel of an unknown hash is fine
+	status, _ = request(t, s, map[string]interface{}{"action": "work_cancel", "hash": otherHash}, "secret")
+	assert.Equal(t, 200, status)
+}
+
+func TestWorkServerQueue(t *testing.T) {
+	s := NewWorkServer(pow.NewPippinPow([]string{}, "", "", 30, 1), false, "", 1)
+
+	// Sends before receives
+	receive := s.enqueue(knownHash, 1, priorityLow)
+	send := s.enqueue(otherHash, 64, priorityHigh)
+	assert.Equal(t, send, s.next())
+	assert.Equal(t, receive, s.next())
+	assert.Nil(t, s.next())
+
+	// Same hash at the same or lower difficulty joins the running job
+	assert.Equal(t, receive, s.enqueue(knownHash, 1, priorityLow))
+	assert.Equal(t, 2, receive.waiters)
+	// Higher difficulty needs its own
+	assert.NotEqual(t, receive, s.enqueue(knownHash, 64, priorityHigh))
+	assert.Len(t, s.jobs[knownHash], 2)
+}
+
+func TestWorkServerCancel(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// This peer never answers work_generate until released
+	release := make(chan struct{})
+	defer close(release)
+	httpmock.RegisterResponder("POST", "http://slowpeer",
+		func(req *http.Request) (*http.Response, error) {
+			var body map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&body)
+			if body["action"] == "work_generate" {
+				<-release
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{})
+		},
+	)
+
+	p := pow.NewPippinPow([]string{"http://slowpeer"}, "", "", 30, 0)
+	s := NewWorkServer(p, false, "", 1)
+
+	// Cancel while queued, no workers yet
+	result := make(chan error, 1)
+	go func() {
+		_, err := s.Generate(context.Background(), otherHash, pow.SendThreshold)
+		result <- err
+	}()
+	assert.Eventually(t, func() bool {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		return len(s.queues[priorityHigh]) == 1
+	}, time.Second, time.Millisecond*10)
+	s.Cancel(otherHash)
+	assert.ErrorIs(t, <-result, ErrCancelled)
+	assert.Len(t, s.jobs, 0)
+	assert.Len(t, s.queues[priorityHigh], 0)
+
+	// Cancel while running
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopped := make(chan struct{})
+	go func() {
+		s.Start(ctx)
+		close(stopped)
+	}()
+	go func() {
+		_, err := s.Generate(context.Background(), otherHash, pow.SendThreshold)
+		result <- err
+	}()
+	assert.Eventually(t, func() bool {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		return len(s.jobs[otherHash]) == 1 && s.jobs[otherHash][0].running
+	}, time.Second, time.Millisecond*10)
+	status, _ := request(t, s, map[string]interface{}{"action": "work_cancel", "hash": otherHash}, "")
+	assert.Equal(t, 200, status)
+	assert.ErrorIs(t, <-result, ErrCancelled)
+
+	// The last requester leaving cancels the job too
+	reqCtx, reqCancel := context.WithCancel(context.Background())
+	go func() {
+		_, err := s.Generate(reqCtx, otherHash, pow.SendThreshold)
+		result <- err
+	}()
+	assert.Eventually(t, func() bool {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		return len(s.jobs[otherHash]) == 1 && s.jobs[otherHash][0].running
+	}, time.Second, time.Millisecond*10)
+	reqCancel()
+	assert.ErrorIs(t, <-result, context.Canceled)
+	assert.Eventually(t, func() bool {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		return len(s.jobs) == 0
+	}, time.Second, time.Millisecond*10)
+
+	// Shutting down cancels what's running and whatever comes after
+	go func() {
+		_, err := s.Generate(context.Background(), otherHash, pow.SendThreshold)
+		result <- err
+	}()
+	assert.Eventually(t, func() bool {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		return len(s.jobs[otherHash]) == 1 && s.jobs[otherHash][0].running
+	}, time.Second, time.Millisecond*10)
+	cancel()
+	assert.ErrorIs(t, <-result, ErrCancelled)
+	<-stopped
+	_, err := s.Generate(context.Background(), knownHash, pow.SendThreshold)
+	assert.ErrorIs(t, err, ErrCancelled)
+	p.Wait()
+}
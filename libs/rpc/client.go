@@ -1,11 +1,12 @@
 package rpc
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
-	"io"
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,50 +19,48 @@ import (
 var ErrAccountNotFound = errors.New("Account not found")
 
 type RPCClient struct {
-	Url        string
+	// The primary node
+	Url string
+	// How many nodes have to agree on account_info, 0 or 1 asks a single node
+	Quorum     int
+	nodes      []*rpcNode
 	httpClient *http.Client
 }
 
-func NewRPCClient(url string) *RPCClient {
-	return &RPCClient{
-		Url: url,
+// Requests go to the first url, the others take over when it fails
+func NewRPCClient(urls ...string) *RPCClient {
+	client := &RPCClient{
 		httpClient: &http.Client{
 			Timeout: time.Second * 30, // Set a timeout for all requests
 		},
 	}
+	for _, url := range urls {
+		client.nodes = append(client.nodes, newRPCNode(url))
+	}
+	if len(urls) > 0 {
+		client.Url = urls[0]
+	}
+	return client
 }
 
 // Base request
-func (client *RPCClient) MakeRequest(request interface{}) ([]byte, error) {
+func (client *RPCClient) MakeRequest(ctx context.Context, request interface{}) ([]byte, error) {
 	requestBody, err := json.Marshal(request)
 	if err != nil {
 		log.Errorf("Error marshalling request %s", err)
 		return nil, err
 	}
-	// HTTP post
-	resp, err := client.httpClient.Post(client.Url, "application/json", bytes.NewBuffer(requestBody))
-	if err != nil {
-		log.Errorf("Error making RPC request %s", err)
-		return nil, err
-	}
-	defer resp.Body.Close()
-	// Try to decode+deserialize
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		log.Errorf("Error decoding response body %s", err)
-		return nil, err
-	}
-	return body, nil
+	return client.postFailover(ctx, requestBody)
 }
 
-func (client *RPCClient) MakeAccountsBalancesRequest(accounts []string) (*responses.AccountsBalancesResponse, error) {
+func (client *RPCClient) MakeAccountsBalancesRequest(ctx context.Context, accounts []string) (*responses.AccountsBalancesResponse, error) {
 	request := requests.AccountsRequest{
 		BaseRequest: requests.BaseRequest{
 			Action: "accounts_balances",
 		},
 		Accounts: accounts,
 	}
-	response, err := client.MakeRequest(request)
+	response, err := client.MakeRequest(ctx, request)
 	if err != nil {
 		log.Errorf("Error making request %s", err)
 		return nil, err
@@ -94,14 +93,14 @@ func (client *RPCClient) MakeAccountsBalancesRequest(accounts []string) (*respon
 	return &decoded, nil
 }
 
-func (client *RPCClient) MakeAccountBalanceRequest(account string) (*responses.AccountBalanceItem, error) {
+func (client *RPCClient) MakeAccountBalanceRequest(ctx context.Context, account string) (*responses.AccountBalanceItem, error) {
 	request := requests.AccountRequest{
 		BaseRequest: requests.BaseRequest{
 			Action: "account_balance",
 		},
 		Account: account,
 	}
-	response, err := client.MakeRequest(request)
+	response, err := client.MakeRequest(ctx, request)
 	if err != nil {
 		log.Errorf("Error making request %s", err)
 		return nil, err
@@ -134,14 +133,14 @@ func (client *RPCClient) MakeAccountBalanceRequest(account string) (*responses.A
 	return &decoded, nil
 }
 
-func (client *RPCClient) MakeAccountsFrontiersRequest(accounts []string) (*responses.AccountsFrontiersResponse, error) {
+func (client *RPCClient) MakeAccountsFrontiersRequest(ctx context.Context, accounts []string) (*responses.AccountsFrontiersResponse, error) {
 	request := requests.AccountsRequest{
 		BaseRequest: requests.BaseRequest{
 			Action: "accounts_frontiers",
 		},
 		Accounts: accounts,
 	}
-	response, err := client.MakeRequest(request)
+	response, err := client.MakeRequest(ctx, request)
 	if err != nil {
 		log.Errorf("Error making request %s", err)
 		return nil, err
@@ -174,14 +173,14 @@ func (client *RPCClient) MakeAccountsFrontiersRequest(accounts []string) (*respo
 	return &decoded, nil
 }
 
-func (client *RPCClient) MakeAccountsPendingRequest(accounts []string) (*responses.AccountsPendingResponse, error) {
+func (client *RPCClient) MakeAccountsPendingRequest(ctx context.Context, accounts []string) (*responses.AccountsPendingResponse, error) {
 	request := requests.AccountsRequest{
 		BaseRequest: requests.BaseRequest{
 			Action: "accounts_pending",
 		},
 		Accounts: accounts,
 	}
-	response, err := client.MakeRequest(request)
+	response, err := client.MakeRequest(ctx, request)
 	if err != nil {
 		log.Errorf("Error making request %s", err)
 		return nil, err
@@ -226,7 +225,62 @@ func (client *RPCClient) MakeAccountsPendingRequest(accounts []string) (*respons
 	return &decoded, nil
 }
 
-func (client *RPCClient) MakeBlockInfoRequest(hash string) (*responses.BlockInfoResponse, error) {
+// Receivable blocks above threshold for every account, accounts without any are left out
+func (client *RPCClient) MakeAccountsReceivableRequest(ctx context.Context, accounts []string, threshold string) (*responses.AccountsReceivableResponse, error) {
+	request := requests.AccountsReceivableRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "accounts_receivable",
+		},
+		Accounts:             accounts,
+		Threshold:            threshold,
+		IncludeOnlyConfirmed: true,
+	}
+	response, err := client.MakeRequest(ctx, request)
+	if err != nil {
+		log.Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		log.Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+
+	decoded := responses.AccountsReceivableResponse{
+		Blocks: make(map[string]map[string]string),
+	}
+	// Blocks, and each account's blocks, may be an empty string or array when there's nothing
+	blocks, ok := resp["blocks"].(map[string]interface{})
+	if !ok {
+		return &decoded, nil
+	}
+	for account, accountBlocks := range blocks {
+		if _, ok := accountBlocks.(map[string]interface{}); !ok {
+			continue
+		}
+		var hashes map[string]string
+		if err := mapstructure.Decode(accountBlocks, &hashes); err != nil {
+			log.Errorf("Error decoding response %s", err)
+			return nil, err
+		}
+		if len(hashes) > 0 {
+			decoded.Blocks[account] = hashes
+		}
+	}
+
+	return &decoded, nil
+}
+
+func (client *RPCClient) MakeBlockInfoRequest(ctx context.Context, hash string) (*responses.BlockInfoResponse, error) {
 	request := requests.BlockInfoRequest{
 		BaseRequest: requests.BaseRequest{
 			Action: "block_info",
@@ -234,7 +288,7 @@ func (client *RPCClient) MakeBlockInfoRequest(hash string) (*responses.BlockInfo
 		Hash:      hash,
 		JsonBlock: true,
 	}
-	response, err := client.MakeRequest(request)
+	response, err := client.MakeRequest(ctx, request)
 	if err != nil {
 		log.Errorf("Error making request %s", err)
 		return nil, err
@@ -263,14 +317,43 @@ func (client *RPCClient) MakeBlockInfoRequest(hash string) (*responses.BlockInfo
 	return &decoded, nil
 }
 
-func (client *RPCClient) MakeProcessRequest(request requests.ProcessRequest) (*responses.ProcessResponse, error) {
-	response, err := client.MakeRequest(request)
+// Broadcast to every healthy node, so the block gets out even if one of them is stuck
+func (client *RPCClient) MakeProcessRequest(ctx context.Context, request requests.ProcessRequest) (*responses.ProcessResponse, error) {
+	requestBody, err := json.Marshal(request)
 	if err != nil {
-		log.Errorf("Error making request %s", err)
+		log.Errorf("Error marshalling request %s", err)
 		return nil, err
 	}
+	if len(client.nodes) < 2 {
+		response, err := client.postFailover(ctx, requestBody)
+		if err != nil {
+			log.Errorf("Error making request %s", err)
+			return nil, err
+		}
+		return decodeProcessResponse(response)
+	}
+	// Any node taking it is enough, otherwise the error of the first one that answered
+	var firstErr error
+	for _, response := range client.postAll(ctx, requestBody) {
+		if response == nil {
+			continue
+		}
+		decoded, err := decodeProcessResponse(response)
+		if err == nil {
+			return decoded, nil
+		} else if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if firstErr == nil {
+		firstErr = errors.New("No node answered")
+	}
+	return nil, firstErr
+}
+
+func decodeProcessResponse(response []byte) (*responses.ProcessResponse, error) {
 	var resp map[string]interface{}
-	err = json.Unmarshal(response, &resp)
+	err := json.Unmarshal(response, &resp)
 	if err != nil {
 		log.Errorf("Error unmarshalling response %s", err)
 		return nil, err
@@ -296,7 +379,7 @@ func (client *RPCClient) MakeProcessRequest(request requests.ProcessRequest) (*r
 }
 
 // {"error":"Account not found"}
-func (client *RPCClient) MakeAccountInfoRequest(account string) (*responses.AccountInfoResponse, error) {
+func (client *RPCClient) MakeAccountInfoRequest(ctx context.Context, account string) (*responses.AccountInfoResponse, error) {
 	includeAll := true
 	request := requests.AccountInfoRequest{
 		AccountRequest: requests.AccountRequest{
@@ -310,13 +393,57 @@ func (client *RPCClient) MakeAccountInfoRequest(account string) (*responses.Acco
 		Pending:          &includeAll,
 		IncludeConfirmed: &includeAll,
 	}
-	response, err := client.MakeRequest(request)
+	if client.Quorum > 1 {
+		return client.accountInfoQuorum(ctx, request)
+	}
+	response, err := client.MakeRequest(ctx, request)
 	if err != nil {
 		log.Errorf("Error making request %s", err)
 		return nil, err
 	}
+	return decodeAccountInfoResponse(response)
+}
+
+// Asks every healthy node, at least Quorum of them have to return the same frontier, balance and representative
+func (client *RPCClient) accountInfoQuorum(ctx context.Context, request requests.AccountInfoRequest) (*responses.AccountInfoResponse, error) {
+	requestBody, err := json.Marshal(request)
+	if err != nil {
+		log.Errorf("Error marshalling request %s", err)
+		return nil, err
+	}
+	type answer struct {
+		info  *responses.AccountInfoResponse
+		err   error
+		votes int
+	}
+	answers := map[string]*answer{}
+	for _, response := range client.postAll(ctx, requestBody) {
+		if response == nil {
+			continue
+		}
+		info, err := decodeAccountInfoResponse(response)
+		var key string
+		if errors.Is(err, ErrAccountNotFound) {
+			key = "not found"
+		} else if err != nil {
+			continue
+		} else {
+			key = fmt.Sprintf("%s:%s:%s", info.Frontier, info.Balance, info.Representative)
+		}
+		if _, ok := answers[key]; !ok {
+			answers[key] = &answer{info: info, err: err}
+		}
+		answers[key].votes++
+		if answers[key].votes >= client.Quorum {
+			return answers[key].info, answers[key].err
+		}
+	}
+	return nil, ErrNoQuorum
+}
+
+func decodeAccountInfoResponse(response []byte) (*responses.AccountInfoResponse, error) {
 	var resp map[string]interface{}
-	err = json.Unmarshal(response, &resp)
+	err := json.Unmarshal(response, &resp)
 	if err != nil {
 		log.Errorf("Error unmarshalling response %s", err)
 		return nil, err
@@ -342,7 +469,7 @@ func (client *RPCClient) MakeAccountInfoRequest(account string) (*responses.Acco
 	return &decoded, nil
 }
 
-func (client *RPCClient) MakeReceivableRequest(account string, threshold string) (*responses.ReceivableResponse, error) {
+func (client *RPCClient) MakeReceivableRequest(ctx context.Context, account string, threshold string) (*responses.ReceivableResponse, error) {
 	request := requests.ReceivableRequest{
 		BaseRequest: requests.BaseRequest{
 			Action: "receivable",
@@ -351,7 +478,7 @@ func (client *RPCClient) MakeReceivableRequest(account string, threshold string)
 		Threshold:            threshold,
 		IncludeOnlyConfirmed: true,
 	}
-	response, err := client.MakeRequest(request)
+	response, err := client.MakeRequest(ctx, request)
 	if err != nil {
 		log.Errorf("Error making request %s", err)
 		return nil, err
@@ -402,3 +529,200 @@ func (client *RPCClient) MakeReceivableRequest(account string, threshold string)
 
 	return &decoded, nil
 }
+
+func (client *RPCClient) MakeActiveDifficultyRequest(ctx context.Context) (*responses.ActiveDifficultyResponse, error) {
+	request := requests.BaseRequest{
+		Action: "active_difficulty",
+	}
+	response, err := client.MakeRequest(ctx, request)
+	if err != nil {
+		log.Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		log.Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	var decoded responses.ActiveDifficultyResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		log.Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+
+	if decoded.Multiplier == "" {
+		return nil, errors.New("No multiplier returned")
+	}
+
+	return &decoded, nil
+}
+
+// Implements pow.ActiveDifficultySource
+func (client *RPCClient) ActiveDifficultyMultiplier(ctx context.Context) (float64, error) {
+	resp, err := client.MakeActiveDifficultyRequest(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(resp.Multiplier, 64)
+}
+
+// Decodes into decoded, dropping the "" or [] the node returns for empty collections
+func (client *RPCClient) makeTypedRequest(ctx context.Context, request interface{}, decoded interface{}) error {
+	response, err := client.MakeRequest(ctx, request)
+	if err != nil {
+		log.Errorf("Error making request %s", err)
+		return err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		log.Errorf("Error unmarshalling response %s", err)
+		return err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			if strings.ToLower(errStr) == "account not found" {
+				return ErrAccountNotFound
+			}
+			return errors.New(errStr)
+		}
+		return errors.New("Unknown error")
+	}
+	for key, val := range resp {
+		switch v := val.(type) {
+		case string:
+			if v == "" {
+				delete(resp, key)
+			}
+		case []interface{}:
+			if len(v) == 0 {
+				delete(resp, key)
+			}
+		}
+	}
+	err = mapstructure.Decode(resp, decoded)
+	if err != nil {
+		log.Errorf("Error decoding response %s", err)
+		return err
+	}
+	return nil
+}
+
+// Up to count blocks starting at head, or the frontier if head is empty
+func (client *RPCClient) MakeAccountHistoryRequest(ctx context.Context, account string, count int, head string) (*responses.AccountHistoryResponse, error) {
+	request := requests.AccountHistoryRequest{
+		AccountRequest: requests.AccountRequest{
+			BaseRequest: requests.BaseRequest{
+				Action: "account_history",
+			},
+			Account: account,
+		},
+		Count: strconv.Itoa(count),
+		Head:  head,
+	}
+	var decoded responses.AccountHistoryResponse
+	if err := client.makeTypedRequest(ctx, request, &decoded); err != nil {
+		return nil, err
+	}
+	return &decoded, nil
+}
+
+// Hashes the node doesn't know end up in BlocksNotFound
+func (client *RPCClient) MakeBlocksInfoRequest(ctx context.Context, hashes []string) (*responses.BlocksInfoResponse, error) {
+	request := requests.BlocksInfoRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "blocks_info",
+		},
+		JsonBlock:       true,
+		IncludeNotFound: true,
+		Hashes:          hashes,
+	}
+	var decoded responses.BlocksInfoResponse
+	if err := client.makeTypedRequest(ctx, request, &decoded); err != nil {
+		return nil, err
+	}
+	return &decoded, nil
+}
+
+// Recently confirmed elections, only the one for hash if it's not empty
+func (client *RPCClient) MakeConfirmationHistoryRequest(ctx context.Context, hash string) (*responses.ConfirmationHistoryResponse, error) {
+	request := requests.HashRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "confirmation_history",
+		},
+		Hash: hash,
+	}
+	var decoded responses.ConfirmationHistoryResponse
+	if err := client.makeTypedRequest(ctx, request, &decoded); err != nil {
+		return nil, err
+	}
+	return &decoded, nil
+}
+
+func (client *RPCClient) MakeRepresentativesOnlineRequest(ctx context.Context) (*responses.RepresentativesOnlineResponse, error) {
+	request := requests.RepresentativesOnlineRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "representatives_online",
+		},
+		Weight: true,
+	}
+	var decoded responses.RepresentativesOnlineResponse
+	if err := client.makeTypedRequest(ctx, request, &decoded); err != nil {
+		return nil, err
+	}
+	return &decoded, nil
+}
+
+func (client *RPCClient) MakeDelegatorsRequest(ctx context.Context, account string) (*responses.DelegatorsResponse, error) {
+	request := requests.DelegatorsRequest{
+		AccountRequest: requests.AccountRequest{
+			BaseRequest: requests.BaseRequest{
+				Action: "delegators",
+			},
+			Account: account,
+		},
+	}
+	var decoded responses.DelegatorsResponse
+	if err := client.makeTypedRequest(ctx, request, &decoded); err != nil {
+		return nil, err
+	}
+	return &decoded, nil
+}
+
+func (client *RPCClient) MakeTelemetryRequest(ctx context.Context) (*responses.TelemetryResponse, error) {
+	request := requests.BaseRequest{
+		Action: "telemetry",
+	}
+	var decoded responses.TelemetryResponse
+	if err := client.makeTypedRequest(ctx, request, &decoded); err != nil {
+		return nil, err
+	}
+	return &decoded, nil
+}
+
+// Asks the node to start an election for hash
+func (client *RPCClient) MakeBlockConfirmRequest(ctx context.Context, hash string) (*responses.BlockConfirmResponse, error) {
+	request := requests.HashRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "block_confirm",
+		},
+		Hash: hash,
+	}
+	var decoded responses.BlockConfirmResponse
+	if err := client.makeTypedRequest(ctx, request, &decoded); err != nil {
+		return nil, err
+	}
+	return &decoded, nil
+}
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"os"
@@ -38,7 +39,7 @@ func TestGetAccountsBalances(t *testing.T) {
 		},
 	)
 
-	resp, err := MockRpcClient.MakeAccountsBalancesRequest([]string{"nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"})
+	resp, err := MockRpcClient.MakeAccountsBalancesRequest(context.Background(), []string{"nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"})
 	assert.Nil(t, err)
 	balances := *resp.Balances
 	assert.Equal(t, "11999999999999999918751838129509869131", balances["nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"].Balance)
@@ -57,7 +58,7 @@ func TestGetAccountBalance(t *testing.T) {
 		},
 	)
 
-	resp, err := MockRpcClient.MakeAccountBalanceRequest("nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3")
+	resp, err := MockRpcClient.MakeAccountBalanceRequest(context.Background(), "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3")
 	assert.Nil(t, err)
 	assert.Equal(t, "10000", resp.Balance)
 	assert.Equal(t, "10000", resp.Pending)
@@ -77,7 +78,7 @@ func TestGetAccountsFrontiers(t *testing.T) {
 		},
 	)
 
-	resp, err := MockRpcClient.MakeAccountsFrontiersRequest([]string{"nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"})
+	resp, err := MockRpcClient.MakeAccountsFrontiersRequest(context.Background(), []string{"nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"})
 	assert.Nil(t, err)
 	frontiers := *resp.Frontiers
 	assert.Equal(t, "791AF413173EEE674A6FCF633B5DFC0F3C33F397F0DA08E987D9E0741D40D81A", frontiers["nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"])
@@ -97,7 +98,7 @@ func TestGetAccountsPending(t *testing.T) {
 		},
 	)
 
-	resp, err := MockRpcClient.MakeAccountsPendingRequest([]string{"nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"})
+	resp, err := MockRpcClient.MakeAccountsPendingRequest(context.Background(), []string{"nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"})
 	assert.Nil(t, err)
 	blocks := *resp.Blocks
 	assert.Equal(t, "4C1FEEF0BEA7F50BE35489A1233FE002B212DEA554B55B1B470D78BD8F210C74", blocks["nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"][0])
@@ -117,7 +118,7 @@ func TestGetAccountsPendingEmpty(t *testing.T) {
 		},
 	)
 
-	resp, err := MockRpcClient.MakeAccountsPendingRequest([]string{"nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"})
+	resp, err := MockRpcClient.MakeAccountsPendingRequest(context.Background(), []string{"nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"})
 	assert.Nil(t, err)
 	blocks := *resp.Blocks
 	assert.Empty(t, blocks)
@@ -144,7 +145,7 @@ func TestMakeProcessRequest(t *testing.T) {
 		},
 	)
 
-	resp, err := MockRpcClient.MakeProcessRequest(requests.ProcessRequest{
+	resp, err := MockRpcClient.MakeProcessRequest(context.Background(), requests.ProcessRequest{
 		BaseRequest: requests.BaseRequest{
 			Action: "process",
 		},
@@ -158,7 +159,7 @@ func TestMakeProcessRequest(t *testing.T) {
 	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", resp.Hash)
 
 	// Make an error req
-	resp, err = MockRpcClient.MakeProcessRequest(requests.ProcessRequest{
+	resp, err = MockRpcClient.MakeProcessRequest(context.Background(), requests.ProcessRequest{
 		BaseRequest: requests.BaseRequest{
 			Action: "process",
 		},
@@ -192,7 +193,7 @@ func TestMakeBlockInfoRequest(t *testing.T) {
 		},
 	)
 
-	resp, err := MockRpcClient.MakeBlockInfoRequest("abcd1234")
+	resp, err := MockRpcClient.MakeBlockInfoRequest(context.Background(), "abcd1234")
 
 	assert.Nil(t, err)
 	assert.Equal(t, "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", resp.BlockAccount)
@@ -214,7 +215,7 @@ func TestMakeBlockInfoRequest(t *testing.T) {
 	assert.Equal(t, "send", resp.Subtype)
 
 	// Make an error req
-	resp, err = MockRpcClient.MakeBlockInfoRequest("def")
+	resp, err = MockRpcClient.MakeBlockInfoRequest(context.Background(), "def")
 	assert.NotNil(t, err)
 	assert.Equal(t, "bad input", err.Error())
 }
@@ -240,7 +241,7 @@ func TestMakeAccountInfoRequest(t *testing.T) {
 		},
 	)
 
-	resp, err := MockRpcClient.MakeAccountInfoRequest("abcd1234")
+	resp, err := MockRpcClient.MakeAccountInfoRequest(context.Background(), "abcd1234")
 
 	assert.Nil(t, err)
 	assert.Equal(t, "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F", resp.Frontier)
@@ -260,11 +261,42 @@ func TestMakeAccountInfoRequest(t *testing.T) {
 	assert.Equal(t, "0", resp.ConfirmedReceivable)
 
 	// Make an error req
-	resp, err = MockRpcClient.MakeAccountInfoRequest("def")
+	resp, err = MockRpcClient.MakeAccountInfoRequest(context.Background(), "def")
 	assert.NotNil(t, err)
 	assert.ErrorIs(t, err, ErrAccountNotFound)
 }
 
+func TestMakeAccountsReceivableRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.AccountsReceivableRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			var js map[string]interface{}
+			if pr.Threshold == "1" {
+				json.Unmarshal([]byte(mocks.AccountsReceivableResponseStr), &js)
+			} else {
+				json.Unmarshal([]byte(mocks.AccountsReceivableResponseEmptyStr), &js)
+			}
+			resp, err := httpmock.NewJsonResponse(200, js)
+			return resp, err
+		},
+	)
+
+	resp, err := MockRpcClient.MakeAccountsReceivableRequest(context.Background(), []string{"nano_1111111111111111111111111111111111111111111111111117353trpda", "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"}, "1")
+	assert.Nil(t, err)
+	assert.Len(t, resp.Blocks, 2)
+	assert.Equal(t, "6000000000000000000000000000000", resp.Blocks["nano_1111111111111111111111111111111111111111111111111117353trpda"]["142A538F36833D1CC78B94E11C766F75818F8B940771335C6C1B8AB880C5BB1D"])
+	assert.Len(t, resp.Blocks["nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"], 2)
+
+	// Nothing receivable
+	resp, err = MockRpcClient.MakeAccountsReceivableRequest(context.Background(), []string{"nano_1111111111111111111111111111111111111111111111111117353trpda"}, "1000")
+	assert.Nil(t, err)
+	assert.Len(t, resp.Blocks, 0)
+}
+
 func TestMakeReceivableRequest(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -286,11 +318,222 @@ func TestMakeReceivableRequest(t *testing.T) {
 		},
 	)
 
-	resp, err := MockRpcClient.MakeReceivableRequest("abcd1234", "1")
+	resp, err := MockRpcClient.MakeReceivableRequest(context.Background(), "abcd1234", "1")
 	assert.Nil(t, err)
 	assert.Equal(t, "6000000000000000000000000000000", resp.Blocks["000D1BAEC8EC208142C99059B393051BAC8380F9B5A2E6B2489A277D81789F3F"])
 
-	resp, err = MockRpcClient.MakeReceivableRequest("abcd12345", "1")
+	resp, err = MockRpcClient.MakeReceivableRequest(context.Background(), "abcd12345", "1")
 	assert.Nil(t, err)
 	assert.Len(t, resp.Blocks, 0)
 }
+
+func TestMakeActiveDifficultyRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.Unmarshal([]byte(mocks.ActiveDifficultyResponseStr), &js)
+			resp, err := httpmock.NewJsonResponse(200, js)
+			return resp, err
+		},
+	)
+
+	resp, err := MockRpcClient.MakeActiveDifficultyRequest(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "fffffffa00000000", resp.NetworkCurrent)
+
+	multiplier, err := MockRpcClient.ActiveDifficultyMultiplier(context.Background())
+	assert.Nil(t, err)
+	assert.InDelta(t, 1.3333, multiplier, 0.001)
+}
+
+func TestMakeAccountHistoryRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.AccountHistoryRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			var js map[string]interface{}
+			if pr.Action != "account_history" || pr.Count != "10" {
+				json.Unmarshal([]byte(mocks.ErrorResponseStr), &js)
+			} else if pr.Head == "" {
+				json.Unmarshal([]byte(mocks.AccountHistoryResponseStr), &js)
+			} else {
+				json.Unmarshal([]byte(mocks.AccountHistoryResponseEmptyStr), &js)
+			}
+			resp, err := httpmock.NewJsonResponse(200, js)
+			return resp, err
+		},
+	)
+
+	resp, err := MockRpcClient.MakeAccountHistoryRequest(context.Background(), "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", 10, "")
+	assert.Nil(t, err)
+	assert.Len(t, resp.History, 1)
+	assert.Equal(t, "send", resp.History[0].Type)
+	assert.Equal(t, "80392607E85E73CC3E94B4126F24488EBDFEB174944B890C97E8F36D89591DC5", resp.History[0].Hash)
+	assert.Equal(t, "8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72", resp.Previous)
+
+	// Nothing past the head
+	resp, err = MockRpcClient.MakeAccountHistoryRequest(context.Background(), "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", 10, resp.Previous)
+	assert.Nil(t, err)
+	assert.Len(t, resp.History, 0)
+	assert.Equal(t, "", resp.Previous)
+
+	_, err = MockRpcClient.MakeAccountHistoryRequest(context.Background(), "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", 5, "")
+	assert.Equal(t, "bad input", err.Error())
+}
+
+func TestMakeBlocksInfoRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BlocksInfoRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			var js map[string]interface{}
+			if pr.Action == "blocks_info" && pr.JsonBlock && pr.IncludeNotFound && len(pr.Hashes) == 2 {
+				json.Unmarshal([]byte(mocks.BlocksInfoResponseStr), &js)
+			} else {
+				json.Unmarshal([]byte(mocks.ErrorResponseStr), &js)
+			}
+			resp, err := httpmock.NewJsonResponse(200, js)
+			return resp, err
+		},
+	)
+
+	resp, err := MockRpcClient.MakeBlocksInfoRequest(context.Background(), []string{"87434F8041869A01C8F6F263B87972D7BA443A72E0A97D7A3FD0CCC2358FD6F9", "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3"})
+	assert.Nil(t, err)
+	assert.Len(t, resp.Blocks, 1)
+	assert.Equal(t, "58", resp.Blocks["87434F8041869A01C8F6F263B87972D7BA443A72E0A97D7A3FD0CCC2358FD6F9"].Height)
+	assert.Equal(t, "8a142e07a10996d5", resp.Blocks["87434F8041869A01C8F6F263B87972D7BA443A72E0A97D7A3FD0CCC2358FD6F9"].Contents.Work)
+	assert.Equal(t, []string{"E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3"}, resp.BlocksNotFound)
+}
+
+func TestMakeConfirmationHistoryRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.Unmarshal([]byte(mocks.ConfirmationHistoryResponseStr), &js)
+			resp, err := httpmock.NewJsonResponse(200, js)
+			return resp, err
+		},
+	)
+
+	resp, err := MockRpcClient.MakeConfirmationHistoryRequest(context.Background(), "")
+	assert.Nil(t, err)
+	assert.Equal(t, "2", resp.ConfirmationStats.Count)
+	assert.Len(t, resp.Confirmations, 1)
+	assert.Equal(t, "4000", resp.Confirmations[0].Duration)
+	assert.Equal(t, "2", resp.Confirmations[0].RequestCount)
+}
+
+func TestMakeRepresentativesOnlineRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.RepresentativesOnlineRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			var js map[string]interface{}
+			if pr.Weight {
+				json.Unmarshal([]byte(mocks.RepresentativesOnlineResponseStr), &js)
+			} else {
+				json.Unmarshal([]byte(mocks.ErrorResponseStr), &js)
+			}
+			resp, err := httpmock.NewJsonResponse(200, js)
+			return resp, err
+		},
+	)
+
+	resp, err := MockRpcClient.MakeRepresentativesOnlineRequest(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, resp.Representatives, 2)
+	assert.Equal(t, "106370018690737180855181130736362640", resp.Representatives["nano_3dmtrrws3pocycmbqwawk6xs7446qxa36fcncush4s1pejk16ksbmakis1a1"].Weight)
+}
+
+func TestMakeDelegatorsRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.DelegatorsRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			var js map[string]interface{}
+			if pr.Account == "nano_1111111111111111111111111111111111111111111111111117353trpda" {
+				js = map[string]interface{}{"delegators": ""}
+			} else {
+				json.Unmarshal([]byte(mocks.DelegatorsResponseStr), &js)
+			}
+			resp, err := httpmock.NewJsonResponse(200, js)
+			return resp, err
+		},
+	)
+
+	resp, err := MockRpcClient.MakeDelegatorsRequest(context.Background(), "nano_1stofnrxuz3cai7ze75o174bpm7scwj9jn3nxsn8ntzg784jf1gzn1jjdkou")
+	assert.Nil(t, err)
+	assert.Len(t, resp.Delegators, 2)
+	assert.Equal(t, "500000000000000000000000000000000000", resp.Delegators["nano_13bqhi1cdqq8yb9szneoc38qk899d58i5rcrgdk5mkdm86hekpoez3zxw5sd"])
+
+	// No delegators
+	resp, err = MockRpcClient.MakeDelegatorsRequest(context.Background(), "nano_1111111111111111111111111111111111111111111111111117353trpda")
+	assert.Nil(t, err)
+	assert.Len(t, resp.Delegators, 0)
+}
+
+func TestMakeTelemetryRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.Unmarshal([]byte(mocks.TelemetryResponseStr), &js)
+			resp, err := httpmock.NewJsonResponse(200, js)
+			return resp, err
+		},
+	)
+
+	resp, err := MockRpcClient.MakeTelemetryRequest(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "5777903", resp.BlockCount)
+	assert.Equal(t, "688819", resp.CementedCount)
+	assert.Equal(t, "21", resp.MajorVersion)
+	assert.Equal(t, "ffffffcdbf40aa45", resp.ActiveDifficulty)
+}
+
+func TestMakeBlockConfirmRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.HashRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			var js map[string]interface{}
+			if pr.Action == "block_confirm" && pr.Hash == "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3" {
+				json.Unmarshal([]byte(mocks.BlockConfirmResponseStr), &js)
+			} else {
+				js = map[string]interface{}{"error": "Block not found"}
+			}
+			resp, err := httpmock.NewJsonResponse(200, js)
+			return resp, err
+		},
+	)
+
+	resp, err := MockRpcClient.MakeBlockConfirmRequest(context.Background(), "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", resp.Started)
+
+	_, err = MockRpcClient.MakeBlockConfirmRequest(context.Background(), "abcd")
+	assert.Equal(t, "Block not found", err.Error())
+}
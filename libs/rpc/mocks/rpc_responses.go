@@ -8,8 +8,19 @@ var AccountsPendingResponseStr = "{\n  \"blocks\" : {\n    \"nano_11111111111111
 var AccountsPendingResponseEmptyStr = `{
   "blocks": ""
 }`
+var AccountsReceivableResponseStr = "{\n  \"blocks\" : {\n    \"nano_1111111111111111111111111111111111111111111111111117353trpda\": {\n      \"142A538F36833D1CC78B94E11C766F75818F8B940771335C6C1B8AB880C5BB1D\": \"6000000000000000000000000000000\"\n    },\n    \"nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3\": {\n      \"4C1FEEF0BEA7F50BE35489A1233FE002B212DEA554B55B1B470D78BD8F210C74\": \"106370018000000000000000000000000\",\n      \"FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE\": \"30000000000000000000000000000000000\"\n    }\n  }\n}"
+var AccountsReceivableResponseEmptyStr = "{\"blocks\" : \"\"}"
 var BlockInfoResponseStr = "{\n  \"block_account\": \"nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est\",\n  \"amount\": \"30000000000000000000000000000000000\",\n  \"balance\": \"5606157000000000000000000000000000000\",\n  \"height\": \"58\",\n  \"local_timestamp\": \"0\",\n  \"successor\": \"8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72\",\n  \"confirmed\": \"true\",\n  \"contents\": {\n    \"type\": \"state\",\n    \"account\": \"nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est\",\n    \"previous\": \"CE898C131AAEE25E05362F247760F8A3ACF34A9796A5AE0D9204E86B0637965E\",\n    \"representative\": \"nano_1stofnrxuz3cai7ze75o174bpm7scwj9jn3nxsn8ntzg784jf1gzn1jjdkou\",\n    \"balance\": \"5606157000000000000000000000000000000\",\n    \"link\": \"5D1AA8A45F8736519D707FCB375976A7F9AF795091021D7E9C7548D6F45DD8D5\",\n    \"link_as_account\": \"nano_1qato4k7z3spc8gq1zyd8xeqfbzsoxwo36a45ozbrxcatut7up8ohyardu1z\",\n    \"signature\": \"82D41BC16F313E4B2243D14DFFA2FB04679C540C2095FEE7EAE0F2F26880AD56DD48D87A7CC5DD760C5B2D76EE2C205506AA557BF00B60D8DEE312EC7343A501\",\n    \"work\": \"8a142e07a10996d5\"\n  },\n  \"subtype\": \"send\"\n}"
 var ReceivableResponseStr = "{\n  \"blocks\" : {\n    \"000D1BAEC8EC208142C99059B393051BAC8380F9B5A2E6B2489A277D81789F3F\": \"6000000000000000000000000000000\"\n  }\n}"
 var ReceivableResponseEmptyStr = "{\"blocks\" : \"\"}"
 var ProcessResponseStr = "{\n  \"hash\": \"E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3\"\n}"
 var ErrorResponseStr = "{\n  \"error\": \"bad input\"\n}"
+var ActiveDifficultyResponseStr = "{\n  \"deprecated\": \"1\",\n  \"network_minimum\": \"fffffff800000000\",\n  \"network_receive_minimum\": \"fffffe0000000000\",\n  \"network_current\": \"fffffffa00000000\",\n  \"network_receive_current\": \"fffffe8000000000\",\n  \"multiplier\": \"1.333333333333333\"\n}"
+var AccountHistoryResponseStr = "{\n  \"account\": \"nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est\",\n  \"history\": [\n    {\n      \"type\": \"send\",\n      \"account\": \"nano_38ztgpejb7yrm7rr586nenkn597s3a1sqiy3m3uyqjicht7kzuhnihdk6zpz\",\n      \"amount\": \"80000000000000000000000000000000000\",\n      \"local_timestamp\": \"1551532723\",\n      \"height\": \"60\",\n      \"hash\": \"80392607E85E73CC3E94B4126F24488EBDFEB174944B890C97E8F36D89591DC5\",\n      \"confirmed\": \"true\"\n    }\n  ],\n  \"previous\": \"8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72\"\n}"
+var AccountHistoryResponseEmptyStr = "{\"account\": \"nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est\", \"history\": \"\"}"
+var BlocksInfoResponseStr = "{\n  \"blocks\": {\n    \"87434F8041869A01C8F6F263B87972D7BA443A72E0A97D7A3FD0CCC2358FD6F9\": {\n      \"block_account\": \"nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est\",\n      \"amount\": \"30000000000000000000000000000000000\",\n      \"balance\": \"5606157000000000000000000000000000000\",\n      \"height\": \"58\",\n      \"local_timestamp\": \"0\",\n      \"confirmed\": \"true\",\n      \"contents\": {\n        \"type\": \"state\",\n        \"account\": \"nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est\",\n        \"previous\": \"CE898C131AAEE25E05362F247760F8A3ACF34A9796A5AE0D9204E86B0637965E\",\n        \"balance\": \"5606157000000000000000000000000000000\",\n        \"work\": \"8a142e07a10996d5\"\n      },\n      \"subtype\": \"send\"\n    }\n  },\n  \"blocks_not_found\": [\"E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3\"]\n}"
+var ConfirmationHistoryResponseStr = "{\n  \"confirmation_stats\": {\n    \"count\": \"2\",\n    \"average\": \"5000\"\n  },\n  \"confirmations\": [\n    {\n      \"hash\": \"EA70B32C55C193345D625F766EEA2FCA52D3F2CCE0B3A30838CC543026BB0FEA\",\n      \"duration\": \"4000\",\n      \"time\": \"1544819986\",\n      \"tally\": \"80394786589602980996311817874549318248\",\n      \"final\": \"80394786589602980996311817874549318248\",\n      \"blocks\": \"1\",\n      \"voters\": \"37\",\n      \"request_count\": \"2\"\n    }\n  ]\n}"
+var RepresentativesOnlineResponseStr = "{\n  \"representatives\": {\n    \"nano_114nk4rwjctu6n6tr6g6ps61g1w3hdpjxfas4xj1tq6i8jyomc5d858xr1xi\": {\n      \"weight\": \"150462654614686936429917024683496890\"\n    },\n    \"nano_3dmtrrws3pocycmbqwawk6xs7446qxa36fcncush4s1pejk16ksbmakis1a1\": {\n      \"weight\": \"106370018690737180855181130736362640\"\n    }\n  }\n}"
+var DelegatorsResponseStr = "{\n  \"delegators\": {\n    \"nano_13bqhi1cdqq8yb9szneoc38qk899d58i5rcrgdk5mkdm86hekpoez3zxw5sd\": \"500000000000000000000000000000000000\",\n    \"nano_17k6ug685154an8gri9whhe5kb5z1mf5w6y39gokc1657sh95fegm8ht1zpn\": \"961647970820730000000000000000000000\"\n  }\n}"
+var TelemetryResponseStr = "{\n  \"block_count\": \"5777903\",\n  \"cemented_count\": \"688819\",\n  \"unchecked_count\": \"443468\",\n  \"account_count\": \"620750\",\n  \"bandwidth_cap\": \"1572864\",\n  \"peer_count\": \"32\",\n  \"protocol_version\": \"18\",\n  \"uptime\": \"556896\",\n  \"genesis_block\": \"991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948\",\n  \"major_version\": \"21\",\n  \"minor_version\": \"0\",\n  \"patch_version\": \"0\",\n  \"pre_release_version\": \"0\",\n  \"maker\": \"0\",\n  \"timestamp\": \"1587055945990\",\n  \"active_difficulty\": \"ffffffcdbf40aa45\"\n}"
+var BlockConfirmResponseStr = "{\n  \"started\": \"1\"\n}"
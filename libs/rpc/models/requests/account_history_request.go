@@ -0,0 +1,10 @@
+package requests
+
+type AccountHistoryRequest struct {
+	AccountRequest `mapstructure:",squash"`
+	Count          string `json:"count" mapstructure:"count"`
+	Head           string `json:"head,omitempty" mapstructure:"head,omitempty"`
+	Offset         string `json:"offset,omitempty" mapstructure:"offset,omitempty"`
+	Raw            bool   `json:"raw,omitempty" mapstructure:"raw,omitempty"`
+	Reverse        bool   `json:"reverse,omitempty" mapstructure:"reverse,omitempty"`
+}
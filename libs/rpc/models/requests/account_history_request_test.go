@@ -0,0 +1,50 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountHistoryRequest(t *testing.T) {
+	request := AccountHistoryRequest{
+		AccountRequest: AccountRequest{
+			BaseRequest: BaseRequest{Action: "account_history"},
+			Account:     "abc",
+		},
+		Count: "10",
+		Head:  "1234",
+	}
+	encoded, err := json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"account_history\",\"account\":\"abc\",\"count\":\"10\",\"head\":\"1234\"}", string(encoded))
+}
+
+func TestDecodeAccountHistoryRequest(t *testing.T) {
+	encoded := "{\"action\":\"account_history\",\"account\":\"abc\",\"count\":\"10\",\"raw\":true}"
+	var request AccountHistoryRequest
+	err := json.Unmarshal([]byte(encoded), &request)
+	assert.Nil(t, err)
+	assert.Equal(t, "account_history", request.Action)
+	assert.Equal(t, "abc", request.Account)
+	assert.Equal(t, "10", request.Count)
+	assert.True(t, request.Raw)
+	assert.False(t, request.Reverse)
+}
+
+func TestMapStructureDecodeAccountHistoryRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "account_history",
+		"account": "abc",
+		"count":   "10",
+		"offset":  "5",
+	}
+	var decoded AccountHistoryRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_history", decoded.Action)
+	assert.Equal(t, "abc", decoded.Account)
+	assert.Equal(t, "10", decoded.Count)
+	assert.Equal(t, "5", decoded.Offset)
+}
@@ -0,0 +1,8 @@
+package requests
+
+type AccountsReceivableRequest struct {
+	BaseRequest          `mapstructure:",squash"`
+	Accounts             []string `json:"accounts" mapstructure:"accounts"`
+	Threshold            string   `json:"threshold" mapstructure:"threshold"`
+	IncludeOnlyConfirmed bool     `json:"include_only_confirmed" mapstructure:"include_only_confirmed"`
+}
@@ -0,0 +1,38 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountsReceivableRequest(t *testing.T) {
+	request := AccountsReceivableRequest{
+		BaseRequest: BaseRequest{
+			Action: "accounts_receivable",
+		},
+		Accounts:             []string{"abcd", "efgh"},
+		Threshold:            "1234",
+		IncludeOnlyConfirmed: true,
+	}
+	encoded, err := json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"accounts_receivable\",\"accounts\":[\"abcd\",\"efgh\"],\"threshold\":\"1234\",\"include_only_confirmed\":true}", string(encoded))
+}
+
+func TestMapStructureDecodeAccountsReceivableRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":                 "accounts_receivable",
+		"accounts":               []string{"abcd"},
+		"threshold":              "1234",
+		"include_only_confirmed": true,
+	}
+	var decoded AccountsReceivableRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "accounts_receivable", decoded.Action)
+	assert.Equal(t, []string{"abcd"}, decoded.Accounts)
+	assert.Equal(t, "1234", decoded.Threshold)
+	assert.True(t, decoded.IncludeOnlyConfirmed)
+}
@@ -0,0 +1,8 @@
+package requests
+
+type BlocksInfoRequest struct {
+	BaseRequest     `mapstructure:",squash"`
+	JsonBlock       bool     `json:"json_block" mapstructure:"json_block"`
+	IncludeNotFound bool     `json:"include_not_found" mapstructure:"include_not_found"`
+	Hashes          []string `json:"hashes" mapstructure:"hashes"`
+}
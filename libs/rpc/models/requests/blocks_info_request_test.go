@@ -0,0 +1,47 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeBlocksInfoRequest(t *testing.T) {
+	request := BlocksInfoRequest{
+		BaseRequest: BaseRequest{
+			Action: "blocks_info",
+		},
+		JsonBlock:       true,
+		IncludeNotFound: true,
+		Hashes:          []string{"1234", "5678"},
+	}
+	encoded, err := json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"blocks_info\",\"json_block\":true,\"include_not_found\":true,\"hashes\":[\"1234\",\"5678\"]}", string(encoded))
+}
+
+func TestDecodeBlocksInfoRequest(t *testing.T) {
+	encoded := "{\"action\":\"blocks_info\",\"json_block\":true,\"hashes\":[\"1234\"]}"
+	var request BlocksInfoRequest
+	err := json.Unmarshal([]byte(encoded), &request)
+	assert.Nil(t, err)
+	assert.Equal(t, "blocks_info", request.Action)
+	assert.Equal(t, []string{"1234"}, request.Hashes)
+	assert.True(t, request.JsonBlock)
+	assert.False(t, request.IncludeNotFound)
+}
+
+func TestMapStructureDecodeBlocksInfoRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":     "blocks_info",
+		"json_block": true,
+		"hashes":     []string{"1234"},
+	}
+	var decoded BlocksInfoRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "blocks_info", decoded.Action)
+	assert.Equal(t, []string{"1234"}, decoded.Hashes)
+	assert.True(t, decoded.JsonBlock)
+}
@@ -0,0 +1,8 @@
+package requests
+
+type DelegatorsRequest struct {
+	AccountRequest `mapstructure:",squash"`
+	Threshold      string `json:"threshold,omitempty" mapstructure:"threshold,omitempty"`
+	Count          string `json:"count,omitempty" mapstructure:"count,omitempty"`
+	Start          string `json:"start,omitempty" mapstructure:"start,omitempty"`
+}
@@ -0,0 +1,47 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDelegatorsRequest(t *testing.T) {
+	request := DelegatorsRequest{
+		AccountRequest: AccountRequest{
+			BaseRequest: BaseRequest{Action: "delegators"},
+			Account:     "abc",
+		},
+		Count: "50",
+	}
+	encoded, err := json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"delegators\",\"account\":\"abc\",\"count\":\"50\"}", string(encoded))
+}
+
+func TestDecodeDelegatorsRequest(t *testing.T) {
+	encoded := "{\"action\":\"delegators\",\"account\":\"abc\",\"threshold\":\"1000\",\"start\":\"def\"}"
+	var request DelegatorsRequest
+	err := json.Unmarshal([]byte(encoded), &request)
+	assert.Nil(t, err)
+	assert.Equal(t, "delegators", request.Action)
+	assert.Equal(t, "abc", request.Account)
+	assert.Equal(t, "1000", request.Threshold)
+	assert.Equal(t, "def", request.Start)
+	assert.Equal(t, "", request.Count)
+}
+
+func TestMapStructureDecodeDelegatorsRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "delegators",
+		"account": "abc",
+		"count":   "50",
+	}
+	var decoded DelegatorsRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "delegators", decoded.Action)
+	assert.Equal(t, "abc", decoded.Account)
+	assert.Equal(t, "50", decoded.Count)
+}
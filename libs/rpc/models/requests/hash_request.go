@@ -0,0 +1,7 @@
+package requests
+
+// For block_confirm and confirmation_history, the hash is optional for the latter
+type HashRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Hash        string `json:"hash,omitempty" mapstructure:"hash,omitempty"`
+}
@@ -0,0 +1,47 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeHashRequest(t *testing.T) {
+	request := HashRequest{
+		BaseRequest: BaseRequest{Action: "block_confirm"},
+		Hash:        "1234",
+	}
+	encoded, err := json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"block_confirm\",\"hash\":\"1234\"}", string(encoded))
+
+	// Without a hash
+	request = HashRequest{
+		BaseRequest: BaseRequest{Action: "confirmation_history"},
+	}
+	encoded, err = json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"confirmation_history\"}", string(encoded))
+}
+
+func TestDecodeHashRequest(t *testing.T) {
+	encoded := "{\"action\":\"block_confirm\",\"hash\":\"1234\"}"
+	var request HashRequest
+	err := json.Unmarshal([]byte(encoded), &request)
+	assert.Nil(t, err)
+	assert.Equal(t, "block_confirm", request.Action)
+	assert.Equal(t, "1234", request.Hash)
+}
+
+func TestMapStructureDecodeHashRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "block_confirm",
+		"hash":   "1234",
+	}
+	var decoded HashRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "block_confirm", decoded.Action)
+	assert.Equal(t, "1234", decoded.Hash)
+}
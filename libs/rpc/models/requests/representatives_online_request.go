@@ -0,0 +1,7 @@
+package requests
+
+type RepresentativesOnlineRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Weight      bool     `json:"weight" mapstructure:"weight"`
+	Accounts    []string `json:"accounts,omitempty" mapstructure:"accounts,omitempty"`
+}
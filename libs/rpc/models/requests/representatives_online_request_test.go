@@ -0,0 +1,41 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeRepresentativesOnlineRequest(t *testing.T) {
+	request := RepresentativesOnlineRequest{
+		BaseRequest: BaseRequest{Action: "representatives_online"},
+		Weight:      true,
+	}
+	encoded, err := json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"representatives_online\",\"weight\":true}", string(encoded))
+}
+
+func TestDecodeRepresentativesOnlineRequest(t *testing.T) {
+	encoded := "{\"action\":\"representatives_online\",\"weight\":true,\"accounts\":[\"abc\"]}"
+	var request RepresentativesOnlineRequest
+	err := json.Unmarshal([]byte(encoded), &request)
+	assert.Nil(t, err)
+	assert.Equal(t, "representatives_online", request.Action)
+	assert.True(t, request.Weight)
+	assert.Equal(t, []string{"abc"}, request.Accounts)
+}
+
+func TestMapStructureDecodeRepresentativesOnlineRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "representatives_online",
+		"weight": true,
+	}
+	var decoded RepresentativesOnlineRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "representatives_online", decoded.Action)
+	assert.True(t, decoded.Weight)
+	assert.Nil(t, decoded.Accounts)
+}
@@ -0,0 +1,27 @@
+package responses
+
+// Raw history adds the block's own fields, Subtype through Work
+type AccountHistoryItem struct {
+	Type           string `json:"type" mapstructure:"type"`
+	Account        string `json:"account" mapstructure:"account"`
+	Amount         string `json:"amount" mapstructure:"amount"`
+	LocalTimestamp string `json:"local_timestamp" mapstructure:"local_timestamp"`
+	Height         string `json:"height" mapstructure:"height"`
+	Hash           string `json:"hash" mapstructure:"hash"`
+	Confirmed      string `json:"confirmed" mapstructure:"confirmed"`
+	Subtype        string `json:"subtype,omitempty" mapstructure:"subtype,omitempty"`
+	Representative string `json:"representative,omitempty" mapstructure:"representative,omitempty"`
+	Link           string `json:"link,omitempty" mapstructure:"link,omitempty"`
+	Balance        string `json:"balance,omitempty" mapstructure:"balance,omitempty"`
+	Previous       string `json:"previous,omitempty" mapstructure:"previous,omitempty"`
+	Signature      string `json:"signature,omitempty" mapstructure:"signature,omitempty"`
+	Work           string `json:"work,omitempty" mapstructure:"work,omitempty"`
+}
+
+// Previous is the head for the next page, empty on the last one
+type AccountHistoryResponse struct {
+	Account  string               `json:"account" mapstructure:"account"`
+	History  []AccountHistoryItem `json:"history" mapstructure:"history"`
+	Previous string               `json:"previous,omitempty" mapstructure:"previous,omitempty"`
+	Next     string               `json:"next,omitempty" mapstructure:"next,omitempty"`
+}
@@ -0,0 +1,36 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountHistoryResponse(t *testing.T) {
+	encoded := "{\n  \"account\": \"nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est\",\n  \"history\": [\n    {\n      \"type\": \"send\",\n      \"account\": \"nano_38ztgpejb7yrm7rr586nenkn597s3a1sqiy3m3uyqjicht7kzuhnihdk6zpz\",\n      \"amount\": \"80000000000000000000000000000000000\",\n      \"local_timestamp\": \"1551532723\",\n      \"height\": \"60\",\n      \"hash\": \"80392607E85E73CC3E94B4126F24488EBDFEB174944B890C97E8F36D89591DC5\",\n      \"confirmed\": \"true\"\n    }\n  ],\n  \"previous\": \"8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72\"\n}"
+
+	var decoded AccountHistoryResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", decoded.Account)
+	assert.Len(t, decoded.History, 1)
+	assert.Equal(t, "send", decoded.History[0].Type)
+	assert.Equal(t, "nano_38ztgpejb7yrm7rr586nenkn597s3a1sqiy3m3uyqjicht7kzuhnihdk6zpz", decoded.History[0].Account)
+	assert.Equal(t, "80000000000000000000000000000000000", decoded.History[0].Amount)
+	assert.Equal(t, "60", decoded.History[0].Height)
+	assert.Equal(t, "80392607E85E73CC3E94B4126F24488EBDFEB174944B890C97E8F36D89591DC5", decoded.History[0].Hash)
+	assert.Equal(t, "true", decoded.History[0].Confirmed)
+	assert.Equal(t, "", decoded.History[0].Subtype)
+	assert.Equal(t, "8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72", decoded.Previous)
+
+	// Raw entries through mapstructure
+	var asMap map[string]interface{}
+	json.Unmarshal([]byte("{\"account\":\"abc\",\"history\":[{\"type\":\"state\",\"subtype\":\"receive\",\"link\":\"1234\",\"balance\":\"100\"}]}"), &asMap)
+	decoded = AccountHistoryResponse{}
+	assert.Nil(t, mapstructure.Decode(asMap, &decoded))
+	assert.Equal(t, "receive", decoded.History[0].Subtype)
+	assert.Equal(t, "1234", decoded.History[0].Link)
+	assert.Equal(t, "100", decoded.History[0].Balance)
+	assert.Equal(t, "", decoded.Previous)
+}
@@ -0,0 +1,14 @@
+package responses
+
+// With a threshold, receivable hashes come with their amount
+//
+//	{
+//	  "blocks" : {
+//	    "nano_1111111111111111111111111111111111111111111111111117353trpda": {
+//	      "142A538F36833D1CC78B94E11C766F75818F8B940771335C6C1B8AB880C5BB1D": "6000000000000000000000000000000"
+//	    }
+//	  }
+//	}
+type AccountsReceivableResponse struct {
+	Blocks map[string]map[string]string `json:"blocks" mapstructure:"blocks"`
+}
@@ -0,0 +1,16 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountsReceivableResponse(t *testing.T) {
+	encoded := "{\n  \"blocks\" : {\n    \"nano_1111111111111111111111111111111111111111111111111117353trpda\": {\n      \"142A538F36833D1CC78B94E11C766F75818F8B940771335C6C1B8AB880C5BB1D\": \"6000000000000000000000000000000\"\n    }\n  }\n}"
+	var decoded AccountsReceivableResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Len(t, decoded.Blocks, 1)
+	assert.Equal(t, "6000000000000000000000000000000", decoded.Blocks["nano_1111111111111111111111111111111111111111111111111117353trpda"]["142A538F36833D1CC78B94E11C766F75818F8B940771335C6C1B8AB880C5BB1D"])
+}
@@ -0,0 +1,9 @@
+package responses
+
+type ActiveDifficultyResponse struct {
+	NetworkMinimum        string `json:"network_minimum" mapstructure:"network_minimum"`
+	NetworkReceiveMinimum string `json:"network_receive_minimum" mapstructure:"network_receive_minimum"`
+	NetworkCurrent        string `json:"network_current" mapstructure:"network_current"`
+	NetworkReceiveCurrent string `json:"network_receive_current" mapstructure:"network_receive_current"`
+	Multiplier            string `json:"multiplier" mapstructure:"multiplier"`
+}
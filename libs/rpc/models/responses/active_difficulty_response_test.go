@@ -0,0 +1,20 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeActiveDifficultyResponse(t *testing.T) {
+	encoded := "{\n  \"deprecated\": \"1\",\n  \"network_minimum\": \"fffffff800000000\",\n  \"network_receive_minimum\": \"fffffe0000000000\",\n  \"network_current\": \"fffffffa00000000\",\n  \"network_receive_current\": \"fffffe8000000000\",\n  \"multiplier\": \"1.333333333333333\"\n}"
+
+	var decoded ActiveDifficultyResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "fffffff800000000", decoded.NetworkMinimum)
+	assert.Equal(t, "fffffe0000000000", decoded.NetworkReceiveMinimum)
+	assert.Equal(t, "fffffffa00000000", decoded.NetworkCurrent)
+	assert.Equal(t, "fffffe8000000000", decoded.NetworkReceiveCurrent)
+	assert.Equal(t, "1.333333333333333", decoded.Multiplier)
+}
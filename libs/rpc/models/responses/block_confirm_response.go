@@ -0,0 +1,6 @@
+package responses
+
+// Started is "1" once the node started confirming the block
+type BlockConfirmResponse struct {
+	Started string `json:"started" mapstructure:"started"`
+}
@@ -0,0 +1,16 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeBlockConfirmResponse(t *testing.T) {
+	encoded := "{\n  \"started\": \"1\"\n}"
+
+	var decoded BlockConfirmResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "1", decoded.Started)
+}
@@ -0,0 +1,6 @@
+package responses
+
+type BlocksInfoResponse struct {
+	Blocks         map[string]BlockInfoResponse `json:"blocks" mapstructure:"blocks"`
+	BlocksNotFound []string                     `json:"blocks_not_found,omitempty" mapstructure:"blocks_not_found,omitempty"`
+}
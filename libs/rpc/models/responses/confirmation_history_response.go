@@ -0,0 +1,23 @@
+package responses
+
+type ConfirmationStats struct {
+	Count   string `json:"count" mapstructure:"count"`
+	Average string `json:"average" mapstructure:"average"`
+}
+
+// Duration is in milliseconds, Time a unix timestamp in milliseconds
+type ConfirmationHistoryItem struct {
+	Hash         string `json:"hash" mapstructure:"hash"`
+	Duration     string `json:"duration" mapstructure:"duration"`
+	Time         string `json:"time" mapstructure:"time"`
+	Tally        string `json:"tally" mapstructure:"tally"`
+	Final        string `json:"final" mapstructure:"final"`
+	Blocks       string `json:"blocks" mapstructure:"blocks"`
+	Voters       string `json:"voters" mapstructure:"voters"`
+	RequestCount string `json:"request_count" mapstructure:"request_count"`
+}
+
+type ConfirmationHistoryResponse struct {
+	ConfirmationStats ConfirmationStats         `json:"confirmation_stats" mapstructure:"confirmation_stats"`
+	Confirmations     []ConfirmationHistoryItem `json:"confirmations" mapstructure:"confirmations"`
+}
@@ -0,0 +1,29 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeConfirmationHistoryResponse(t *testing.T) {
+	encoded := "{\n  \"confirmation_stats\": {\n    \"count\": \"2\",\n    \"average\": \"5000\"\n  },\n  \"confirmations\": [\n    {\n      \"hash\": \"EA70B32C55C193345D625F766EEA2FCA52D3F2CCE0B3A30838CC543026BB0FEA\",\n      \"duration\": \"4000\",\n      \"time\": \"1544819986\",\n      \"tally\": \"80394786589602980996311817874549318248\",\n      \"final\": \"80394786589602980996311817874549318248\",\n      \"blocks\": \"1\",\n      \"voters\": \"37\",\n      \"request_count\": \"2\"\n    }\n  ]\n}"
+
+	var decoded ConfirmationHistoryResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "2", decoded.ConfirmationStats.Count)
+	assert.Equal(t, "5000", decoded.ConfirmationStats.Average)
+	assert.Len(t, decoded.Confirmations, 1)
+	assert.Equal(t, "EA70B32C55C193345D625F766EEA2FCA52D3F2CCE0B3A30838CC543026BB0FEA", decoded.Confirmations[0].Hash)
+	assert.Equal(t, "4000", decoded.Confirmations[0].Duration)
+	assert.Equal(t, "37", decoded.Confirmations[0].Voters)
+	assert.Equal(t, "2", decoded.Confirmations[0].RequestCount)
+
+	var asMap map[string]interface{}
+	json.Unmarshal([]byte(encoded), &asMap)
+	decoded = ConfirmationHistoryResponse{}
+	assert.Nil(t, mapstructure.Decode(asMap, &decoded))
+	assert.Equal(t, "80394786589602980996311817874549318248", decoded.Confirmations[0].Tally)
+}
@@ -0,0 +1,10 @@
+package responses
+
+//	{
+//	  "delegators": {
+//	    "nano_13bqhi1cdqq8yb9szneoc38qk899d58i5rcrgdk5mkdm86hekpoez3zxw5sd": "500000000000000000000000000000000000"
+//	  }
+//	}
+type DelegatorsResponse struct {
+	Delegators map[string]string `json:"delegators" mapstructure:"delegators"`
+}
@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeDelegatorsResponse(t *testing.T) {
+	encoded := "{\n  \"delegators\": {\n    \"nano_13bqhi1cdqq8yb9szneoc38qk899d58i5rcrgdk5mkdm86hekpoez3zxw5sd\": \"500000000000000000000000000000000000\",\n    \"nano_17k6ug685154an8gri9whhe5kb5z1mf5w6y39gokc1657sh95fegm8ht1zpn\": \"961647970820730000000000000000000000\"\n  }\n}"
+
+	var decoded DelegatorsResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Len(t, decoded.Delegators, 2)
+	assert.Equal(t, "500000000000000000000000000000000000", decoded.Delegators["nano_13bqhi1cdqq8yb9szneoc38qk899d58i5rcrgdk5mkdm86hekpoez3zxw5sd"])
+}
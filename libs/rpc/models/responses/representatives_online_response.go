@@ -0,0 +1,16 @@
+package responses
+
+type RepresentativeOnline struct {
+	Weight string `json:"weight" mapstructure:"weight"`
+}
+
+//	{
+//	  "representatives": {
+//	    "nano_114nk4rwjctu6n6tr6g6ps61g1w3hdpjxfas4xj1tq6i8jyomc5d858xr1xi": {
+//	      "weight": "150462654614686936429917024683496890"
+//	    }
+//	  }
+//	}
+type RepresentativesOnlineResponse struct {
+	Representatives map[string]RepresentativeOnline `json:"representatives" mapstructure:"representatives"`
+}
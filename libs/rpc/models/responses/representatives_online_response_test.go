@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeRepresentativesOnlineResponse(t *testing.T) {
+	encoded := "{\n  \"representatives\": {\n    \"nano_114nk4rwjctu6n6tr6g6ps61g1w3hdpjxfas4xj1tq6i8jyomc5d858xr1xi\": {\n      \"weight\": \"150462654614686936429917024683496890\"\n    },\n    \"nano_3dmtrrws3pocycmbqwawk6xs7446qxa36fcncush4s1pejk16ksbmakis1a1\": {\n      \"weight\": \"106370018690737180855181130736362640\"\n    }\n  }\n}"
+
+	var decoded RepresentativesOnlineResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Len(t, decoded.Representatives, 2)
+	assert.Equal(t, "150462654614686936429917024683496890", decoded.Representatives["nano_114nk4rwjctu6n6tr6g6ps61g1w3hdpjxfas4xj1tq6i8jyomc5d858xr1xi"].Weight)
+}
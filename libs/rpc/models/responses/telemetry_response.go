@@ -0,0 +1,21 @@
+package responses
+
+// Averaged over the node's peers
+type TelemetryResponse struct {
+	BlockCount        string `json:"block_count" mapstructure:"block_count"`
+	CementedCount     string `json:"cemented_count" mapstructure:"cemented_count"`
+	UncheckedCount    string `json:"unchecked_count" mapstructure:"unchecked_count"`
+	AccountCount      string `json:"account_count" mapstructure:"account_count"`
+	BandwidthCap      string `json:"bandwidth_cap" mapstructure:"bandwidth_cap"`
+	PeerCount         string `json:"peer_count" mapstructure:"peer_count"`
+	ProtocolVersion   string `json:"protocol_version" mapstructure:"protocol_version"`
+	Uptime            string `json:"uptime" mapstructure:"uptime"`
+	GenesisBlock      string `json:"genesis_block" mapstructure:"genesis_block"`
+	MajorVersion      string `json:"major_version" mapstructure:"major_version"`
+	MinorVersion      string `json:"minor_version" mapstructure:"minor_version"`
+	PatchVersion      string `json:"patch_version" mapstructure:"patch_version"`
+	PreReleaseVersion string `json:"pre_release_version" mapstructure:"pre_release_version"`
+	Maker             string `json:"maker" mapstructure:"maker"`
+	Timestamp         string `json:"timestamp" mapstructure:"timestamp"`
+	ActiveDifficulty  string `json:"active_difficulty" mapstructure:"active_difficulty"`
+}
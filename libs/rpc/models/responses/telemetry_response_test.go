@@ -0,0 +1,25 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeTelemetryResponse(t *testing.T) {
+	encoded := "{\n  \"block_count\": \"5777903\",\n  \"cemented_count\": \"688819\",\n  \"unchecked_count\": \"443468\",\n  \"account_count\": \"620750\",\n  \"bandwidth_cap\": \"1572864\",\n  \"peer_count\": \"32\",\n  \"protocol_version\": \"18\",\n  \"uptime\": \"556896\",\n  \"genesis_block\": \"991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948\",\n  \"major_version\": \"21\",\n  \"minor_version\": \"0\",\n  \"patch_version\": \"0\",\n  \"pre_release_version\": \"0\",\n  \"maker\": \"0\",\n  \"timestamp\": \"1587055945990\",\n  \"active_difficulty\": \"ffffffcdbf40aa45\"\n}"
+
+	var decoded TelemetryResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "5777903", decoded.BlockCount)
+	assert.Equal(t, "688819", decoded.CementedCount)
+	assert.Equal(t, "443468", decoded.UncheckedCount)
+	assert.Equal(t, "620750", decoded.AccountCount)
+	assert.Equal(t, "32", decoded.PeerCount)
+	assert.Equal(t, "18", decoded.ProtocolVersion)
+	assert.Equal(t, "991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948", decoded.GenesisBlock)
+	assert.Equal(t, "21", decoded.MajorVersion)
+	assert.Equal(t, "1587055945990", decoded.Timestamp)
+	assert.Equal(t, "ffffffcdbf40aa45", decoded.ActiveDifficulty)
+}
@@ -0,0 +1,142 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
+
+var ErrNoQuorum = errors.New("Nodes don't agree")
+
+type rpcNode struct {
+	url string
+	// Set by health checks and failed requests, unhealthy nodes are only used when none are healthy
+	healthy atomic.Bool
+}
+
+func newRPCNode(url string) *rpcNode {
+	node := &rpcNode{url: url}
+	node.healthy.Store(true)
+	return node
+}
+
+// Healthy nodes first, in the configured order
+func (client *RPCClient) orderedNodes() []*rpcNode {
+	ordered := make([]*rpcNode, 0, len(client.nodes))
+	for _, node := range client.nodes {
+		if node.healthy.Load() {
+			ordered = append(ordered, node)
+		}
+	}
+	for _, node := range client.nodes {
+		if !node.healthy.Load() {
+			ordered = append(ordered, node)
+		}
+	}
+	return ordered
+}
+
+// Every healthy node, or every node when none are
+func (client *RPCClient) healthyNodes() []*rpcNode {
+	healthy := []*rpcNode{}
+	for _, node := range client.nodes {
+		if node.healthy.Load() {
+			healthy = append(healthy, node)
+		}
+	}
+	if len(healthy) < 1 {
+		return client.nodes
+	}
+	return healthy
+}
+
+// A request cancelled by the caller says nothing about the node, so it stays healthy
+func (client *RPCClient) post(ctx context.Context, node *rpcNode, requestBody []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, node.url, bytes.NewBuffer(requestBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() == nil {
+			node.healthy.Store(false)
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		node.healthy.Store(false)
+		return nil, fmt.Errorf("%s returned %d", node.url, resp.StatusCode)
+	}
+	node.healthy.Store(true)
+	return io.ReadAll(resp.Body)
+}
+
+// Tries each node until one answers or ctx is done, errors in the response itself don't fail over
+func (client *RPCClient) postFailover(ctx context.Context, requestBody []byte) ([]byte, error) {
+	var lastErr error
+	for _, node := range client.orderedNodes() {
+		body, err := client.post(ctx, node, requestBody)
+		if err == nil {
+			return body, nil
+		} else if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		log.Errorf("Error making RPC request to %s %s", node.url, err)
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// Sends to every healthy node at once, a nil body means that node failed
+func (client *RPCClient) postAll(ctx context.Context, requestBody []byte) [][]byte {
+	nodes := client.healthyNodes()
+	bodies := make([][]byte, len(nodes))
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		wg.Add(1)
+		go func(i int, node *rpcNode) {
+			defer wg.Done()
+			body, err := client.post(ctx, node, requestBody)
+			if err != nil {
+				log.Errorf("Error making RPC request to %s %s", node.url, err)
+				return
+			}
+			bodies[i] = body
+		}(i, node)
+	}
+	wg.Wait()
+	return bodies
+}
+
+// Marks nodes healthy or not with a block_count request every interval, until ctx is done
+func (client *RPCClient) StartHealthChecks(ctx context.Context, interval time.Duration) {
+	requestBody := []byte(`{"action":"block_count"}`)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, node := range client.nodes {
+				wasHealthy := node.healthy.Load()
+				_, err := client.post(ctx, node, requestBody)
+				if wasHealthy && err != nil {
+					log.Errorf("Node %s is unhealthy %s", node.url, err)
+				} else if !wasHealthy && err == nil {
+					log.Infof("Node %s is healthy again", node.url)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	walletmodels "github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func jsonResponder(str string, calls *atomic.Int32) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		var js map[string]interface{}
+		json.Unmarshal([]byte(str), &js)
+		return httpmock.NewJsonResponse(200, js)
+	}
+}
+
+func TestMakeRequestFailover(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var primary, secondary atomic.Int32
+	httpmock.RegisterResponder("POST", "http://node1", func(req *http.Request) (*http.Response, error) {
+		primary.Add(1)
+		return nil, errors.New("timeout")
+	})
+	httpmock.RegisterResponder("POST", "http://node2", jsonResponder(mocks.AccountBalanceResponseStr, &secondary))
+
+	client := NewRPCClient("http://node1", "http://node2")
+	assert.Equal(t, "http://node1", client.Url)
+	resp, err := client.MakeAccountBalanceRequest(context.Background(), "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3")
+	assert.Nil(t, err)
+	assert.NotEmpty(t, resp.Balance)
+	assert.False(t, client.nodes[0].healthy.Load())
+
+	// The unhealthy node is skipped while another one works
+	_, err = client.MakeAccountBalanceRequest(context.Background(), "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), primary.Load())
+	assert.Equal(t, int32(2), secondary.Load())
+
+	// Errors from the node itself don't fail over
+	httpmock.RegisterResponder("POST", "http://node2", jsonResponder(mocks.ErrorResponseStr, &secondary))
+	_, err = client.MakeAccountBalanceRequest(context.Background(), "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3")
+	assert.Equal(t, "bad input", err.Error())
+	assert.Equal(t, int32(1), primary.Load())
+
+	// Everything down
+	httpmock.RegisterResponder("POST", "http://node2", httpmock.NewStringResponder(502, "bad gateway"))
+	_, err = client.MakeAccountBalanceRequest(context.Background(), "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3")
+	assert.NotNil(t, err)
+	assert.False(t, client.nodes[1].healthy.Load())
+}
+
+func TestMakeRequestCancelled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var primary, secondary atomic.Int32
+	httpmock.RegisterResponder("POST", "http://node1", func(req *http.Request) (*http.Response, error) {
+		primary.Add(1)
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	})
+	httpmock.RegisterResponder("POST", "http://node2", jsonResponder(mocks.AccountBalanceResponseStr, &secondary))
+
+	client := NewRPCClient("http://node1", "http://node2")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	_, err := client.MakeAccountBalanceRequest(ctx, "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	// Neither blamed on the node nor retried on the next one
+	assert.True(t, client.nodes[0].healthy.Load())
+	assert.Equal(t, int32(1), primary.Load())
+	assert.Equal(t, int32(0), secondary.Load())
+}
+
+func TestHealthChecks(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var calls atomic.Int32
+	httpmock.RegisterResponder("POST", "http://node1", jsonResponder(`{"count":"1","unchecked":"0","cemented":"1"}`, &calls))
+	client := NewRPCClient("http://node1")
+	client.nodes[0].healthy.Store(false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		client.StartHealthChecks(ctx, time.Millisecond*10)
+		close(done)
+	}()
+	assert.Eventually(t, func() bool {
+		return client.nodes[0].healthy.Load()
+	}, time.Second, time.Millisecond*10)
+	cancel()
+	<-done
+}
+
+func TestMakeProcessRequestBroadcast(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var node1, node2, node3 atomic.Int32
+	httpmock.RegisterResponder("POST", "http://node1", jsonResponder(`{"error":"Fork"}`, &node1))
+	httpmock.RegisterResponder("POST", "http://node2", jsonResponder(mocks.ProcessResponseStr, &node2))
+	httpmock.RegisterResponder("POST", "http://node3", jsonResponder(mocks.ProcessResponseStr, &node3))
+
+	client := NewRPCClient("http://node1", "http://node2", "http://node3")
+	client.nodes[2].healthy.Store(false)
+	request := requests.ProcessRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "process",
+		},
+		JsonBlock: true,
+		Block:     walletmodels.StateBlock{Hash: "abcd1234"},
+	}
+
+	// Every healthy node gets it, one taking it is enough
+	resp, err := client.MakeProcessRequest(context.Background(), request)
+	assert.Nil(t, err)
+	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", resp.Hash)
+	assert.Equal(t, int32(1), node1.Load())
+	assert.Equal(t, int32(1), node2.Load())
+	assert.Equal(t, int32(0), node3.Load())
+
+	// None of them took it
+	httpmock.RegisterResponder("POST", "http://node2", jsonResponder(`{"error":"Fork"}`, &node2))
+	_, err = client.MakeProcessRequest(context.Background(), request)
+	assert.Equal(t, "Fork", err.Error())
+}
+
+func TestMakeAccountInfoRequestQuorum(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var calls atomic.Int32
+	httpmock.RegisterResponder("POST", "http://node1", jsonResponder(mocks.AccountInfoResponseStr, &calls))
+	httpmock.RegisterResponder("POST", "http://node2", jsonResponder(`{"frontier":"ABCD","balance":"1","representative":"nano_1"}`, &calls))
+	httpmock.RegisterResponder("POST", "http://node3", jsonResponder(mocks.AccountInfoResponseStr, &calls))
+
+	client := NewRPCClient("http://node1", "http://node2", "http://node3")
+	client.Quorum = 2
+	resp, err := client.MakeAccountInfoRequest(context.Background(), "abcd1234")
+	assert.Nil(t, err)
+	assert.Equal(t, "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F", resp.Frontier)
+	assert.Equal(t, int32(3), calls.Load())
+
+	// Not found counts as an answer
+	httpmock.RegisterResponder("POST", "http://node1", jsonResponder(`{"error":"Account not found"}`, &calls))
+	httpmock.RegisterResponder("POST", "http://node2", jsonResponder(`{"error":"Account not found"}`, &calls))
+	_, err = client.MakeAccountInfoRequest(context.Background(), "abcd1234")
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	// Nobody agrees
+	httpmock.RegisterResponder("POST", "http://node2", jsonResponder(`{"frontier":"ABCD","balance":"1","representative":"nano_1"}`, &calls))
+	_, err = client.MakeAccountInfoRequest(context.Background(), "abcd1234")
+	assert.ErrorIs(t, err, ErrNoQuorum)
+}
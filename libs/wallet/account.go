@@ -1,6 +1,7 @@
 package wallet
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -18,7 +19,7 @@ var ErrAccountExists = errors.New("account already exists")
 var ErrUnableToCreateAccount = errors.New("unable to create account")
 
 // Retrieve an account or adhoc account for a wallet
-func (w *NanoWallet) GetAccount(wallet *ent.Wallet, address string) (*ent.Account, error) {
+func (w *NanoWallet) GetAccount(ctx context.Context, wallet *ent.Wallet, address string) (*ent.Account, error) {
 	if wallet == nil {
 		return nil, ErrInvalidWallet
 	}
@@ -30,7 +31,7 @@ func (w *NanoWallet) GetAccount(wallet *ent.Wallet, address string) (*ent.Accoun
 	}
 
 	// Check if account exists
-	acc, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.Address(address)).First(w.Ctx)
+	acc, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.Address(address)).First(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, ErrAccountNotFound
@@ -41,9 +42,9 @@ func (w *NanoWallet) GetAccount(wallet *ent.Wallet, address string) (*ent.Accoun
 	return acc, nil
 }
 
-func (w *NanoWallet) GetAccountByAddress(address string) (*ent.Account, error) {
+func (w *NanoWallet) GetAccountByAddress(ctx context.Context, address string) (*ent.Account, error) {
 	// Check if account exists
-	acc, err := w.DB.Account.Query().Where(account.Address(address)).First(w.Ctx)
+	acc, err := w.DB.Account.Query().Where(account.Address(address)).First(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, ErrAccountNotFound
@@ -55,17 +56,17 @@ func (w *NanoWallet) GetAccountByAddress(address string) (*ent.Account, error) {
 }
 
 // Create the next account in sequence, or at index
-func (w *NanoWallet) AccountCreate(wallet *ent.Wallet, index *int) (*ent.Account, error) {
+func (w *NanoWallet) AccountCreate(ctx context.Context, wallet *ent.Wallet, index *int) (*ent.Account, error) {
 	if wallet == nil {
 		return nil, ErrInvalidWallet
 	}
 
 	// Obtain a lock, prevent concurrent calls
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
 	if err != nil {
 		return nil, database.ErrLockNotObtained
 	}
-	defer lock.Release(w.Ctx)
+	defer lock.Release(context.WithoutCancel(ctx))
 
 	// Get seed
 	seed, err := GetDecryptedKeyFromStorage(wallet, "seed")
@@ -80,7 +81,7 @@ func (w *NanoWallet) AccountCreate(wallet *ent.Wallet, index *int) (*ent.Account
 			return nil, err
 		}
 		address := utils.PubKeyToAddress(pub, w.Banano)
-		exists, err := w.AccountExists(wallet, address)
+		exists, err := w.AccountExists(ctx, wallet, address)
 		if err != nil {
 			return nil, err
 		}
@@ -88,14 +89,15 @@ func (w *NanoWallet) AccountCreate(wallet *ent.Wallet, index *int) (*ent.Account
 			return nil, ErrAccountExists
 		}
 		// Create it as an adhoc
-		acc, err := w.DB.Account.Create().SetWallet(wallet).SetAddress(address).SetPrivateKey(hex.EncodeToString(priv)).Save(w.Ctx)
+		acc, err := w.DB.Account.Create().SetWallet(wallet).SetAddress(address).SetPrivateKey(hex.EncodeToString(priv)).Save(ctx)
 		if err != nil {
 			return nil, err
 		}
+		w.accountsChanged([]string{address}, nil)
 		return acc, nil
 	}
 
-	account, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Order(ent.Desc(account.FieldAccountIndex)).First(w.Ctx)
+	account, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Order(ent.Desc(account.FieldAccountIndex)).First(ctx)
 
 	if err != nil {
 		return nil, err
@@ -110,7 +112,7 @@ func (w *NanoWallet) AccountCreate(wallet *ent.Wallet, index *int) (*ent.Account
 			return nil, err
 		}
 		address := utils.PubKeyToAddress(pub, w.Banano)
-		exists, err := w.AccountExists(wallet, address)
+		exists, err := w.AccountExists(ctx, wallet, address)
 		if err != nil {
 			return nil, err
 		}
@@ -118,17 +120,18 @@ func (w *NanoWallet) AccountCreate(wallet *ent.Wallet, index *int) (*ent.Account
 			runningIndex++
 			continue
 		}
-		newAcc, err := w.DB.Account.Create().SetWallet(wallet).SetAccountIndex(runningIndex).SetAddress(address).Save(w.Ctx)
+		newAcc, err := w.DB.Account.Create().SetWallet(wallet).SetAccountIndex(runningIndex).SetAddress(address).Save(ctx)
 		if err != nil {
 			return nil, err
 		}
+		w.accountsChanged([]string{address}, nil)
 
 		return newAcc, nil
 	}
 	return nil, ErrUnableToCreateAccount
 }
 
-func (w *NanoWallet) AccountsCreate(wallet *ent.Wallet, count int) ([]*ent.Account, error) {
+func (w *NanoWallet) AccountsCreate(ctx context.Context, wallet *ent.Wallet, count int) ([]*ent.Account, error) {
 	if wallet == nil {
 		return nil, ErrInvalidWallet
 	} else if count < 1 {
@@ -136,11 +139,11 @@ func (w *NanoWallet) AccountsCreate(wallet *ent.Wallet, count int) ([]*ent.Accou
 	}
 
 	// Obtain a lock, prevent concurrent calls
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
 	if err != nil {
 		return nil, database.ErrLockNotObtained
 	}
-	defer lock.Release(w.Ctx)
+	defer lock.Release(context.WithoutCancel(ctx))
 
 	// Get seed
 	seed, err := GetDecryptedKeyFromStorage(wallet, "seed")
@@ -148,7 +151,7 @@ func (w *NanoWallet) AccountsCreate(wallet *ent.Wallet, count int) ([]*ent.Accou
 		return nil, err
 	}
 
-	acc, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Order(ent.Desc(account.FieldAccountIndex)).First(w.Ctx)
+	acc, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Order(ent.Desc(account.FieldAccountIndex)).First(ctx)
 
 	if err != nil {
 		return nil, err
@@ -156,7 +159,7 @@ func (w *NanoWallet) AccountsCreate(wallet *ent.Wallet, count int) ([]*ent.Accou
 
 	nextIndex := *acc.AccountIndex + 1
 
-	tx, err := w.DB.Tx(w.Ctx)
+	tx, err := w.DB.Tx(ctx)
 	var accounts []*ent.Account
 	for i := 0; i < count; i++ {
 		// Derive next account
@@ -166,7 +169,7 @@ func (w *NanoWallet) AccountsCreate(wallet *ent.Wallet, count int) ([]*ent.Accou
 			return nil, err
 		}
 		address := utils.PubKeyToAddress(pub, w.Banano)
-		count, err := tx.Account.Query().Where(account.WalletID(wallet.ID), account.Address(address)).Count(w.Ctx)
+		count, err := tx.Account.Query().Where(account.WalletID(wallet.ID), account.Address(address)).Count(ctx)
 		if err != nil {
 			tx.Rollback()
 			return nil, err
@@ -177,7 +180,7 @@ func (w *NanoWallet) AccountsCreate(wallet *ent.Wallet, count int) ([]*ent.Accou
 			i--
 			continue
 		}
-		acct, err := tx.Account.Create().SetWallet(wallet).SetAccountIndex(nextIndex).SetAddress(address).Save(w.Ctx)
+		acct, err := tx.Account.Create().SetWallet(wallet).SetAccountIndex(nextIndex).SetAddress(address).Save(ctx)
 		if err != nil {
 			tx.Rollback()
 			return nil, err
@@ -189,13 +192,18 @@ func (w *NanoWallet) AccountsCreate(wallet *ent.Wallet, count int) ([]*ent.Accou
 	if err != nil {
 		return nil, err
 	}
+	addresses := make([]string, len(accounts))
+	for i, acct := range accounts {
+		addresses[i] = acct.Address
+	}
+	w.accountsChanged(addresses, nil)
 
 	return accounts, nil
 }
 
 // Create an adhoc account. Returns adhocaccount or account if already exists
 // Already existing account may be adhoc or non-adhoc
-func (w *NanoWallet) AdhocAccountCreate(wallet *ent.Wallet, privKey ed25519.PrivateKey) (*ent.Account, error) {
+func (w *NanoWallet) AdhocAccountCreate(ctx context.Context, wallet *ent.Wallet, privKey ed25519.PrivateKey) (*ent.Account, error) {
 	// Input validations
 	if wallet == nil {
 		return nil, ErrInvalidWallet
@@ -204,11 +212,11 @@ func (w *NanoWallet) AdhocAccountCreate(wallet *ent.Wallet, privKey ed25519.Priv
 	}
 
 	// Obtain a lock, prevent concurrent calls
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
 	if err != nil {
 		return nil, database.ErrLockNotObtained
 	}
-	defer lock.Release(w.Ctx)
+	defer lock.Release(context.WithoutCancel(ctx))
 
 	// Determine if wallet is locked or not
 	_, err = GetDecryptedKeyFromStorage(wallet, "seed")
@@ -221,7 +229,7 @@ func (w *NanoWallet) AdhocAccountCreate(wallet *ent.Wallet, privKey ed25519.Priv
 	address := utils.PubKeyToAddress(pub, w.Banano)
 
 	// See if account already exists
-	acct, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.Address(address)).First(w.Ctx)
+	acct, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.Address(address)).First(ctx)
 	if err == nil {
 		return acct, nil
 	} else if !ent.IsNotFound(err) {
@@ -230,16 +238,22 @@ func (w *NanoWallet) AdhocAccountCreate(wallet *ent.Wallet, privKey ed25519.Priv
 	}
 
 	// Create adhoc account
-	adhocAcct, err := w.DB.Account.Create().SetWallet(wallet).SetAddress(address).SetPrivateKey(hex.EncodeToString(privKey)).Save(w.Ctx)
+	adhocAcct, err := w.DB.Account.Create().SetWallet(wallet).SetAddress(address).SetPrivateKey(hex.EncodeToString(privKey)).Save(ctx)
 	if err != nil {
 		return nil, err
 	}
+	w.accountsChanged([]string{address}, nil)
 
 	return adhocAcct, nil
 }
 
+// Addresses of the accounts in every wallet
+func (w *NanoWallet) AllAccountAddresses(ctx context.Context) ([]string, error) {
+	return w.DB.Account.Query().Select(account.FieldAddress).Strings(ctx)
+}
+
 // Retrieve list of accounts on a wallet, if not locked
-func (w *NanoWallet) AccountsList(wallet *ent.Wallet, limit int) ([]*ent.Account, []string, error) {
+func (w *NanoWallet) AccountsList(ctx context.Context, wallet *ent.Wallet, limit int) ([]*ent.Account, []string, error) {
 	if wallet == nil {
 		return nil, nil, ErrInvalidWallet
 	}
@@ -253,12 +267,12 @@ func (w *NanoWallet) AccountsList(wallet *ent.Wallet, limit int) ([]*ent.Account
 	// Get accounts
 	var accounts []*ent.Account
 	if limit > 0 {
-		accounts, err = w.DB.Account.Query().Where(account.WalletID(wallet.ID)).Limit(limit).All(w.Ctx)
+		accounts, err = w.DB.Account.Query().Where(account.WalletID(wallet.ID)).Limit(limit).All(ctx)
 		if err != nil {
 			return nil, nil, err
 		}
 	} else {
-		accounts, err = w.DB.Account.Query().Where(account.WalletID(wallet.ID)).All(w.Ctx)
+		accounts, err = w.DB.Account.Query().Where(account.WalletID(wallet.ID)).All(ctx)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -273,7 +287,7 @@ func (w *NanoWallet) AccountsList(wallet *ent.Wallet, limit int) ([]*ent.Account
 	return accounts, addresses, nil
 }
 
-func (w *NanoWallet) AccountExists(wallet *ent.Wallet, address string) (bool, error) {
+func (w *NanoWallet) AccountExists(ctx context.Context, wallet *ent.Wallet, address string) (bool, error) {
 	if wallet == nil {
 		return false, ErrInvalidWallet
 	}
@@ -285,7 +299,7 @@ func (w *NanoWallet) AccountExists(wallet *ent.Wallet, address string) (bool, er
 	}
 
 	// Get accounts
-	count, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.Address(address)).Count(w.Ctx)
+	count, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.Address(address)).Count(ctx)
 	if err != nil {
 		return false, err
 	}
@@ -0,0 +1,183 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+// We track the frontier, balance and representative of accounts as we publish blocks
+// This lets consecutive blocks from a hot account be built back-to-back without asking the node for account_info
+// and waiting for it to learn about the previous block. State lives in redis so it's shared between instances,
+// it's only read and written while holding the account's acl: lock.
+
+// How long we trust the local state without hearing anything about the account
+const accountStateExpiry = time.Minute * 10
+
+// Cap on how many unconfirmed hashes we remember per account
+const maxUnconfirmedTracked = 1000
+
+func accountStateKey(address string) string {
+	return fmt.Sprintf("account_state:%s", address)
+}
+
+// Retrieve the locally tracked state of an account, nil if we aren't tracking it
+func (w *NanoWallet) GetAccountState(address string) *models.AccountState {
+	raw, err := database.GetRedisDB().Get(accountStateKey(address))
+	if err != nil {
+		return nil
+	}
+	var state models.AccountState
+	if err := json.Unmarshal([]byte(raw), &state); err != nil {
+		return nil
+	}
+	return &state
+}
+
+func (w *NanoWallet) setAccountState(address string, state *models.AccountState) {
+	if len(state.Unconfirmed) > maxUnconfirmedTracked {
+		state.Unconfirmed = state.Unconfirmed[len(state.Unconfirmed)-maxUnconfirmedTracked:]
+	}
+	serialized, err := json.Marshal(state)
+	if err != nil {
+		return
+	}
+	if err := database.GetRedisDB().Set(accountStateKey(address), string(serialized), accountStateExpiry); err != nil {
+		log.Errorf("Error saving account state for %s %v", address, err)
+	}
+}
+
+// Stop tracking an account, the next block will be built from the node's account_info
+func (w *NanoWallet) ClearAccountState(address string) {
+	database.GetRedisDB().Del(accountStateKey(address))
+}
+
+// Account info from the locally tracked state if we have it, otherwise from the node
+// Only Frontier, Balance, Representative and AccountVersion are populated from local state
+// The state starts from a quorum read and only moves on with blocks we published, see UpdateAccountStateFromConfirmation
+func (w *NanoWallet) getAccountInfo(ctx context.Context, address string) (*responses.AccountInfoResponse, error) {
+	// Don't build anything on an account we know diverged from the network
+	if state := w.GetAccountState(address); state != nil {
+		if state.NeedsResync {
+			return nil, ErrAccountNeedsResync
+		}
+		return &responses.AccountInfoResponse{
+			Frontier:       state.Frontier,
+			Balance:        state.Balance,
+			Representative: state.Representative,
+			AccountVersion: state.Version,
+		}, nil
+	}
+	// The flag is only looked up when the state isn't cached, it's cached along with it
+	if needsResync, err := w.needsResync(ctx, address); err != nil {
+		return nil, err
+	} else if needsResync {
+		w.setAccountState(address, &models.AccountState{NeedsResync: true})
+		return nil, ErrAccountNeedsResync
+	}
+	accountInfo, err := w.RpcClient.MakeAccountInfoRequest(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	w.setAccountState(address, &models.AccountState{
+		Frontier:       strings.ToUpper(accountInfo.Frontier),
+		Balance:        accountInfo.Balance,
+		Representative: accountInfo.Representative,
+		Version:        accountInfo.AccountVersion,
+	})
+	return accountInfo, nil
+}
+
+// Publish a block we created and advance the local state of the account to it
+// If the node rejects it our view of the account can't be trusted anymore, so we drop it
+// Rejections because of the previous block may mean we forked, see checkFork
+func (w *NanoWallet) publishBlock(ctx context.Context, sb *models.StateBlock, subtype string) (string, error) {
+	// The node may accept the block even if the request is cancelled meanwhile, so we see it through
+	// Otherwise a retried send with the same id would find nothing saved and pay again
+	ctx = context.WithoutCancel(ctx)
+	resp, err := w.RpcClient.MakeProcessRequest(ctx, requests.ProcessRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "process",
+		},
+		Subtype:   &subtype,
+		JsonBlock: true,
+		Block:     *sb,
+	})
+	if err == nil && !utils.Validate64HexHash(resp.Hash) {
+		err = fmt.Errorf("invalid hash returned from node %s", resp.Hash)
+	}
+	if err != nil {
+		w.ClearAccountState(sb.Account)
+		if isPreviousMismatch(err) {
+			err = w.checkFork(ctx, sb, err)
+		}
+		return "", err
+	}
+	var unconfirmed []string
+	var version string
+	var previousBalance string
+	if state := w.GetAccountState(sb.Account); state != nil {
+		unconfirmed = state.Unconfirmed
+		version = state.Version
+		previousBalance = state.Balance
+	}
+	w.setAccountState(sb.Account, &models.AccountState{
+		Frontier:       strings.ToUpper(sb.Hash),
+		Balance:        sb.Balance,
+		Representative: sb.Representative,
+		Version:        version,
+		Unconfirmed:    append(unconfirmed, strings.ToUpper(sb.Hash)),
+	})
+	w.trackPublishedBlock(resp.Hash, sb, subtype)
+	w.emitPublishedEvent(ctx, sb, subtype, resp.Hash, previousBalance)
+
+	return resp.Hash, nil
+}
+
+// Apply a confirmation from the node websocket to the local state of an account
+// Confirmations of our own blocks just mark them confirmed, a block built on our frontier by someone else is adopted,
+// anything else means our view diverged from the network and we stop tracking the account.
+// With a quorum, blocks by someone else come from a single node, so the account is read again through the quorum instead.
+// If the account is busy we skip the update, a stale frontier gets rejected by the node and cleared on publish anyway.
+func (w *NanoWallet) UpdateAccountStateFromConfirmation(ctx context.Context, address string, hash string, previous string, balance string, representative string) {
+	// Most confirmations aren't for accounts we're tracking, avoid taking a lock for those
+	if w.GetAccountState(address) == nil {
+		return
+	}
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("acl:%s", address), time.Second*30, nil)
+	if err != nil {
+		return
+	}
+	defer lock.Release(context.WithoutCancel(ctx))
+
+	state := w.GetAccountState(address)
+	if state == nil {
+		return
+	}
+	hash = strings.ToUpper(hash)
+	if idx := slices.Index(state.Unconfirmed, hash); idx >= 0 {
+		state.Unconfirmed = slices.Delete(state.Unconfirmed, idx, idx+1)
+		w.setAccountState(address, state)
+		return
+	} else if strings.EqualFold(previous, state.Frontier) && w.RpcClient.Quorum <= 1 {
+		state.Frontier = hash
+		state.Balance = balance
+		state.Representative = representative
+		w.setAccountState(address, state)
+		return
+	} else if hash == state.Frontier {
+		return
+	}
+	log.Infof("Confirmation %s for %s doesn't match local state, will refresh from node", hash, address)
+	w.ClearAccountState(address)
+}
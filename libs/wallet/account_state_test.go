@@ -0,0 +1,115 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountStateTracking(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	accountInfoCalls := 0
+	processFails := false
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				accountInfoCalls++
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			} else if pr.Action == "process" && !processFails {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "Fork",
+			})
+			return resp, err
+		},
+	)
+
+	seed, err := utils.GenerateSeed(strings.NewReader("7A1C5E9B3D2F4A6C8E0B1D3F5A7C9E2B4D6F8A0C1E3B5D7F9A2C4E6B8D0F1A3C"))
+	assert.Nil(t, err)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	wallet.Representative = utils.ToPtr("nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs")
+	acc, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, MockWallet.GetAccountState(acc.Address))
+
+	// Consecutive sends chain off local state with a single account_info
+	work := "0000000000000000"
+	destination := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	_, err = MockWallet.CreateAndPublishSendBlock(context.Background(), wallet, "1", acc.Address, destination, nil, &work, nil)
+	assert.Nil(t, err)
+	first := MockWallet.GetAccountState(acc.Address)
+	assert.NotNil(t, first)
+	assert.Equal(t, "11999999999999999918751838129509869130", first.Balance)
+	assert.Equal(t, "nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs", first.Representative)
+	assert.Len(t, first.Unconfirmed, 1)
+
+	sb, err := MockWallet.createSendBlock(context.Background(), wallet, acc, "1", destination, &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, first.Frontier, sb.Previous)
+	assert.Equal(t, "11999999999999999918751838129509869129", sb.Balance)
+	assert.Equal(t, 1, accountInfoCalls)
+
+	// Confirmation of our own block marks it confirmed
+	MockWallet.UpdateAccountStateFromConfirmation(context.Background(), acc.Address, first.Unconfirmed[0], "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F", first.Balance, first.Representative)
+	state := MockWallet.GetAccountState(acc.Address)
+	assert.Equal(t, first.Frontier, state.Frontier)
+	assert.Len(t, state.Unconfirmed, 0)
+
+	// A block built on our frontier by someone else is adopted
+	MockWallet.UpdateAccountStateFromConfirmation(context.Background(), acc.Address, "8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72", strings.ToLower(first.Frontier), "5", destination)
+	state = MockWallet.GetAccountState(acc.Address)
+	assert.Equal(t, "8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72", state.Frontier)
+	assert.Equal(t, "5", state.Balance)
+	assert.Equal(t, destination, state.Representative)
+
+	// Unless reads need a quorum, then it's left to the next quorum read
+	MockWallet.RpcClient.Quorum = 2
+	MockWallet.UpdateAccountStateFromConfirmation(context.Background(), acc.Address, "C1E8D9F7A3B0D5E4F6A7B8C9D0E1F2A3B4C5D6E7F8A9B0C1D2E3F4A5B6C7D8E9", "8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72", "4", destination)
+	MockWallet.RpcClient.Quorum = 0
+	assert.Nil(t, MockWallet.GetAccountState(acc.Address))
+
+	// Anything else means we diverged
+	MockWallet.setAccountState(acc.Address, state)
+	MockWallet.UpdateAccountStateFromConfirmation(context.Background(), acc.Address, "CE898C131AAEE25E05362F247760F8A3ACF34A9796A5AE0D9204E86B0637965E", "0E3F07F7F2B8AEDEA4A984E29BFE1E3933BA473DD3E27C662EC041F6EA3917A0", "5", destination)
+	assert.Nil(t, MockWallet.GetAccountState(acc.Address))
+
+	// A rejected block drops local state, the node is on another frontier so only the resync flag is left
+	MockWallet.setAccountState(acc.Address, &models.AccountState{Frontier: first.Frontier, Balance: first.Balance, Representative: first.Representative})
+	processFails = true
+	_, err = MockWallet.CreateAndPublishSendBlock(context.Background(), wallet, "1", acc.Address, destination, nil, &work, nil)
+	assert.NotNil(t, err)
+	state = MockWallet.GetAccountState(acc.Address)
+	assert.Equal(t, "", state.Frontier)
+	assert.True(t, state.NeedsResync)
+
+	// A cancelled request still sees the block through the node
+	processFails = false
+	MockWallet.ClearAccountState(acc.Address)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	hash, err := MockWallet.publishBlock(ctx, sb, "send")
+	assert.Nil(t, err)
+	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", hash)
+	assert.Equal(t, strings.ToUpper(sb.Hash), MockWallet.GetAccountState(acc.Address).Frontier)
+}
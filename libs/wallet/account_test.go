@@ -1,6 +1,7 @@
 package wallet
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -14,40 +15,40 @@ func TestGetAccount(t *testing.T) {
 	// Predictable seed
 	seed, _ := utils.GenerateSeed(strings.NewReader("63bbf524e6bae9e2bf22c9967f8fd093d1ce906b5dc581ba2f3529156efbae92"))
 
-	wallet, err := MockWallet.WalletCreate(seed)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
 	assert.Nil(t, err)
 
 	assert.Equal(t, false, wallet.Encrypted)
 	assert.Equal(t, seed, wallet.Seed)
 
 	// Create an account and an adhoc account
-	account, err := MockWallet.AccountCreate(wallet, nil)
+	account, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
 	assert.Nil(t, err)
 
 	_, priv, _ := ed25519.GenerateKey(strings.NewReader("1f729340e07eee69abac049c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040d"))
-	adhocAcct, err := MockWallet.AdhocAccountCreate(wallet, priv)
+	adhocAcct, err := MockWallet.AdhocAccountCreate(context.Background(), wallet, priv)
 	assert.Nil(t, err)
 
 	// Retrieve account
-	retrieved, err := MockWallet.GetAccount(wallet, account.Address)
+	retrieved, err := MockWallet.GetAccount(context.Background(), wallet, account.Address)
 	assert.Nil(t, err)
 	assert.Equal(t, account.Address, retrieved.Address)
 
 	// Retrieve adhoc account
-	retrievedAdhoc, err := MockWallet.GetAccount(wallet, adhocAcct.Address)
+	retrievedAdhoc, err := MockWallet.GetAccount(context.Background(), wallet, adhocAcct.Address)
 	assert.Nil(t, err)
 	assert.Equal(t, adhocAcct.Address, retrievedAdhoc.Address)
 
 	// Retrieve by address
-	retrieved, err = MockWallet.GetAccountByAddress(account.Address)
+	retrieved, err = MockWallet.GetAccountByAddress(context.Background(), account.Address)
 	assert.Nil(t, err)
 	assert.Equal(t, account.Address, retrieved.Address)
 
 	// Retrieve unknown account
-	_, err = MockWallet.GetAccount(wallet, "nano_1efa1gxbitary1urzix9h13nkzadtz71n3auyj7uztb8i4qbtipu8cxz61ee")
+	_, err = MockWallet.GetAccount(context.Background(), wallet, "nano_1efa1gxbitary1urzix9h13nkzadtz71n3auyj7uztb8i4qbtipu8cxz61ee")
 	assert.ErrorIs(t, ErrAccountNotFound, err)
 
-	_, err = MockWallet.GetAccountByAddress("nano_1efa1gxbitary1urzix9h13nkzadtz71n3auyj7uztb8i4qbtipu8cxz61ee")
+	_, err = MockWallet.GetAccountByAddress(context.Background(), "nano_1efa1gxbitary1urzix9h13nkzadtz71n3auyj7uztb8i4qbtipu8cxz61ee")
 	assert.ErrorIs(t, ErrAccountNotFound, err)
 }
 
@@ -55,47 +56,47 @@ func TestAccountCreate(t *testing.T) {
 	// Predictable seed
 	seed, _ := utils.GenerateSeed(strings.NewReader("5f729340e07eee69abac049c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040c"))
 
-	wallet, err := MockWallet.WalletCreate(seed)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
 	assert.Nil(t, err)
 
 	// Create a couple accounts and ensure they are sequential
-	acct, err := MockWallet.AccountCreate(wallet, nil)
+	acct, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, *acct.AccountIndex)
 	assert.Equal(t, "nano_3tdqk8ghsdfapzhrag5978izd19minorfmxergefkecdsbyxaw6og4fejs89", acct.Address)
 
-	acct, err = MockWallet.AccountCreate(wallet, nil)
+	acct, err = MockWallet.AccountCreate(context.Background(), wallet, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, *acct.AccountIndex)
 	assert.Equal(t, "nano_1frwge7oebdn87jip7k3sa1uuyf4yxxjh8jg67i69r7smf7tddj1gr6yremf", acct.Address)
 
 	// Test collision
 	idx := 2
-	acct, err = MockWallet.AccountCreate(wallet, &idx)
+	acct, err = MockWallet.AccountCreate(context.Background(), wallet, &idx)
 	assert.ErrorIs(t, ErrAccountExists, err)
 
 	// Test at index
 	idx = 3
-	acct, err = MockWallet.AccountCreate(wallet, &idx)
+	acct, err = MockWallet.AccountCreate(context.Background(), wallet, &idx)
 	assert.Nil(t, err)
 	assert.Equal(t, "69d5b476d8e892213a17511b977de91eb41adc9b8bf46e25a42da3d4de70427bd194c238a97aa0aa436cfb4507f2bd4c1e8e4adb5f91f0e56eb30ee4da723493", *acct.PrivateKey)
 	assert.Equal(t, "nano_3nenrawckyo1ob3psyt71zsdtm1yjs7fpqwjy5kpxergwmf96f6mdenouyyk", acct.Address)
 
 	// Test now that sequence is broken
-	acct, err = MockWallet.AccountCreate(wallet, nil)
+	acct, err = MockWallet.AccountCreate(context.Background(), wallet, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, 4, *acct.AccountIndex)
 	assert.Equal(t, "nano_1w84hd687n5nywb1zfjat7ghe7czgo39tyeqbnbi67icr4fornhqgh3w5q6a", acct.Address)
 
 	// Check that it fails if wallet is locked
-	MockWallet.EncryptWallet(wallet, "password")
-	_, err = MockWallet.AccountCreate(wallet, nil)
+	MockWallet.EncryptWallet(context.Background(), wallet, "password")
+	_, err = MockWallet.AccountCreate(context.Background(), wallet, nil)
 	assert.ErrorIs(t, ErrWalletLocked, err)
 }
 
 func TestAccountCreateBadInput(t *testing.T) {
 	// Empty seed
-	_, err := MockWallet.AccountCreate(nil, nil)
+	_, err := MockWallet.AccountCreate(context.Background(), nil, nil)
 	assert.ErrorIs(t, ErrInvalidWallet, err)
 }
 
@@ -103,24 +104,24 @@ func TestAdhocAccountCreate(t *testing.T) {
 	// Predictable seed
 	seed, _ := utils.GenerateSeed(strings.NewReader("aa729340e07eee69abac049c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040d"))
 
-	wallet, err := MockWallet.WalletCreate(seed)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
 	assert.Nil(t, err)
 
 	// Create a couple accounts and ensure they are sequential
-	acct, err := MockWallet.AccountCreate(wallet, nil)
+	acct, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, *acct.AccountIndex)
 	assert.Equal(t, "nano_3suihcm3txrfcnipecixeu7kcdm8jisyb1osy14j1r5na1c17g7kkbbu143o", acct.Address)
 
 	_, priv, _ := ed25519.GenerateKey(strings.NewReader("1f729340e07eee69abac049c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040d"))
-	adhocAcct, err := MockWallet.AdhocAccountCreate(wallet, priv)
+	adhocAcct, err := MockWallet.AdhocAccountCreate(context.Background(), wallet, priv)
 	assert.Nil(t, err)
 	assert.Equal(t, "31663732393334306530376565653639616261633034396332666464346133632c9c941001f4236f487aac98848320ce746f7b809af76e5e795835ef30022424", *adhocAcct.PrivateKey)
 	assert.Equal(t, "nano_1d6wkia15x35fx69od6rik3k3mmnfxxr38qqfsh9kp3oxwr16b36eztouu3a", adhocAcct.Address)
 	assert.Equal(t, wallet.ID, adhocAcct.WalletID)
 
 	// Test duplicate returns same account
-	adhocAcct, err = MockWallet.AdhocAccountCreate(wallet, priv)
+	adhocAcct, err = MockWallet.AdhocAccountCreate(context.Background(), wallet, priv)
 	assert.Nil(t, err)
 	assert.Equal(t, "31663732393334306530376565653639616261633034396332666464346133632c9c941001f4236f487aac98848320ce746f7b809af76e5e795835ef30022424", *adhocAcct.PrivateKey)
 	assert.Equal(t, "nano_1d6wkia15x35fx69od6rik3k3mmnfxxr38qqfsh9kp3oxwr16b36eztouu3a", adhocAcct.Address)
@@ -128,21 +129,21 @@ func TestAdhocAccountCreate(t *testing.T) {
 
 	// Test we get non-adhoc account if we are trying to re-create it
 	_, priv, _ = utils.KeypairFromSeed(seed, 1)
-	acct, err = MockWallet.AdhocAccountCreate(wallet, priv)
+	acct, err = MockWallet.AdhocAccountCreate(context.Background(), wallet, priv)
 	assert.Equal(t, "nano_3suihcm3txrfcnipecixeu7kcdm8jisyb1osy14j1r5na1c17g7kkbbu143o", acct.Address)
 	assert.Equal(t, 1, *acct.AccountIndex)
 
 	// Check that it fails if wallet is locked
-	MockWallet.EncryptWallet(wallet, "password")
-	_, err = MockWallet.AdhocAccountCreate(wallet, priv)
+	MockWallet.EncryptWallet(context.Background(), wallet, "password")
+	_, err = MockWallet.AdhocAccountCreate(context.Background(), wallet, priv)
 	assert.ErrorIs(t, ErrWalletLocked, err)
 }
 
 func TestAdhocAccountCreateBadInput(t *testing.T) {
-	_, err := MockWallet.AdhocAccountCreate(nil, nil)
+	_, err := MockWallet.AdhocAccountCreate(context.Background(), nil, nil)
 	assert.ErrorIs(t, ErrInvalidWallet, err)
 	// Bad private key
-	_, err = MockWallet.AdhocAccountCreate(&ent.Wallet{}, nil)
+	_, err = MockWallet.AdhocAccountCreate(context.Background(), &ent.Wallet{}, nil)
 	assert.ErrorIs(t, ErrInvalidPrivKey, err)
 }
 
@@ -150,11 +151,11 @@ func TestAccountsCreate(t *testing.T) {
 	// Predictable seed
 	seed, _ := utils.GenerateSeed(strings.NewReader("9f729340e07eee69abac049c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040e"))
 
-	wallet, err := MockWallet.WalletCreate(seed)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
 	assert.Nil(t, err)
 
 	// Create a couple accounts and ensure they are sequential
-	accts, err := MockWallet.AccountsCreate(wallet, 10)
+	accts, err := MockWallet.AccountsCreate(context.Background(), wallet, 10)
 	assert.Nil(t, err)
 	assert.Len(t, accts, 10)
 	assert.Equal(t, 1, *accts[0].AccountIndex)
@@ -180,17 +181,17 @@ func TestAccountsCreate(t *testing.T) {
 
 	// Lets break the sequence
 	idx := 11
-	_, err = MockWallet.AccountCreate(wallet, &idx)
+	_, err = MockWallet.AccountCreate(context.Background(), wallet, &idx)
 	assert.Nil(t, err)
 	idx++
-	_, err = MockWallet.AccountCreate(wallet, &idx)
+	_, err = MockWallet.AccountCreate(context.Background(), wallet, &idx)
 	assert.Nil(t, err)
 	idx++
-	_, err = MockWallet.AccountCreate(wallet, &idx)
+	_, err = MockWallet.AccountCreate(context.Background(), wallet, &idx)
 	assert.Nil(t, err)
 
 	// Create 3 more
-	accts, err = MockWallet.AccountsCreate(wallet, 3)
+	accts, err = MockWallet.AccountsCreate(context.Background(), wallet, 3)
 	assert.Nil(t, err)
 	assert.Len(t, accts, 3)
 	assert.Equal(t, 14, *accts[0].AccountIndex)
@@ -198,15 +199,15 @@ func TestAccountsCreate(t *testing.T) {
 	assert.Equal(t, 16, *accts[2].AccountIndex)
 
 	// Check that it fails if wallet is locked
-	MockWallet.EncryptWallet(wallet, "password")
-	_, err = MockWallet.AccountsCreate(wallet, 100)
+	MockWallet.EncryptWallet(context.Background(), wallet, "password")
+	_, err = MockWallet.AccountsCreate(context.Background(), wallet, 100)
 	assert.ErrorIs(t, ErrWalletLocked, err)
 }
 
 func TestAccountsCreateBadInput(t *testing.T) {
-	_, err := MockWallet.AccountsCreate(nil, 10)
+	_, err := MockWallet.AccountsCreate(context.Background(), nil, 10)
 	assert.ErrorIs(t, ErrInvalidWallet, err)
-	_, err = MockWallet.AccountsCreate(&ent.Wallet{}, 0)
+	_, err = MockWallet.AccountsCreate(context.Background(), &ent.Wallet{}, 0)
 	assert.ErrorIs(t, ErrInvalidAccountCount, err)
 }
 
@@ -215,21 +216,21 @@ func TestAccountsList(t *testing.T) {
 	seed, _ := utils.GenerateSeed(strings.NewReader("1111111111111111abac049c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040e"))
 
 	// +1 This by default creates an account
-	wallet, err := MockWallet.WalletCreate(seed)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
 	assert.Nil(t, err)
 
 	// Create some accounts and adhoc accounts to make sure they come back in results
 	// +5
-	_, err = MockWallet.AccountsCreate(wallet, 5)
+	_, err = MockWallet.AccountsCreate(context.Background(), wallet, 5)
 	assert.Nil(t, err)
 
 	_, priv, _ := utils.KeypairFromSeed(seed, 100)
 
 	// +1
-	_, err = MockWallet.AdhocAccountCreate(wallet, priv)
+	_, err = MockWallet.AdhocAccountCreate(context.Background(), wallet, priv)
 
 	// List accounts
-	_, accts, err := MockWallet.AccountsList(wallet, 100)
+	_, accts, err := MockWallet.AccountsList(context.Background(), wallet, 100)
 	assert.Nil(t, err)
 	// We actually have 7 accounts because WalletCreate implicitly creates the first one
 	// So this isn't a mistake
@@ -244,7 +245,7 @@ func TestAccountsList(t *testing.T) {
 	assert.Contains(t, accts, "nano_1bzpyc67m6hzhm8egshbnyseowohs11d7hkcw4ksz8guetsyegkx3r1ns6s4")
 
 	// 0 removes the limit clause
-	_, accts, err = MockWallet.AccountsList(wallet, 0)
+	_, accts, err = MockWallet.AccountsList(context.Background(), wallet, 0)
 	assert.Nil(t, err)
 	// We actually have 7 accounts because WalletCreate implicitly creates the first one
 	// So this isn't a mistake
@@ -259,33 +260,68 @@ func TestAccountsList(t *testing.T) {
 	assert.Contains(t, accts, "nano_1bzpyc67m6hzhm8egshbnyseowohs11d7hkcw4ksz8guetsyegkx3r1ns6s4")
 
 	// Check that it fails if wallet is locked
-	MockWallet.EncryptWallet(wallet, "password")
-	_, _, err = MockWallet.AccountsList(wallet, 100)
+	MockWallet.EncryptWallet(context.Background(), wallet, "password")
+	_, _, err = MockWallet.AccountsList(context.Background(), wallet, 100)
 	assert.ErrorIs(t, ErrWalletLocked, err)
 }
 
 func TestAccountExists(t *testing.T) {
 	// Create a test wallet
 	seed, _ := utils.GenerateSeed(strings.NewReader("cd92b5cf58554077ccd450a09ccbefaea04229c7d9bc54abba06dcaf7ed01af9"))
-	wallet, err := MockWallet.WalletCreate(seed)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
 	assert.Nil(t, err)
 
 	// Check that account exists if asle
-	exists, err := MockWallet.AccountExists(wallet, "nano_33mhuqjxr166czm4y37xk7emfnt4zogxmqrhbfxyngrkbdchmpsk6qehhm3n")
+	exists, err := MockWallet.AccountExists(context.Background(), wallet, "nano_33mhuqjxr166czm4y37xk7emfnt4zogxmqrhbfxyngrkbdchmpsk6qehhm3n")
 	assert.Nil(t, err)
 	assert.False(t, exists)
 
 	// Create an adhoc account
 	_, priv, _ := utils.KeypairFromSeed(seed, 100)
-	_, err = MockWallet.AdhocAccountCreate(wallet, priv)
+	_, err = MockWallet.AdhocAccountCreate(context.Background(), wallet, priv)
 	assert.Nil(t, err)
 
-	exists, err = MockWallet.AccountExists(wallet, "nano_1hpq679fqnsahkjz4d66nantwsjbkd1erjbycinbrmhcfnuketzhfaeoptu6")
+	exists, err = MockWallet.AccountExists(context.Background(), wallet, "nano_1hpq679fqnsahkjz4d66nantwsjbkd1erjbycinbrmhcfnuketzhfaeoptu6")
 	assert.Nil(t, err)
 	assert.True(t, exists)
 
 	// Create some accounts
-	_, err = MockWallet.AccountCreate(wallet, nil)
-	exists, err = MockWallet.AccountExists(wallet, "nano_1pidkij46sqyf7gan8fugj693z5ornpf449tikop83dwsuosy1o5164p1jry")
+	_, err = MockWallet.AccountCreate(context.Background(), wallet, nil)
+	exists, err = MockWallet.AccountExists(context.Background(), wallet, "nano_1pidkij46sqyf7gan8fugj693z5ornpf449tikop83dwsuosy1o5164p1jry")
 	assert.True(t, exists)
 }
+
+func TestAccountsHandler(t *testing.T) {
+	var added, removed []string
+	w := *MockWallet
+	w.AccountsHandler = func(a []string, r []string) {
+		added = append(added, a...)
+		removed = append(removed, r...)
+	}
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("4a1c7b9e0d2f3a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b"))
+	wallet, err := w.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	_, err = w.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+	_, err = w.AccountsCreate(context.Background(), wallet, 3)
+	assert.Nil(t, err)
+	_, priv, _ := utils.KeypairFromSeed(seed, 100)
+	_, err = w.AdhocAccountCreate(context.Background(), wallet, priv)
+	assert.Nil(t, err)
+	assert.Len(t, added, 6)
+	assert.Len(t, removed, 0)
+
+	all, err := w.AllAccountAddresses(context.Background())
+	assert.Nil(t, err)
+	assert.Subset(t, all, added)
+
+	// Everything goes with the wallet
+	assert.Nil(t, w.WalletDestroy(context.Background(), wallet))
+	assert.ElementsMatch(t, added, removed)
+	all, err = w.AllAccountAddresses(context.Background())
+	assert.Nil(t, err)
+	for _, address := range removed {
+		assert.NotContains(t, all, address)
+	}
+}
@@ -0,0 +1,85 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
+
+// How many accounts go in one accounts_receivable request
+const backfillBatchSize = 500
+
+// Receives what's receivable on every account of every unlocked wallet, respecting receive minimum and receive policies
+// Catches up on confirmations the websocket missed while it was disconnected
+// Returns how many blocks were received
+func (w *NanoWallet) BackfillReceivables(ctx context.Context) (int, error) {
+	// One sweep at a time across instances
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, "receivablebackfill", time.Minute*10, nil)
+	if err != nil {
+		return 0, database.ErrLockNotObtained
+	}
+	defer lock.Release(context.WithoutCancel(ctx))
+
+	accounts, err := w.DB.Account.Query().WithWallet().All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	byAddress := make(map[string]*ent.Account, len(accounts))
+	addresses := make([]string, 0, len(accounts))
+	for _, acc := range accounts {
+		byAddress[acc.Address] = acc
+		addresses = append(addresses, acc.Address)
+	}
+
+	received := 0
+	for start := 0; start < len(addresses); start += backfillBatchSize {
+		end := start + backfillBatchSize
+		if end > len(addresses) {
+			end = len(addresses)
+		}
+		receivable, err := w.RpcClient.MakeAccountsReceivableRequest(ctx, addresses[start:end], w.Config.Wallet.ReceiveMinimum)
+		if err != nil {
+			return received, err
+		}
+		for address, blocks := range receivable.Blocks {
+			acc, ok := byAddress[address]
+			if !ok {
+				continue
+			}
+			allowed := w.receiveFilter(ctx, acc, false)
+			for hash := range blocks {
+				if !allowed(hash) {
+					continue
+				}
+				err := w.backfillReceive(ctx, acc, hash)
+				if errors.Is(err, ErrWalletLocked) {
+					// The rest can wait until it's unlocked
+					break
+				} else if errors.Is(err, database.ErrLockNotObtained) {
+					continue
+				} else if err != nil {
+					log.Errorf("Error receiving %s on %s: %v", hash, address, err)
+					continue
+				}
+				received++
+			}
+		}
+	}
+	return received, nil
+}
+
+// Receives one block, unless the websocket callback or another instance is already on it
+func (w *NanoWallet) backfillReceive(ctx context.Context, acc *ent.Account, hash string) error {
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("blocklock:%s", hash), time.Second*30, nil)
+	if err != nil {
+		return database.ErrLockNotObtained
+	}
+	defer lock.Release(context.WithoutCancel(ctx))
+	_, err = w.CreateAndPublishReceiveBlock(ctx, acc.Edges.Wallet, acc.Address, hash, nil, nil)
+	return err
+}
@@ -0,0 +1,90 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/pow/powtest"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackfillReceivables(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("7d3e1f5a9b2c4d6e8f0a1b3c5d7e9f1a2b4c6d8e0f1a3b5c7d9e1f2a4b6c8d0e"))
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	receiver, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+	MockWallet.setAccountState(receiver.Address, &models.AccountState{
+		Frontier:       powtest.KnownHash,
+		Balance:        "1",
+		Representative: "nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs",
+	})
+
+	lockedSeed, _ := utils.GenerateSeed(strings.NewReader("0e8d6c4b2a1f9e7d5c3b1a0f8e6d4c2b1a9f7e5d3c1b0a8f6e4d2c1b9a7f5e3d"))
+	lockedWallet, err := MockWallet.WalletCreate(context.Background(), lockedSeed)
+	assert.Nil(t, err)
+	lockedAccount, err := MockWallet.AccountCreate(context.Background(), lockedWallet, nil)
+	assert.Nil(t, err)
+	_, err = MockWallet.EncryptWallet(context.Background(), lockedWallet, "password")
+	assert.Nil(t, err)
+
+	var requested []string
+	var published []models.StateBlock
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var raw map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&raw)
+			var js map[string]interface{}
+			switch raw["action"] {
+			case "accounts_receivable":
+				var pr requests.AccountsReceivableRequest
+				serialized, _ := json.Marshal(raw)
+				json.Unmarshal(serialized, &pr)
+				requested = append(requested, pr.Accounts...)
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"blocks": map[string]interface{}{
+						receiver.Address: map[string]string{
+							"FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE": "30000000000000000000000000000000000",
+						},
+						lockedAccount.Address: map[string]string{
+							"4C1FEEF0BEA7F50BE35489A1233FE002B212DEA554B55B1B470D78BD8F210C74": "30000000000000000000000000000000000",
+						},
+					},
+				})
+			case "block_info":
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+			case "process":
+				var pr requests.ProcessRequest
+				serialized, _ := json.Marshal(raw)
+				json.Unmarshal(serialized, &pr)
+				published = append(published, pr.Block)
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+			default:
+				js = map[string]interface{}{"error": "error"}
+			}
+			return httpmock.NewJsonResponse(200, js)
+		},
+	)
+
+	// Only the unlocked wallet can receive
+	received, err := MockWallet.BackfillReceivables(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, received)
+	assert.Contains(t, requested, receiver.Address)
+	assert.Contains(t, requested, lockedAccount.Address)
+	assert.Len(t, published, 1)
+	assert.Equal(t, receiver.Address, published[0].Account)
+	assert.Equal(t, "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE", published[0].Link)
+	assert.Equal(t, powtest.KnownWork, published[0].Work)
+}
@@ -1,19 +1,23 @@
 package wallet
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/appditto/pippin_nano_wallet/libs/database"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
 	entblock "github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
 	nanorpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
 	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
@@ -29,7 +33,7 @@ var ErrSameRepresentative = errors.New("same representative")
 
 // Retrieve a block for an account or adhoc account, error if it doesn't exist
 // Intended to retrieve a block with a particular send ID, to see if it's already been created
-func (w *NanoWallet) GetBlockFromDatabase(wallet *ent.Wallet, address string, sendID string) (*ent.Block, error) {
+func (w *NanoWallet) GetBlockFromDatabase(ctx context.Context, wallet *ent.Wallet, address string, sendID string) (*ent.Block, error) {
 	if wallet == nil {
 		return nil, ErrInvalidWallet
 	}
@@ -41,7 +45,7 @@ func (w *NanoWallet) GetBlockFromDatabase(wallet *ent.Wallet, address string, se
 	}
 
 	// Check if account exists
-	acc, err := w.GetAccount(wallet, address)
+	acc, err := w.GetAccount(ctx, wallet, address)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +53,7 @@ func (w *NanoWallet) GetBlockFromDatabase(wallet *ent.Wallet, address string, se
 	// Get block
 	var block *ent.Block
 	if acc != nil {
-		block, err = w.DB.Block.Query().Where(entblock.AccountID(acc.ID), entblock.SendID(sendID)).First(w.Ctx)
+		block, err = w.DB.Block.Query().Where(entblock.AccountID(acc.ID), entblock.SendID(sendID)).First(ctx)
 		if ent.IsNotFound(err) {
 			return nil, ErrBlockNotFound
 		} else if err != nil {
@@ -60,14 +64,79 @@ func (w *NanoWallet) GetBlockFromDatabase(wallet *ent.Wallet, address string, se
 	return block, nil
 }
 
+// Retrieve the signing key for an account, adhoc accounts store it directly while others derive it from the seed
+func (w *NanoWallet) getPrivateKey(wallet *ent.Wallet, acc *ent.Account) (ed25519.PrivateKey, error) {
+	if acc.PrivateKey != nil {
+		decoded, err := hex.DecodeString(*acc.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		return ed25519.PrivateKey(decoded), nil
+	}
+	sd, err := GetDecryptedKeyFromStorage(wallet, "seed")
+	if err != nil {
+		return nil, err
+	}
+	_, priv, _ := utils.KeypairFromSeed(sd, uint32(*acc.AccountIndex))
+	return priv, nil
+}
+
+// The wallet representative if set, otherwise a random preconfigured one
+func (w *NanoWallet) getDefaultRepresentative(wallet *ent.Wallet) (string, error) {
+	if wallet.Representative != nil {
+		return *wallet.Representative, nil
+	}
+	return w.Config.GetRandomRep()
+}
+
+// Re-publish a block we've already saved, returns its hash
+func (w *NanoWallet) republishSavedBlock(ctx context.Context, block *ent.Block) (string, error) {
+	var sb models.StateBlock
+	if err := mapstructure.Decode(block.Block, &sb); err != nil {
+		return "", err
+	}
+	subtype := block.Subtype
+	// Call process with same block
+	w.RpcClient.MakeProcessRequest(ctx, requests.ProcessRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "process",
+		},
+		Subtype:   &subtype,
+		JsonBlock: true,
+		Block:     sb,
+	})
+	return strings.ToUpper(sb.Hash), nil
+}
+
+// Work multiplier for a block built on top of accountInfo
+// Sends and changes always use the epoch v2 threshold, it's above the epoch v1 one so it's valid either way.
+// Receives follow the account's version, an unknown version gets the higher epoch v1 threshold.
+// Opens are at the epoch of their source, which we assume is v2.
+func (w *NanoWallet) workMultiplier(subtype string, accountInfo *responses.AccountInfoResponse) int {
+	epoch := 2
+	if subtype == "receive" && accountInfo != nil {
+		if version, err := strconv.Atoi(accountInfo.AccountVersion); err == nil {
+			epoch = version
+		} else {
+			epoch = 1
+		}
+	}
+	multiplier := pow.MultiplierForBlock(subtype, epoch, w.Config.Wallet.Banano)
+	if w.WorkClient == nil {
+		return multiplier
+	}
+	// Raise it during network saturation
+	return w.WorkClient.AdjustMultiplier(multiplier)
+}
+
 // ** Low level block creations, not intended for use by the user **
-func (w *NanoWallet) createReceiveBlock(wallet *ent.Wallet, receiver *ent.Account, hash string, precomputedWork *string, bpowKey *string) (*models.StateBlock, error) {
+func (w *NanoWallet) createReceiveBlock(ctx context.Context, wallet *ent.Wallet, receiver *ent.Account, hash string, precomputedWork *string, bpowKey *string) (*models.StateBlock, error) {
 	if wallet == nil {
 		return nil, ErrInvalidWallet
 	} else if receiver == nil {
 		return nil, ErrInvalidAccount
 	}
-	blockInfo, err := w.RpcClient.MakeBlockInfoRequest(hash)
+	blockInfo, err := w.RpcClient.MakeBlockInfoRequest(ctx, hash)
 	if err != nil {
 		return nil, err
 	} else if blockInfo == nil {
@@ -75,7 +144,7 @@ func (w *NanoWallet) createReceiveBlock(wallet *ent.Wallet, receiver *ent.Accoun
 	}
 	// Get account info
 	isOpen := true
-	accountInfo, err := w.RpcClient.MakeAccountInfoRequest(receiver.Address)
+	accountInfo, err := w.getAccountInfo(ctx, receiver.Address)
 	if errors.Is(err, nanorpc.ErrAccountNotFound) {
 		isOpen = false
 	} else if err != nil {
@@ -106,14 +175,9 @@ func (w *NanoWallet) createReceiveBlock(wallet *ent.Wallet, receiver *ent.Accoun
 	if isOpen {
 		representative = accountInfo.Representative
 	} else {
-		if wallet.Representative != nil {
-			representative = *wallet.Representative
-		} else {
-			rep, err := w.Config.GetRandomRep()
-			if err != nil {
-				return nil, err
-			}
-			representative = rep
+		representative, err = w.getDefaultRepresentative(wallet)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -138,7 +202,11 @@ func (w *NanoWallet) createReceiveBlock(wallet *ent.Wallet, receiver *ent.Accoun
 		if bpowKey != nil {
 			key = *bpowKey
 		}
-		work, err = w.WorkClient.WorkGenerateMeta(workbase, 1, true, false, key)
+		subtype := "open"
+		if isOpen {
+			subtype = "receive"
+		}
+		work, err = w.generateBlockWork(ctx, receiver, workbase, w.workMultiplier(subtype, accountInfo), key)
 		if err != nil {
 			return nil, err
 		}
@@ -158,19 +226,9 @@ func (w *NanoWallet) createReceiveBlock(wallet *ent.Wallet, receiver *ent.Accoun
 	}
 
 	// Get the private key for this account
-	var priv ed25519.PrivateKey
-	if receiver.PrivateKey != nil {
-		decoded, err := hex.DecodeString(*receiver.PrivateKey)
-		if err != nil {
-			return nil, err
-		}
-		priv = ed25519.PrivateKey(decoded)
-	} else {
-		sd, err := GetDecryptedKeyFromStorage(wallet, "seed")
-		if err != nil {
-			return nil, err
-		}
-		_, priv, _ = utils.KeypairFromSeed(sd, uint32(*receiver.AccountIndex))
+	priv, err := w.getPrivateKey(wallet, receiver)
+	if err != nil {
+		return nil, err
 	}
 
 	// Sign the block
@@ -183,7 +241,8 @@ func (w *NanoWallet) createReceiveBlock(wallet *ent.Wallet, receiver *ent.Accoun
 }
 
 // Receive all without locking the wallet
-func (w *NanoWallet) receiveAll(wallet *ent.Wallet, acc *ent.Account, bpowKey *string) (int, error) {
+// With a filter, only the hashes it returns true for
+func (w *NanoWallet) receiveAll(ctx context.Context, wallet *ent.Wallet, acc *ent.Account, bpowKey *string, filter func(hash string) bool) (int, error) {
 	if wallet == nil {
 		return 0, ErrInvalidWallet
 	} else if acc == nil {
@@ -191,7 +250,7 @@ func (w *NanoWallet) receiveAll(wallet *ent.Wallet, acc *ent.Account, bpowKey *s
 	}
 	receivedCount := 0
 	// Get pending
-	pending, err := w.RpcClient.MakeReceivableRequest(acc.Address, w.Config.Wallet.ReceiveMinimum)
+	pending, err := w.RpcClient.MakeReceivableRequest(ctx, acc.Address, w.Config.Wallet.ReceiveMinimum)
 	if err != nil {
 		return receivedCount, err
 	}
@@ -201,22 +260,17 @@ func (w *NanoWallet) receiveAll(wallet *ent.Wallet, acc *ent.Account, bpowKey *s
 
 	// Create and publish blocks
 	for hash := range pending.Blocks {
-		sb, err := w.createReceiveBlock(wallet, acc, hash, nil, bpowKey)
+		if filter != nil && !filter(hash) {
+			continue
+		}
+		sb, err := w.createReceiveBlock(ctx, wallet, acc, hash, nil, bpowKey)
 		if err != nil {
 			return receivedCount, err
 		}
 
 		// Publish block
-		subtype := "receive"
-		resp, err := w.RpcClient.MakeProcessRequest(requests.ProcessRequest{
-			BaseRequest: requests.BaseRequest{
-				Action: "process",
-			},
-			Subtype:   &subtype,
-			JsonBlock: true,
-			Block:     *sb,
-		})
-		if err != nil || !utils.Validate64HexHash(resp.Hash) {
+		_, err = w.publishBlock(ctx, sb, "receive")
+		if err != nil {
 			return receivedCount, err
 		}
 		receivedCount++
@@ -224,97 +278,102 @@ func (w *NanoWallet) receiveAll(wallet *ent.Wallet, acc *ent.Account, bpowKey *s
 	return receivedCount, nil
 }
 
-func (w *NanoWallet) createSendBlock(wallet *ent.Wallet, sender *ent.Account, amount string, destination string, precomputedWork *string, bpowKey *string) (*models.StateBlock, error) {
-	if wallet == nil {
-		return nil, ErrInvalidWallet
-	} else if sender == nil {
-		return nil, ErrInvalidAccount
-	}
-
-	sendAmount, ok := big.NewInt(0).SetString(amount, 10)
-	if !ok {
-		return nil, errors.New("Unable to parse send amount")
-	}
-
-	// Get account info
-	accountInfo, err := w.RpcClient.MakeAccountInfoRequest(sender.Address)
+// Retrieve account info for a send of sendAmount, along with the parsed balance
+// If auto_receive_on_send is enabled and the balance is too low, pending blocks are received first
+func (w *NanoWallet) getSendAccountInfo(ctx context.Context, wallet *ent.Wallet, sender *ent.Account, sendAmount *big.Int, bpowKey *string) (*responses.AccountInfoResponse, *big.Int, error) {
+	accountInfo, err := w.getAccountInfo(ctx, sender.Address)
 	if errors.Is(err, nanorpc.ErrAccountNotFound) {
 		if w.Config.Wallet.AutoReceiveOnSend == nil || !*w.Config.Wallet.AutoReceiveOnSend {
-			return nil, ErrInsufficientBalance
+			return nil, nil, ErrInsufficientBalance
 		}
 		// See if account has a pending balance to open the accountt
-		bal, err := w.RpcClient.MakeAccountBalanceRequest(sender.Address)
+		bal, err := w.RpcClient.MakeAccountBalanceRequest(ctx, sender.Address)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		receivable, ok := big.NewInt(0).SetString(bal.Receivable, 10)
 		if !ok {
-			return nil, errors.New("Unable to parse receivable amount")
+			return nil, nil, errors.New("Unable to parse receivable amount")
 		}
 		if receivable.Cmp(sendAmount) < 0 {
-			return nil, ErrInsufficientBalance
+			return nil, nil, ErrInsufficientBalance
 		}
-		receivedCount, err := w.receiveAll(wallet, sender, bpowKey)
+		receivedCount, err := w.receiveAll(ctx, wallet, sender, bpowKey, nil)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if receivedCount == 0 {
-			return nil, ErrInsufficientBalance
+			return nil, nil, ErrInsufficientBalance
 		}
 		// Re-get accountInfo
-		accountInfo, err = w.RpcClient.MakeAccountInfoRequest(sender.Address)
+		accountInfo, err = w.getAccountInfo(ctx, sender.Address)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	} else if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Convert balance to big int
 	balanceBigInt, ok := big.NewInt(0).SetString(accountInfo.Balance, 10)
 	if !ok {
-		return nil, errors.New("Unable to parse balance")
+		return nil, nil, errors.New("Unable to parse balance")
 	}
 
 	// Check if balance is sufficient
 	if sendAmount.Cmp(balanceBigInt) > 0 {
 		if w.Config.Wallet.AutoReceiveOnSend == nil || !*w.Config.Wallet.AutoReceiveOnSend {
-			return nil, ErrInsufficientBalance
+			return nil, nil, ErrInsufficientBalance
 		}
 		// Automatically receive blocks to see if we can make up the difference
-		receivedCount, _ := w.receiveAll(wallet, sender, bpowKey)
+		receivedCount, _ := w.receiveAll(ctx, wallet, sender, bpowKey, nil)
 		if receivedCount > 0 {
 			// Re-check balance
-			accountInfo, err = w.RpcClient.MakeAccountInfoRequest(sender.Address)
+			accountInfo, err = w.getAccountInfo(ctx, sender.Address)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			balanceBigInt, ok = big.NewInt(0).SetString(accountInfo.Balance, 10)
 			if !ok {
-				return nil, errors.New("Unable to parse balance")
+				return nil, nil, errors.New("Unable to parse balance")
 			}
 			if sendAmount.Cmp(balanceBigInt) > 0 {
-				return nil, ErrInsufficientBalance
+				return nil, nil, ErrInsufficientBalance
 			}
 		} else {
-			return nil, ErrInsufficientBalance
+			return nil, nil, ErrInsufficientBalance
 		}
 	}
 
+	return accountInfo, balanceBigInt, nil
+}
+
+func (w *NanoWallet) createSendBlock(ctx context.Context, wallet *ent.Wallet, sender *ent.Account, amount string, destination string, precomputedWork *string, bpowKey *string) (*models.StateBlock, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if sender == nil {
+		return nil, ErrInvalidAccount
+	}
+
+	sendAmount, ok := big.NewInt(0).SetString(amount, 10)
+	if !ok {
+		return nil, errors.New("Unable to parse send amount")
+	}
+
+	// Get account info, receiving pending blocks if necessary to cover the amount
+	accountInfo, balanceBigInt, err := w.getSendAccountInfo(ctx, wallet, sender, sendAmount, bpowKey)
+	if err != nil {
+		return nil, err
+	}
+
 	workbase := accountInfo.Frontier
 
 	// Build other block fields
 	previous := accountInfo.Frontier
 
-	var representative string
-	if wallet.Representative != nil {
-		representative = *wallet.Representative
-	} else {
-		rep, err := w.Config.GetRandomRep()
-		if err != nil {
-			return nil, err
-		}
-		representative = rep
+	representative, err := w.getDefaultRepresentative(wallet)
+	if err != nil {
+		return nil, err
 	}
 
 	// Calculate new balance, subtracing sendAmount from balanceBigInt
@@ -326,11 +385,7 @@ func (w *NanoWallet) createSendBlock(wallet *ent.Wallet, sender *ent.Account, am
 		if bpowKey != nil {
 			key = *bpowKey
 		}
-		difficulty := 1
-		if !w.Config.Wallet.Banano {
-			difficulty = 64
-		}
-		work, err = w.WorkClient.WorkGenerateMeta(workbase, difficulty, true, false, key)
+		work, err = w.generateBlockWork(ctx, sender, workbase, w.workMultiplier("send", accountInfo), key)
 		if err != nil {
 			return nil, err
 		}
@@ -356,19 +411,9 @@ func (w *NanoWallet) createSendBlock(wallet *ent.Wallet, sender *ent.Account, am
 	}
 
 	// Get the private key for this account
-	var priv ed25519.PrivateKey
-	if sender.PrivateKey != nil {
-		decoded, err := hex.DecodeString(*sender.PrivateKey)
-		if err != nil {
-			return nil, err
-		}
-		priv = ed25519.PrivateKey(decoded)
-	} else {
-		sd, err := GetDecryptedKeyFromStorage(wallet, "seed")
-		if err != nil {
-			return nil, err
-		}
-		_, priv, _ = utils.KeypairFromSeed(sd, uint32(*sender.AccountIndex))
+	priv, err := w.getPrivateKey(wallet, sender)
+	if err != nil {
+		return nil, err
 	}
 
 	// Sign the block
@@ -380,7 +425,7 @@ func (w *NanoWallet) createSendBlock(wallet *ent.Wallet, sender *ent.Account, am
 	return stateBlock, nil
 }
 
-func (w *NanoWallet) createChangeBlock(wallet *ent.Wallet, changer *ent.Account, representative string, precomputedWork *string, bpowKey *string, onlyIfDifferent bool) (*models.StateBlock, error) {
+func (w *NanoWallet) createChangeBlock(ctx context.Context, wallet *ent.Wallet, changer *ent.Account, representative string, precomputedWork *string, bpowKey *string, onlyIfDifferent bool) (*models.StateBlock, error) {
 	if wallet == nil {
 		return nil, ErrInvalidWallet
 	} else if changer == nil {
@@ -388,7 +433,7 @@ func (w *NanoWallet) createChangeBlock(wallet *ent.Wallet, changer *ent.Account,
 	}
 
 	// Get account info
-	accountInfo, err := w.RpcClient.MakeAccountInfoRequest(changer.Address)
+	accountInfo, err := w.getAccountInfo(ctx, changer.Address)
 	if err != nil {
 		return nil, err
 	}
@@ -408,11 +453,7 @@ func (w *NanoWallet) createChangeBlock(wallet *ent.Wallet, changer *ent.Account,
 		if bpowKey != nil {
 			key = *bpowKey
 		}
-		difficulty := 1
-		if !w.Config.Wallet.Banano {
-			difficulty = 64
-		}
-		work, err = w.WorkClient.WorkGenerateMeta(workbase, difficulty, true, false, key)
+		work, err = w.generateBlockWork(ctx, changer, workbase, w.workMultiplier("change", accountInfo), key)
 		if err != nil {
 			return nil, err
 		}
@@ -432,19 +473,9 @@ func (w *NanoWallet) createChangeBlock(wallet *ent.Wallet, changer *ent.Account,
 	}
 
 	// Get the private key for this account
-	var priv ed25519.PrivateKey
-	if changer.PrivateKey != nil {
-		decoded, err := hex.DecodeString(*changer.PrivateKey)
-		if err != nil {
-			return nil, err
-		}
-		priv = ed25519.PrivateKey(decoded)
-	} else {
-		sd, err := GetDecryptedKeyFromStorage(wallet, "seed")
-		if err != nil {
-			return nil, err
-		}
-		_, priv, _ = utils.KeypairFromSeed(sd, uint32(*changer.AccountIndex))
+	priv, err := w.getPrivateKey(wallet, changer)
+	if err != nil {
+		return nil, err
 	}
 
 	// Sign the block
@@ -460,174 +491,129 @@ func (w *NanoWallet) createChangeBlock(wallet *ent.Wallet, changer *ent.Account,
 // They are done in a locked context
 
 // Receive single block
-func (w *NanoWallet) CreateAndPublishReceiveBlock(wallet *ent.Wallet, source string, hash string, work *string, bpowKey *string) (string, error) {
+func (w *NanoWallet) CreateAndPublishReceiveBlock(ctx context.Context, wallet *ent.Wallet, source string, hash string, work *string, bpowKey *string) (string, error) {
 	if wallet == nil {
 		return "", ErrInvalidWallet
 	}
 
-	acc, err := w.GetAccount(wallet, source)
+	acc, err := w.GetAccount(ctx, wallet, source)
 	if err != nil {
 		return "", err
 	}
 
 	// Obtain lock
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*30, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*30, &database.LockRetryStrategy)
 	if err != nil {
 		return "", database.ErrLockNotObtained
 	}
-	defer lock.Release(w.Ctx)
+	defer lock.Release(context.WithoutCancel(ctx))
 
-	sb, err := w.createReceiveBlock(wallet, acc, hash, work, bpowKey)
+	sb, err := w.createReceiveBlock(ctx, wallet, acc, hash, work, bpowKey)
 	if err != nil {
 		return "", err
 	}
 
 	// Publish block
-	subtype := "receive"
-	resp, err := w.RpcClient.MakeProcessRequest(requests.ProcessRequest{
-		BaseRequest: requests.BaseRequest{
-			Action: "process",
-		},
-		Subtype:   &subtype,
-		JsonBlock: true,
-		Block:     *sb,
-	})
-	if err != nil || !utils.Validate64HexHash(resp.Hash) {
-		return "", err
-	}
-	return resp.Hash, nil
+	return w.publishBlock(ctx, sb, "receive")
 }
 
 // Receive all blocks in all accounts on wallet, respecting receive minimum
-func (w *NanoWallet) ReceiveAllBlocks(wallet *ent.Wallet, source string, bpowKey *string) (int, error) {
+func (w *NanoWallet) ReceiveAllBlocks(ctx context.Context, wallet *ent.Wallet, source string, bpowKey *string) (int, error) {
 	if wallet == nil {
 		return 0, ErrInvalidWallet
 	}
 
-	acc, err := w.GetAccount(wallet, source)
+	acc, err := w.GetAccount(ctx, wallet, source)
 	if err != nil {
 		return 0, err
 	}
 
 	// Obtain lock
 	// Longer lock since this culd be long running
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*300, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*300, &database.LockRetryStrategy)
 	if err != nil {
 		return 0, database.ErrLockNotObtained
 	}
-	defer lock.Release(w.Ctx)
+	defer lock.Release(context.WithoutCancel(ctx))
 
-	return w.receiveAll(wallet, acc, bpowKey)
+	return w.receiveAll(ctx, wallet, acc, bpowKey, nil)
 }
 
-func (w *NanoWallet) CreateAndPublishSendBlock(wallet *ent.Wallet, amount string, source string, destination string, id *string, work *string, bpowKey *string) (string, error) {
+func (w *NanoWallet) CreateAndPublishSendBlock(ctx context.Context, wallet *ent.Wallet, amount string, source string, destination string, id *string, work *string, bpowKey *string) (string, error) {
 	if wallet == nil {
 		return "", ErrInvalidWallet
 	}
-	acc, err := w.GetAccount(wallet, source)
+	acc, err := w.GetAccount(ctx, wallet, source)
 	if err != nil {
 		return "", err
 	}
 
 	// Obtain lock
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*30, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*30, &database.LockRetryStrategy)
 	if err != nil {
 		return "", database.ErrLockNotObtained
 	}
-	defer lock.Release(w.Ctx)
+	defer lock.Release(context.WithoutCancel(ctx))
 
 	// This is our idempotent send test, we don't create a new send block if a send with this ID has already been created from this account
 	if id != nil {
-		block, err := w.GetBlockFromDatabase(wallet, source, *id)
+		block, err := w.GetBlockFromDatabase(ctx, wallet, source, *id)
 		if !errors.Is(err, ErrBlockNotFound) && err != nil {
 			return "", err
 		} else if block != nil {
 			// Now we can just republish...
-			var sb models.StateBlock
-			if err := mapstructure.Decode(block.Block, &sb); err != nil {
-				return "", err
-			}
-			subtype := "send"
-			// Call process with same block
-			w.RpcClient.MakeProcessRequest(requests.ProcessRequest{
-				BaseRequest: requests.BaseRequest{
-					Action: "process",
-				},
-				Subtype:   &subtype,
-				JsonBlock: true,
-				Block:     sb,
-			})
-			return strings.ToUpper(sb.Hash), nil
+			return w.republishSavedBlock(ctx, block)
 		}
 	}
 
-	sb, err := w.createSendBlock(wallet, acc, amount, destination, work, bpowKey)
+	sb, err := w.createSendBlock(ctx, wallet, acc, amount, destination, work, bpowKey)
 	if err != nil {
 		return "", err
 	}
 
 	// Publish block
-	subtype := "send"
-	resp, err := w.RpcClient.MakeProcessRequest(requests.ProcessRequest{
-		BaseRequest: requests.BaseRequest{
-			Action: "process",
-		},
-		Subtype:   &subtype,
-		JsonBlock: true,
-		Block:     *sb,
-	})
-	if err != nil || !utils.Validate64HexHash(resp.Hash) {
+	hash, err := w.publishBlock(ctx, sb, "send")
+	if err != nil {
 		return "", err
 	}
 
 	// If the ID is set save it in database for indempotency
+	// The block is out, so this is saved even if the request was cancelled meanwhile
 	if id != nil {
+		ctx = context.WithoutCancel(ctx)
 		var asInterface map[string]interface{}
 		inrec, _ := json.Marshal(sb)
 		json.Unmarshal(inrec, &asInterface)
-		_, err := w.DB.Block.Create().SetAccount(acc).SetBlock(asInterface).SetBlockHash(resp.Hash).SetSubtype("send").SetSendID(*id).Save(w.Ctx)
+		_, err := w.DB.Block.Create().SetAccount(acc).SetBlock(asInterface).SetBlockHash(hash).SetSubtype("send").SetSendID(*id).Save(ctx)
 		if err != nil {
 			return "", err
 		}
 	}
 
-	return resp.Hash, nil
+	return hash, nil
 }
 
-func (w *NanoWallet) CreateAndPublishChangeBlock(wallet *ent.Wallet, address string, representative string, work *string, bpowKey *string, onlyIfDifferent bool) (string, error) {
+func (w *NanoWallet) CreateAndPublishChangeBlock(ctx context.Context, wallet *ent.Wallet, address string, representative string, work *string, bpowKey *string, onlyIfDifferent bool) (string, error) {
 	if wallet == nil {
 		return "", ErrInvalidWallet
 	}
-	acc, err := w.GetAccount(wallet, address)
+	acc, err := w.GetAccount(ctx, wallet, address)
 	if err != nil {
 		return "", err
 	}
 
 	// Obtain lock
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*30, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*30, &database.LockRetryStrategy)
 	if err != nil {
 		return "", database.ErrLockNotObtained
 	}
-	defer lock.Release(w.Ctx)
+	defer lock.Release(context.WithoutCancel(ctx))
 
-	sb, err := w.createChangeBlock(wallet, acc, representative, work, bpowKey, onlyIfDifferent)
+	sb, err := w.createChangeBlock(ctx, wallet, acc, representative, work, bpowKey, onlyIfDifferent)
 	if err != nil {
 		return "", err
 	}
 
 	// Publish block
-	subtype := "change"
-	resp, err := w.RpcClient.MakeProcessRequest(requests.ProcessRequest{
-		BaseRequest: requests.BaseRequest{
-			Action: "process",
-		},
-		Subtype:   &subtype,
-		JsonBlock: true,
-		Block:     *sb,
-	})
-	if err != nil || !utils.Validate64HexHash(resp.Hash) {
-		return "", err
-	}
-
-	return resp.Hash, nil
+	return w.publishBlock(ctx, sb, "change")
 }
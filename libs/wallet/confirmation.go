@@ -0,0 +1,119 @@
+package wallet
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+// Waiting for confirmation watches two sources, whichever answers first wins:
+// 1) Confirmations from the node websocket, fed in through NotifyConfirmation
+// 2) Polling block_info, which also covers setups without a websocket and tells us if the block disappeared
+
+// How often we ask the node about a block we're waiting on
+var confirmationPollInterval = time.Second
+
+// A block is only rolled back once the node hasn't known it for this long and this many polls
+// Right after publishing, or on a node that hasn't seen the block yet, it can be missing for a moment
+var rollbackGracePeriod = time.Second * 10
+
+const rollbackMisses = 3
+
+var confirmationWaiters = struct {
+	sync.Mutex
+	waiters map[string][]chan struct{}
+}{
+	waiters: make(map[string][]chan struct{}),
+}
+
+func addConfirmationWaiter(hash string) chan struct{} {
+	ch := make(chan struct{}, 1)
+	confirmationWaiters.Lock()
+	defer confirmationWaiters.Unlock()
+	confirmationWaiters.waiters[hash] = append(confirmationWaiters.waiters[hash], ch)
+	return ch
+}
+
+func removeConfirmationWaiter(hash string, ch chan struct{}) {
+	confirmationWaiters.Lock()
+	defer confirmationWaiters.Unlock()
+	waiters := confirmationWaiters.waiters[hash]
+	for i, waiter := range waiters {
+		if waiter == ch {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(confirmationWaiters.waiters, hash)
+	} else {
+		confirmationWaiters.waiters[hash] = waiters
+	}
+}
+
+// Called when the node tells us a block was confirmed, wakes up anybody waiting on it
+func (w *NanoWallet) NotifyConfirmation(hash string) {
+	hash = strings.ToUpper(hash)
+	w.untrackConfirmedBlock(hash)
+	confirmationWaiters.Lock()
+	defer confirmationWaiters.Unlock()
+	for _, ch := range confirmationWaiters.waiters[hash] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Block until hash is confirmed, is no longer known to the node, or timeout passes or ctx is done
+func (w *NanoWallet) WaitForConfirmation(ctx context.Context, hash string, timeout time.Duration) models.ConfirmationStatus {
+	hash = strings.ToUpper(hash)
+	ch := addConfirmationWaiter(hash)
+	defer removeConfirmationWaiter(hash, ch)
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(confirmationPollInterval)
+	defer ticker.Stop()
+
+	var missingSince time.Time
+	misses := 0
+	for {
+		status, missing := w.pollConfirmation(ctx, hash)
+		if status != "" {
+			return status
+		}
+		if !missing {
+			misses = 0
+		} else if misses++; misses == 1 {
+			missingSince = time.Now()
+		} else if misses >= rollbackMisses && time.Since(missingSince) >= rollbackGracePeriod {
+			return models.ConfirmationRolledBack
+		}
+		select {
+		case <-ch:
+			return models.ConfirmationConfirmed
+		case <-ctx.Done():
+			return models.ConfirmationTimeout
+		case <-deadline.C:
+			return models.ConfirmationTimeout
+		case <-ticker.C:
+		}
+	}
+}
+
+// Returns an empty status while the block is still pending, node errors are treated as pending too
+// missing is set when the node doesn't know the block
+func (w *NanoWallet) pollConfirmation(ctx context.Context, hash string) (status models.ConfirmationStatus, missing bool) {
+	blockInfo, err := w.RpcClient.MakeBlockInfoRequest(ctx, hash)
+	if err != nil {
+		return "", strings.Contains(strings.ToLower(err.Error()), "block not found")
+	}
+	if blockInfo.Confirmed == "true" {
+		return models.ConfirmationConfirmed, false
+	}
+	return "", false
+}
@@ -0,0 +1,84 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForConfirmation(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	confirmationPollInterval = time.Millisecond * 50
+	rollbackGracePeriod = time.Millisecond * 200
+
+	confirmed := "true"
+	var known atomic.Bool
+	known.Store(true)
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BlockInfoRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "block_info" && pr.Hash == "8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72" && known.Load() {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				js["confirmed"] = confirmed
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "Block not found",
+			})
+			return resp, err
+		},
+	)
+
+	hash := "8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72"
+
+	// Already confirmed on the node
+	assert.Equal(t, models.ConfirmationConfirmed, MockWallet.WaitForConfirmation(context.Background(), hash, time.Second))
+
+	// Node no longer knows about the block, after the grace period
+	start := time.Now()
+	assert.Equal(t, models.ConfirmationRolledBack, MockWallet.WaitForConfirmation(context.Background(), "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", time.Second))
+	assert.GreaterOrEqual(t, time.Since(start), rollbackGracePeriod)
+	assert.Equal(t, models.ConfirmationTimeout, MockWallet.WaitForConfirmation(context.Background(), "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", time.Millisecond*100))
+
+	// Node that hasn't seen the block yet
+	known.Store(false)
+	time.AfterFunc(time.Millisecond*100, func() {
+		known.Store(true)
+	})
+	assert.Equal(t, models.ConfirmationConfirmed, MockWallet.WaitForConfirmation(context.Background(), hash, time.Second))
+
+	// Never confirms
+	confirmed = "false"
+	start = time.Now()
+	assert.Equal(t, models.ConfirmationTimeout, MockWallet.WaitForConfirmation(context.Background(), hash, time.Millisecond*200))
+	assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*200)
+
+	// Gives up with the caller
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	start = time.Now()
+	assert.Equal(t, models.ConfirmationTimeout, MockWallet.WaitForConfirmation(ctx, hash, time.Second*5))
+	assert.Less(t, time.Since(start), time.Second)
+
+	// Websocket confirmation wakes the waiter up
+	go func() {
+		time.Sleep(time.Millisecond * 100)
+		MockWallet.NotifyConfirmation("8d3ab98b301224253750d448b4bd997132400cedd0a8432f775724f2d9821c72")
+	}()
+	assert.Equal(t, models.ConfirmationConfirmed, MockWallet.WaitForConfirmation(context.Background(), hash, time.Second*5))
+	assert.Len(t, confirmationWaiters.waiters, 0)
+}
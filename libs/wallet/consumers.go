@@ -0,0 +1,100 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+// Routes wallet events and confirmations through the bus, with consumers for
+// account state, auto-receive, webhooks and the EventHandler, which run until the bus is stopped
+func (w *NanoWallet) UseEventBus(bus *EventBus) {
+	workers := w.Config.Wallet.AutoReceiveWorkers
+	SubscribeOrdered(bus, "confirmations", workers, func(c models.BlockConfirmation) string {
+		return c.Account
+	}, func(c models.BlockConfirmation) {
+		w.handleConfirmation(bus.ctx, c)
+	})
+	SubscribeOrdered(bus, "auto_receive", workers, func(c models.BlockConfirmation) string {
+		return c.LinkAsAccount
+	}, func(c models.BlockConfirmation) {
+		w.autoReceive(bus.ctx, c)
+	})
+	Subscribe(bus, "webhooks", 1, func(event models.WalletEvent) {
+		w.enqueueWebhooks(bus.ctx, event)
+	})
+	Subscribe(bus, "event_handler", 1, func(event models.WalletEvent) {
+		if w.EventHandler != nil {
+			w.EventHandler(event)
+		}
+	})
+	w.Events = bus
+}
+
+// How long a handled confirmation is remembered
+const confirmationHandledTTL = time.Hour
+
+func confirmationHandledKey(hash string) string {
+	return fmt.Sprintf("confirmationhandled:%s", strings.ToUpper(hash))
+}
+
+// Wakes up requests waiting on the block, then updates account state and emits events on one instance
+func (w *NanoWallet) handleConfirmation(ctx context.Context, c models.BlockConfirmation) {
+	// Every instance wakes up its own requests waiting on this block
+	w.NotifyConfirmation(c.Hash)
+	// The first instance to see a confirmation handles it, the marker outlives any later copies
+	// from other node websockets so their events aren't emitted twice
+	claimed, err := database.GetRedisDB().SetNX(confirmationHandledKey(c.Hash), "1", confirmationHandledTTL)
+	if err != nil || !claimed {
+		return
+	}
+	// Keep the local state of our accounts in sync with the network
+	w.UpdateAccountStateFromConfirmation(ctx, c.Account, c.Hash, c.Previous, c.Balance, c.Representative)
+	// Confirmations of our accounts go to their wallet's webhook and websocket subscribers
+	w.EmitConfirmationEvents(ctx, c.Account, c.Hash, c.Subtype, c.LinkAsAccount, c.Amount)
+}
+
+// Receives confirmed sends to our accounts of at least receive_minimum, when their receive policy allows it
+func (w *NanoWallet) autoReceive(ctx context.Context, c models.BlockConfirmation) {
+	// Ignore non-sends
+	if c.Subtype != "send" || !c.IsSend {
+		return
+	}
+	amount, ok := big.NewInt(0).SetString(c.Amount, 10)
+	if !ok {
+		return
+	}
+	// Compare to receive minimum
+	receiveMinimum, ok := big.NewInt(0).SetString(w.Config.Wallet.ReceiveMinimum, 10)
+	if !ok || amount.Cmp(receiveMinimum) < 0 {
+		return
+	}
+
+	// See if destination is in our wallet
+	dbAccount, err := w.GetAccountByAddress(ctx, c.LinkAsAccount)
+	if err != nil {
+		return
+	}
+	if !receivePolicyAllows(w.receivePolicyFor(ctx, dbAccount), c.Account, false) {
+		return
+	}
+
+	// Same lock as the backfill, so each send is only received by one instance
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("blocklock:%s", c.Hash), time.Second*30, nil)
+	if err != nil {
+		return
+	}
+	defer lock.Release(context.WithoutCancel(ctx))
+	wallet, err := dbAccount.QueryWallet().Only(ctx)
+	if err != nil {
+		return
+	}
+
+	// Actually receive the block
+	w.CreateAndPublishReceiveBlock(ctx, wallet, dbAccount.Address, c.Hash, nil, nil)
+}
@@ -1,6 +1,7 @@
 package wallet
 
 import (
+	"context"
 	"errors"
 
 	"github.com/appditto/pippin_nano_wallet/libs/database"
@@ -21,7 +22,7 @@ var ErrWalletNotLocked = errors.New("wallet not locked")
 // Encrypt entrypoint
 // If password is a blank string, we disable encryption for the wallet
 // If password is not a blank string, we enable encryption for the wallet
-func (w *NanoWallet) EncryptWallet(wallet *ent.Wallet, password string) (bool, error) {
+func (w *NanoWallet) EncryptWallet(ctx context.Context, wallet *ent.Wallet, password string) (bool, error) {
 	if wallet == nil {
 		return false, ErrInvalidWallet
 	} else if !wallet.Encrypted && password == "" {
@@ -44,16 +45,16 @@ func (w *NanoWallet) EncryptWallet(wallet *ent.Wallet, password string) (bool, e
 
 	if password == "" {
 		// Unlock wallet
-		tx, err := w.DB.Tx(w.Ctx)
+		tx, err := w.DB.Tx(ctx)
 		if err != nil {
 			return false, err
 		}
-		_, err = tx.Wallet.UpdateOne(wallet).SetEncrypted(false).SetSeed(seed).Save(w.Ctx)
+		_, err = tx.Wallet.UpdateOne(wallet).SetEncrypted(false).SetSeed(seed).Save(ctx)
 		if err != nil {
 			tx.Rollback()
 			return false, err
 		}
-		adhocAccts, err := tx.Account.Query().Where(account.WalletID(wallet.ID), account.PrivateKeyNotNil()).All(w.Ctx)
+		adhocAccts, err := tx.Account.Query().Where(account.WalletID(wallet.ID), account.PrivateKeyNotNil()).All(ctx)
 		if err != nil {
 			tx.Rollback()
 			return false, err
@@ -64,7 +65,7 @@ func (w *NanoWallet) EncryptWallet(wallet *ent.Wallet, password string) (bool, e
 				tx.Rollback()
 				return false, err
 			}
-			_, err = tx.Account.UpdateOne(acct).SetPrivateKey(key).Save(w.Ctx)
+			_, err = tx.Account.UpdateOne(acct).SetPrivateKey(key).Save(ctx)
 			if err != nil {
 				tx.Rollback()
 				return false, err
@@ -87,17 +88,17 @@ func (w *NanoWallet) EncryptWallet(wallet *ent.Wallet, password string) (bool, e
 		return false, err
 	}
 
-	tx, err := w.DB.Tx(w.Ctx)
+	tx, err := w.DB.Tx(ctx)
 	if err != nil {
 		return false, err
 	}
-	_, err = tx.Wallet.UpdateOne(wallet).SetEncrypted(true).SetSeed(encryptedSeed).Save(w.Ctx)
+	_, err = tx.Wallet.UpdateOne(wallet).SetEncrypted(true).SetSeed(encryptedSeed).Save(ctx)
 	if err != nil {
 		tx.Rollback()
 		return false, err
 	}
 	// Encrypt all adhoc private keys
-	adhocAccts, err := tx.Account.Query().Where(account.WalletID(wallet.ID), account.PrivateKeyNotNil()).All(w.Ctx)
+	adhocAccts, err := tx.Account.Query().Where(account.WalletID(wallet.ID), account.PrivateKeyNotNil()).All(ctx)
 	if err != nil {
 		tx.Rollback()
 		return false, err
@@ -107,7 +108,7 @@ func (w *NanoWallet) EncryptWallet(wallet *ent.Wallet, password string) (bool, e
 		if err != nil {
 			return false, err
 		}
-		_, err = tx.Account.UpdateOne(acct).SetPrivateKey(encryptedKey).Save(w.Ctx)
+		_, err = tx.Account.UpdateOne(acct).SetPrivateKey(encryptedKey).Save(ctx)
 		if err != nil {
 			tx.Rollback()
 			return false, err
@@ -122,7 +123,7 @@ func (w *NanoWallet) EncryptWallet(wallet *ent.Wallet, password string) (bool, e
 	return true, nil
 }
 
-func (w *NanoWallet) LockWallet(wallet *ent.Wallet) error {
+func (w *NanoWallet) LockWallet(ctx context.Context, wallet *ent.Wallet) error {
 	if wallet == nil {
 		return ErrInvalidWallet
 	} else if !wallet.Encrypted {
@@ -133,7 +134,7 @@ func (w *NanoWallet) LockWallet(wallet *ent.Wallet) error {
 	return nil
 }
 
-func (w *NanoWallet) UnlockWallet(wallet *ent.Wallet, password string) (bool, error) {
+func (w *NanoWallet) UnlockWallet(ctx context.Context, wallet *ent.Wallet, password string) (bool, error) {
 	if wallet == nil {
 		return false, ErrInvalidWallet
 	} else if !wallet.Encrypted {
@@ -152,7 +153,7 @@ func (w *NanoWallet) UnlockWallet(wallet *ent.Wallet, password string) (bool, er
 	}
 
 	// Every adhoc account gets decrypted too
-	adhocAccts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.PrivateKeyNotNil()).All(w.Ctx)
+	adhocAccts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.PrivateKeyNotNil()).All(ctx)
 	if err != nil {
 		return false, err
 	}
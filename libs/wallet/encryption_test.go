@@ -1,6 +1,7 @@
 package wallet
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -15,20 +16,20 @@ func TestEncryptWallet(t *testing.T) {
 	seed, err := utils.GenerateSeed(strings.NewReader("88888340e07eee69abac049c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040b"))
 	assert.Nil(t, err)
 
-	wallet, err := MockWallet.WalletCreate(seed)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
 	assert.Nil(t, err)
 
 	// Create some adhoc accounts
 	_, priv, _ := ed25519.GenerateKey(strings.NewReader("1111111111111111111111111111111111111111111111111111111111111111"))
-	_, err = MockWallet.AdhocAccountCreate(wallet, priv)
+	_, err = MockWallet.AdhocAccountCreate(context.Background(), wallet, priv)
 	assert.Nil(t, err)
 	_, priv, _ = ed25519.GenerateKey(strings.NewReader("2111111111111111111111111111111111111111111111111111111111111111"))
-	_, err = MockWallet.AdhocAccountCreate(wallet, priv)
+	_, err = MockWallet.AdhocAccountCreate(context.Background(), wallet, priv)
 	assert.Nil(t, err)
 
 	// Encrypt the wallet
 	password := "mypassword"
-	encrypted, err := MockWallet.EncryptWallet(wallet, password)
+	encrypted, err := MockWallet.EncryptWallet(context.Background(), wallet, password)
 	assert.Nil(t, err)
 	assert.True(t, encrypted)
 
@@ -38,7 +39,7 @@ func TestEncryptWallet(t *testing.T) {
 	assert.NotEqual(t, seed, wallet.Seed)
 
 	// Ensure adhoc keys are encrypted
-	adhocs, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID), account.PrivateKeyNotNil()).All(MockWallet.Ctx)
+	adhocs, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID), account.PrivateKeyNotNil()).All(context.Background())
 	assert.Nil(t, err)
 	assert.Len(t, adhocs, 2)
 	for _, adhoc := range adhocs {
@@ -47,15 +48,15 @@ func TestEncryptWallet(t *testing.T) {
 	}
 
 	// Ensure we get an error acting on it since it's locked
-	_, err = MockWallet.EncryptWallet(wallet, password)
+	_, err = MockWallet.EncryptWallet(context.Background(), wallet, password)
 	assert.ErrorIs(t, ErrWalletLocked, err)
 
 	// Test bad input
-	_, err = MockWallet.EncryptWallet(nil, "abcd1234")
+	_, err = MockWallet.EncryptWallet(context.Background(), nil, "abcd1234")
 	assert.ErrorIs(t, ErrInvalidWallet, err)
 
 	wallet.Encrypted = false
-	_, err = MockWallet.EncryptWallet(wallet, "")
+	_, err = MockWallet.EncryptWallet(context.Background(), wallet, "")
 	assert.ErrorIs(t, ErrBadPassword, err)
 }
 
@@ -64,7 +65,7 @@ func TestSetAndGetDecryptedFromStorage(t *testing.T) {
 	seed, err := utils.GenerateSeed(strings.NewReader("22222340e07eee69abac049c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040b"))
 	assert.Nil(t, err)
 
-	wallet, err := MockWallet.WalletCreate(seed)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
 	assert.Nil(t, err)
 
 	// Wallet isn't encrypted to begin with, so we expect to get the seed from relational DB
@@ -92,30 +93,30 @@ func TestUnlockWallet(t *testing.T) {
 	seed, err := utils.GenerateSeed(strings.NewReader("33333340e07eee69abac049c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040b"))
 	assert.Nil(t, err)
 
-	wallet, err := MockWallet.WalletCreate(seed)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
 	assert.Nil(t, err)
 
 	// Create some adhoc accounts
 	_, priv, _ := ed25519.GenerateKey(strings.NewReader("1111111111111111111111111111111111111111111111111111111111111111"))
-	acc1, err := MockWallet.AdhocAccountCreate(wallet, priv)
+	acc1, err := MockWallet.AdhocAccountCreate(context.Background(), wallet, priv)
 	assert.Nil(t, err)
 	_, priv, _ = ed25519.GenerateKey(strings.NewReader("2111111111111111111111111111111111111111111111111111111111111111"))
-	acc2, err := MockWallet.AdhocAccountCreate(wallet, priv)
+	acc2, err := MockWallet.AdhocAccountCreate(context.Background(), wallet, priv)
 	assert.Nil(t, err)
 
 	// Encrypt the wallet
 	password := "mypassword"
-	encrypted, err := MockWallet.EncryptWallet(wallet, password)
+	encrypted, err := MockWallet.EncryptWallet(context.Background(), wallet, password)
 	assert.Nil(t, err)
 	assert.True(t, encrypted)
 
 	// Unlock with bad password
-	unlocked, err := MockWallet.UnlockWallet(wallet, "hunter2")
+	unlocked, err := MockWallet.UnlockWallet(context.Background(), wallet, "hunter2")
 	assert.ErrorIs(t, ErrBadPassword, err)
 	assert.False(t, unlocked)
 
 	// Unlock with good password
-	unlocked, err = MockWallet.UnlockWallet(wallet, password)
+	unlocked, err = MockWallet.UnlockWallet(context.Background(), wallet, password)
 	assert.Nil(t, err)
 	assert.True(t, unlocked)
 
@@ -128,7 +129,7 @@ func TestUnlockWallet(t *testing.T) {
 
 	// Check not locked error
 	wallet.Encrypted = false
-	_, err = MockWallet.UnlockWallet(wallet, password)
+	_, err = MockWallet.UnlockWallet(context.Background(), wallet, password)
 	assert.ErrorIs(t, ErrWalletNotLocked, err)
 }
 
@@ -137,17 +138,17 @@ func TestLockWallet(t *testing.T) {
 	seed, err := utils.GenerateSeed(strings.NewReader("56565656540e07eee69abac049c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040b"))
 	assert.Nil(t, err)
 
-	wallet, err := MockWallet.WalletCreate(seed)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
 	assert.Nil(t, err)
 
 	// Encrypt the wallet
 	password := "mypassword"
-	encrypted, err := MockWallet.EncryptWallet(wallet, password)
+	encrypted, err := MockWallet.EncryptWallet(context.Background(), wallet, password)
 	assert.Nil(t, err)
 	assert.True(t, encrypted)
 
 	// Unlock with good password
-	unlocked, err := MockWallet.UnlockWallet(wallet, password)
+	unlocked, err := MockWallet.UnlockWallet(context.Background(), wallet, password)
 	assert.Nil(t, err)
 	assert.True(t, unlocked)
 
@@ -157,7 +158,7 @@ func TestLockWallet(t *testing.T) {
 	assert.Equal(t, seed, key)
 
 	// Lock wallet
-	err = MockWallet.LockWallet(wallet)
+	err = MockWallet.LockWallet(context.Background(), wallet)
 	assert.Nil(t, err)
 
 	key, err = GetDecryptedKeyFromStorage(wallet, "seed")
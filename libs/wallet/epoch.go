@@ -0,0 +1,85 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	nanorpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+var ErrEpochUpgradeUnsupported = errors.New("epoch upgrade is not supported for banano")
+
+// Link of epoch v2 blocks, "epoch v2 block" as ascii
+const epochV2Link = "65706F636820763220626C6F636B000000000000000000000000000000000000"
+
+// Build epoch v2 blocks for every opened account on the wallet that is still below epoch v2
+// Epoch blocks are signed by the network's epoch signer rather than the account, so Pippin only builds them with work.
+// Signing and publishing them is up to whoever holds that key, the node's process takes them with subtype epoch.
+// Unopened accounts are skipped, they open at the epoch of whatever they receive.
+func (w *NanoWallet) EpochUpgrade(ctx context.Context, wallet *ent.Wallet, bpowKey *string) ([]*models.EpochUpgradeResult, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if w.Config.Wallet.Banano {
+		return nil, ErrEpochUpgradeUnsupported
+	}
+
+	accounts, _, err := w.AccountsList(ctx, wallet, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*models.EpochUpgradeResult
+	for _, acc := range accounts {
+		block, err := w.createEpochBlock(ctx, acc, bpowKey)
+		if err == nil && block == nil {
+			continue
+		}
+		results = append(results, &models.EpochUpgradeResult{
+			Account: acc.Address,
+			Block:   block,
+			Error:   err,
+		})
+	}
+
+	return results, nil
+}
+
+// Returns nil if the account doesn't need an upgrade
+func (w *NanoWallet) createEpochBlock(ctx context.Context, acc *ent.Account, bpowKey *string) (*models.StateBlock, error) {
+	accountInfo, err := w.getAccountInfo(ctx, acc.Address)
+	if errors.Is(err, nanorpc.ErrAccountNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if version, err := strconv.Atoi(accountInfo.AccountVersion); err == nil && version >= 2 {
+		return nil, nil
+	}
+
+	key := ""
+	if bpowKey != nil {
+		key = *bpowKey
+	}
+	work, err := w.generateBlockWork(ctx, acc, accountInfo.Frontier, w.workMultiplier("epoch", accountInfo), key)
+	if err != nil {
+		return nil, err
+	}
+
+	stateBlock := &models.StateBlock{
+		Type:           "state",
+		Account:        acc.Address,
+		Previous:       accountInfo.Frontier,
+		Representative: accountInfo.Representative,
+		Balance:        accountInfo.Balance,
+		Link:           epochV2Link,
+		Work:           work,
+		Banano:         w.Config.Wallet.Banano,
+	}
+	if err := stateBlock.ComputeHash(); err != nil {
+		return nil, err
+	}
+	return stateBlock, nil
+}
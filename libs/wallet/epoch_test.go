@@ -0,0 +1,79 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEpochUpgrade(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	processCalls := 0
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "process" {
+				processCalls++
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "Account not found",
+			})
+			return resp, err
+		},
+	)
+
+	seed, err := utils.GenerateSeed(strings.NewReader("6D8F0A2C4E6B8D0F1A3C5E7B9D2F4A6C8E0B1D3F5A7C9E2B4D6F8A0C1E3B5D7F"))
+	assert.Nil(t, err)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	v1, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+	v2, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+
+	MockWallet.setAccountState(v1.Address, &models.AccountState{
+		Frontier:       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+		Balance:        "1000",
+		Representative: "nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs",
+		Version:        "1",
+	})
+	MockWallet.setAccountState(v2.Address, &models.AccountState{
+		Frontier:       "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F",
+		Balance:        "1000",
+		Representative: "nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs",
+		Version:        "2",
+	})
+
+	_, err = MockWallet.EpochUpgrade(context.Background(), nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	// Only the v1 account needs it, the first account on the wallet is unopened
+	results, err := MockWallet.EpochUpgrade(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, v1.Address, results[0].Account)
+	assert.Nil(t, results[0].Error)
+
+	block := results[0].Block
+	assert.Equal(t, epochV2Link, block.Link)
+	assert.Equal(t, "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3", block.Previous)
+	assert.Equal(t, "1000", block.Balance)
+	assert.Equal(t, "nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs", block.Representative)
+	assert.NotEqual(t, "", block.Work)
+	assert.Len(t, block.Hash, 64)
+	// Left for the epoch signer to sign and publish
+	assert.Equal(t, "", block.Signature)
+	assert.Equal(t, 0, processCalls)
+	assert.Equal(t, "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3", MockWallet.GetAccountState(v1.Address).Frontier)
+}
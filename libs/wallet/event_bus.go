@@ -0,0 +1,151 @@
+package wallet
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
+
+// Events waiting per worker queue, publishing blocks once a consumer's queue is full
+const eventBusQueueSize = 100
+
+type busConsumer struct {
+	name    string
+	accepts func(event any) bool
+	handle  func(event any)
+	// Events with the same key go to the same queue, nil when any worker can take any event
+	key     func(event any) string
+	queues  []chan any
+	handled atomic.Int64
+}
+
+type BusConsumerStats struct {
+	Name    string `json:"name"`
+	Queued  int    `json:"queued"`
+	Handled int64  `json:"handled"`
+}
+
+// In-process publish/subscribe for typed events, each consumer has its own queue and workers
+type EventBus struct {
+	ctx       context.Context
+	consumers []*busConsumer
+	mutex     sync.RWMutex
+}
+
+// Workers stop when ctx is done
+func NewEventBus(ctx context.Context) *EventBus {
+	return &EventBus{ctx: ctx}
+}
+
+// Handles every published T on workers goroutines
+func Subscribe[T any](bus *EventBus, name string, workers int, handler func(T)) {
+	subscribe(bus, name, workers, nil, handler)
+}
+
+// Like Subscribe, but events with the same key are handled one at a time in the order they were published
+func SubscribeOrdered[T any](bus *EventBus, name string, workers int, key func(T) string, handler func(T)) {
+	subscribe(bus, name, workers, key, handler)
+}
+
+func subscribe[T any](bus *EventBus, name string, workers int, key func(T) string, handler func(T)) {
+	if workers < 1 {
+		workers = 1
+	}
+	consumer := &busConsumer{
+		name: name,
+		accepts: func(event any) bool {
+			_, ok := event.(T)
+			return ok
+		},
+		handle: func(event any) {
+			handler(event.(T))
+		},
+	}
+	queues := 1
+	if key != nil {
+		queues = workers
+		consumer.key = func(event any) string {
+			return key(event.(T))
+		}
+	}
+	for i := 0; i < queues; i++ {
+		consumer.queues = append(consumer.queues, make(chan any, eventBusQueueSize))
+	}
+	for i := 0; i < workers; i++ {
+		go bus.work(consumer, consumer.queues[i%queues])
+	}
+	bus.mutex.Lock()
+	bus.consumers = append(bus.consumers, consumer)
+	bus.mutex.Unlock()
+}
+
+func (bus *EventBus) work(consumer *busConsumer, queue chan any) {
+	for {
+		select {
+		case <-bus.ctx.Done():
+			return
+		case event := <-queue:
+			bus.handle(consumer, event)
+		}
+	}
+}
+
+// A consumer that panics loses the event, not its worker
+func (bus *EventBus) handle(consumer *busConsumer, event any) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("Event consumer %s panicked %v", consumer.name, r)
+		}
+	}()
+	consumer.handle(event)
+	consumer.handled.Add(1)
+}
+
+// Queues the event for every consumer of its type
+// Blocks while a consumer's queue is full, until the bus is stopped
+func (bus *EventBus) Publish(event any) {
+	bus.mutex.RLock()
+	consumers := bus.consumers
+	bus.mutex.RUnlock()
+	for _, consumer := range consumers {
+		if !consumer.accepts(event) {
+			continue
+		}
+		queue := consumer.queues[0]
+		if consumer.key != nil {
+			h := fnv.New32a()
+			h.Write([]byte(consumer.key(event)))
+			queue = consumer.queues[h.Sum32()%uint32(len(consumer.queues))]
+		}
+		select {
+		case queue <- event:
+		case <-bus.ctx.Done():
+			return
+		}
+	}
+}
+
+// Queued and handled events per consumer, safe on a nil bus
+func (bus *EventBus) Stats() []BusConsumerStats {
+	stats := []BusConsumerStats{}
+	if bus == nil {
+		return stats
+	}
+	bus.mutex.RLock()
+	defer bus.mutex.RUnlock()
+	for _, consumer := range bus.consumers {
+		queued := 0
+		for _, queue := range consumer.queues {
+			queued += len(queue)
+		}
+		stats = append(stats, BusConsumerStats{
+			Name:    consumer.name,
+			Queued:  queued,
+			Handled: consumer.handled.Load(),
+		})
+	}
+	return stats
+}
@@ -0,0 +1,156 @@
+package wallet
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBusTyped(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bus := NewEventBus(ctx)
+
+	events := make(chan models.WalletEvent, 10)
+	confirmations := make(chan models.BlockConfirmation, 10)
+	Subscribe(bus, "events", 2, func(event models.WalletEvent) {
+		events <- event
+	})
+	Subscribe(bus, "confirmations", 1, func(c models.BlockConfirmation) {
+		confirmations <- c
+	})
+	// A panic doesn't take the worker down
+	Subscribe(bus, "panics", 1, func(c models.BlockConfirmation) {
+		panic("oops")
+	})
+
+	bus.Publish(models.WalletEvent{Type: models.EventSendConfirmed})
+	bus.Publish(models.BlockConfirmation{Hash: "A"})
+	bus.Publish(models.BlockConfirmation{Hash: "B"})
+	bus.Publish("ignored")
+	assert.Equal(t, models.EventSendConfirmed, (<-events).Type)
+	assert.Equal(t, "A", (<-confirmations).Hash)
+	assert.Equal(t, "B", (<-confirmations).Hash)
+	assert.Eventually(t, func() bool {
+		stats := bus.Stats()
+		return stats[0].Handled == 1 && stats[1].Handled == 2 && stats[2].Queued == 0
+	}, time.Second, time.Millisecond*10)
+	assert.Len(t, events, 0)
+	assert.Equal(t, []BusConsumerStats{}, (*EventBus)(nil).Stats())
+}
+
+func TestEventBusOrdered(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bus := NewEventBus(ctx)
+
+	var mutex sync.Mutex
+	handled := map[string][]string{}
+	var wg sync.WaitGroup
+	SubscribeOrdered(bus, "ordered", 4, func(c models.BlockConfirmation) string {
+		return c.Account
+	}, func(c models.BlockConfirmation) {
+		defer wg.Done()
+		mutex.Lock()
+		defer mutex.Unlock()
+		handled[c.Account] = append(handled[c.Account], c.Hash)
+	})
+
+	// Each account's events in the order they were published
+	expected := map[string][]string{}
+	for i := 0; i < 50; i++ {
+		for _, account := range []string{"nano_a", "nano_b", "nano_c"} {
+			hash := string(rune('A' + i%26))
+			expected[account] = append(expected[account], hash)
+			wg.Add(1)
+			bus.Publish(models.BlockConfirmation{Account: account, Hash: hash})
+		}
+	}
+	wg.Wait()
+	assert.Equal(t, expected, handled)
+}
+
+func TestEventBusBackPressure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	bus := NewEventBus(ctx)
+
+	release := make(chan struct{})
+	Subscribe(bus, "slow", 1, func(event models.WalletEvent) {
+		<-release
+	})
+	// One being handled and a full queue
+	for i := 0; i < eventBusQueueSize+1; i++ {
+		bus.Publish(models.WalletEvent{})
+	}
+	published := make(chan struct{})
+	go func() {
+		bus.Publish(models.WalletEvent{})
+		close(published)
+	}()
+	select {
+	case <-published:
+		t.Fatal("publish didn't wait for the queue")
+	case <-time.After(time.Millisecond * 100):
+	}
+	release <- struct{}{}
+	<-published
+
+	// Stopping the bus unblocks publishers
+	go func() {
+		bus.Publish(models.WalletEvent{})
+		bus.Publish(models.WalletEvent{})
+		close(release)
+	}()
+	cancel()
+	<-release
+}
+
+func TestUseEventBus(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3"))
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := make(chan models.WalletEvent, 10)
+	w := *MockWallet
+	w.EventHandler = func(event models.WalletEvent) {
+		events <- event
+	}
+	w.UseEventBus(NewEventBus(ctx))
+
+	// Confirmations come back out as wallet events
+	w.Events.Publish(models.BlockConfirmation{Account: acc.Address, Hash: "ab12", Subtype: "change"})
+	select {
+	case event := <-events:
+		assert.Equal(t, models.EventBlockConfirmed, event.Type)
+		assert.Equal(t, wallet.ID.String(), event.Wallet)
+	case <-time.After(time.Second * 5):
+		t.Fatal("no event")
+	}
+	// Too small to be received
+	w.Events.Publish(models.BlockConfirmation{Account: "nano_1sender1111111111111111111111111111111111111111111111hifc8npp", Hash: "cd34", Subtype: "send", IsSend: true, LinkAsAccount: acc.Address, Amount: "1"})
+	select {
+	case event := <-events:
+		assert.Equal(t, models.EventIncomingSendConfirmed, event.Type)
+	case <-time.After(time.Second * 5):
+		t.Fatal("no event")
+	}
+	assert.Eventually(t, func() bool {
+		for _, stats := range w.Events.Stats() {
+			if stats.Name == "auto_receive" {
+				return stats.Handled == 2
+			}
+		}
+		return false
+	}, time.Second, time.Millisecond*10)
+	assert.Len(t, events, 0)
+}
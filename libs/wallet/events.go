@@ -0,0 +1,131 @@
+package wallet
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+// Events are always logged, queued for the wallet's webhook and passed to the wallet's EventHandler if one is set
+// With an event bus they're published on it for its consumers to do that
+func (w *NanoWallet) emitEvent(ctx context.Context, event models.WalletEvent) {
+	log.Infof("Wallet event %s for %s %s %s", event.Type, event.Account, event.Hash, event.Detail)
+	if event.Wallet == "" {
+		if acc, err := w.GetAccountByAddress(ctx, event.Account); err == nil {
+			event.Wallet = acc.WalletID.String()
+		}
+	}
+	if w.Events != nil {
+		w.Events.Publish(event)
+		return
+	}
+	w.enqueueWebhooks(ctx, event)
+	if w.EventHandler != nil {
+		w.EventHandler(event)
+	}
+}
+
+// Tells the AccountsHandler, if set, about addresses that were added or removed
+func (w *NanoWallet) accountsChanged(added []string, removed []string) {
+	if w.AccountsHandler != nil && (len(added) > 0 || len(removed) > 0) {
+		w.AccountsHandler(added, removed)
+	}
+}
+
+// Raw difference between two balances, empty if either is unknown
+func balanceDifference(before string, after string) string {
+	b, ok := big.NewInt(0).SetString(before, 10)
+	if !ok {
+		return ""
+	}
+	a, ok := big.NewInt(0).SetString(after, 10)
+	if !ok {
+		return ""
+	}
+	return b.Sub(b, a).Abs(b).String()
+}
+
+// For a block we just published, previousBalance is empty when we didn't know it
+func (w *NanoWallet) emitPublishedEvent(ctx context.Context, sb *models.StateBlock, subtype string, hash string, previousBalance string) {
+	event := models.WalletEvent{
+		Account: sb.Account,
+		Hash:    strings.ToUpper(hash),
+	}
+	switch subtype {
+	case "send":
+		event.Type = models.EventSendPublished
+		event.Amount = balanceDifference(previousBalance, sb.Balance)
+		event.Detail = sb.LinkAsAccount
+		if event.Detail == "" {
+			if pub, err := hex.DecodeString(sb.Link); err == nil {
+				event.Detail = utils.PubKeyToAddress(ed25519.PublicKey(pub), w.Banano)
+			}
+		}
+	case "receive", "open":
+		event.Type = models.EventReceivePublished
+		event.Amount = balanceDifference(previousBalance, sb.Balance)
+		event.Detail = strings.ToUpper(sb.Link)
+	case "change":
+		event.Type = models.EventRepresentativeChanged
+		event.Detail = sb.Representative
+	default:
+		return
+	}
+	w.emitEvent(ctx, event)
+}
+
+// Events for a confirmation from the node websocket
+// Call it once per confirmation, not on every instance, handleConfirmation makes sure of that
+func (w *NanoWallet) EmitConfirmationEvents(ctx context.Context, account string, hash string, subtype string, destination string, amount string) {
+	if acc, err := w.GetAccountByAddress(ctx, account); err == nil {
+		event := models.WalletEvent{
+			Type:    models.EventBlockConfirmed,
+			Wallet:  acc.WalletID.String(),
+			Account: account,
+			Hash:    strings.ToUpper(hash),
+			Amount:  amount,
+			Detail:  subtype,
+		}
+		if subtype == "send" {
+			event.Type = models.EventSendConfirmed
+			event.Detail = destination
+		}
+		w.emitEvent(ctx, event)
+	}
+	if subtype != "send" {
+		return
+	}
+	if acc, err := w.GetAccountByAddress(ctx, destination); err == nil {
+		w.emitEvent(ctx, models.WalletEvent{
+			Type:    models.EventIncomingSendConfirmed,
+			Wallet:  acc.WalletID.String(),
+			Account: destination,
+			Hash:    strings.ToUpper(hash),
+			Amount:  amount,
+			Detail:  account,
+		})
+	}
+}
+
+// Work for one of our accounts, announced once it's ready
+func (w *NanoWallet) generateBlockWork(ctx context.Context, acc *ent.Account, workbase string, multiplier int, bpowKey string) (string, error) {
+	work, err := w.GenerateWork(ctx, workbase, multiplier, false, bpowKey)
+	if err != nil {
+		return "", err
+	}
+	w.emitEvent(ctx, models.WalletEvent{
+		Type:    models.EventWorkGenerated,
+		Wallet:  acc.WalletID.String(),
+		Account: acc.Address,
+		Hash:    strings.ToUpper(workbase),
+		Detail:  work,
+	})
+	return work, nil
+}
@@ -0,0 +1,13 @@
+package models
+
+// The locally tracked state of an account, as of the last block we know about
+// Unconfirmed holds the hashes of blocks we've published that we haven't seen confirmed yet
+// NeedsResync caches the account's flag, the other fields are empty when it's set
+type AccountState struct {
+	Frontier       string   `json:"frontier"`
+	Balance        string   `json:"balance"`
+	Representative string   `json:"representative"`
+	Version        string   `json:"version,omitempty"`
+	Unconfirmed    []string `json:"unconfirmed,omitempty"`
+	NeedsResync    bool     `json:"needs_resync,omitempty"`
+}
@@ -0,0 +1,24 @@
+package models
+
+// The outcome of waiting for a block to be confirmed
+type ConfirmationStatus string
+
+const (
+	ConfirmationConfirmed  ConfirmationStatus = "confirmed"
+	ConfirmationTimeout    ConfirmationStatus = "timeout"
+	ConfirmationRolledBack ConfirmationStatus = "rolled_back"
+)
+
+// A block the node websocket told us was confirmed, published on the event bus
+type BlockConfirmation struct {
+	Account        string
+	Hash           string
+	Subtype        string
+	Previous       string
+	Balance        string
+	Representative string
+	// The destination of a send
+	LinkAsAccount string
+	Amount        string
+	IsSend        bool
+}
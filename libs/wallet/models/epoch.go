@@ -0,0 +1,8 @@
+package models
+
+// The unsigned epoch block built for an account during an upgrade
+type EpochUpgradeResult struct {
+	Account string
+	Block   *StateBlock
+	Error   error
+}
@@ -0,0 +1,54 @@
+package models
+
+// Something notable happened to one of our accounts
+type WalletEvent struct {
+	Type    string `json:"type"`
+	Wallet  string `json:"wallet,omitempty"`
+	Account string `json:"account"`
+	Hash    string `json:"hash,omitempty"`
+	Amount  string `json:"amount,omitempty"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+const (
+	// The node's chain for the account no longer matches the blocks we published
+	EventAccountOutOfSync = "account_out_of_sync"
+	// account_resync rebuilt the local state of the account
+	EventAccountResynced = "account_resynced"
+	// A send to the account was confirmed, detail is the sender
+	EventIncomingSendConfirmed = "incoming_send_confirmed"
+	// We published a receive, detail is the send it receives
+	EventReceivePublished = "receive_published"
+	// We published a send, detail is the destination
+	EventSendPublished = "send_published"
+	// A send from the account was confirmed, detail is the destination
+	EventSendConfirmed = "send_confirmed"
+	// We published a change block, detail is the new representative
+	EventRepresentativeChanged = "representative_changed"
+	// Any other block of the account was confirmed, detail is the subtype
+	EventBlockConfirmed = "block_confirmed"
+	// Work for the account's next block is ready, hash is what it was generated for
+	EventWorkGenerated = "work_generated"
+	// A block we published still isn't confirmed after rebroadcast_alert_attempts rebroadcasts, detail is the count
+	EventBlockUnconfirmed = "block_unconfirmed"
+)
+
+// Events a webhook can subscribe to
+var WebhookEvents = []string{
+	EventIncomingSendConfirmed,
+	EventReceivePublished,
+	EventSendPublished,
+	EventSendConfirmed,
+	EventRepresentativeChanged,
+	EventBlockConfirmed,
+	EventAccountOutOfSync,
+	EventAccountResynced,
+	EventBlockUnconfirmed,
+}
+
+// What webhooks and the websocket API send
+type EventPayload struct {
+	Id        string `json:"id"`
+	Timestamp int64  `json:"timestamp"`
+	WalletEvent
+}
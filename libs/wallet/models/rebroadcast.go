@@ -0,0 +1,10 @@
+package models
+
+// A block we published that hasn't been seen confirmed yet
+type RebroadcastEntry struct {
+	Block       StateBlock `json:"block"`
+	Subtype     string     `json:"subtype"`
+	Attempts    int        `json:"attempts"`
+	Published   int64      `json:"published"`
+	NextAttempt int64      `json:"next_attempt"`
+}
@@ -0,0 +1,15 @@
+package models
+
+// A single send within a send_many batch
+type SendManyItem struct {
+	Destination string
+	Amount      string
+	ID          *string
+	Work        *string
+}
+
+// The outcome of a single send within a batch, results are in the same order as the items
+type SendManyResult struct {
+	Hash  string
+	Error error
+}
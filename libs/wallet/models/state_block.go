@@ -25,7 +25,8 @@ type StateBlock struct {
 	Banano         bool   `json:"-"`
 }
 
-func (b *StateBlock) computeHash() error {
+// Sets Hash from the block's contents, Sign does this too
+func (b *StateBlock) ComputeHash() error {
 	h, err := blake2b.New256(nil)
 	if err != nil {
 		return err
@@ -63,7 +64,7 @@ func (b *StateBlock) computeHash() error {
 }
 
 func (b *StateBlock) Sign(privateKey ed25519.PrivateKey) error {
-	if err := b.computeHash(); err != nil {
+	if err := b.ComputeHash(); err != nil {
 		return err
 	}
 	hash, err := hex.DecodeString(b.Hash)
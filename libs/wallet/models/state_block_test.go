@@ -22,7 +22,7 @@ func TestComputeBlockHash(t *testing.T) {
 	}
 
 	// Hash
-	err := sb.computeHash()
+	err := sb.ComputeHash()
 	assert.Nil(t, err)
 	assert.Equal(t, "8ebeb9534a14e0b17b3cd4639721387dedac80789278b540ddbde2a0b267b6d0", sb.Hash)
 }
@@ -57,7 +57,7 @@ func TestComputeBlockHashBanano(t *testing.T) {
 	}
 
 	// Hash
-	err := sb.computeHash()
+	err := sb.ComputeHash()
 	assert.Nil(t, err)
 	assert.Equal(t, "8ebeb9534a14e0b17b3cd4639721387dedac80789278b540ddbde2a0b267b6d0", sb.Hash)
 }
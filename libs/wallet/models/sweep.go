@@ -0,0 +1,10 @@
+package models
+
+// What moved out of a single account during a sweep
+type SweepResult struct {
+	Account  string
+	Received int
+	Amount   string
+	Hash     string
+	Error    error
+}
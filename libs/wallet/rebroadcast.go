@@ -0,0 +1,201 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+// Every block we publish is tracked in a redis hash until it's confirmed
+// The tracker periodically checks on them and republishes the ones the network seems to have dropped
+
+const rebroadcastKey = "rebroadcast"
+
+// First republish happens this long after publishing, doubling each attempt up to the max
+const rebroadcastBackoff = time.Second * 30
+const rebroadcastMaxBackoff = time.Minute * 30
+
+// Blocks that still aren't confirmed after this long are given up on
+const rebroadcastMaxAge = time.Hour * 24
+
+func rebroadcastDelay(attempts int) time.Duration {
+	delay := rebroadcastBackoff
+	for i := 0; i < attempts && delay < rebroadcastMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > rebroadcastMaxBackoff {
+		delay = rebroadcastMaxBackoff
+	}
+	return delay
+}
+
+// Hashes this instance published and still tracks, with when they were published
+// Only these are untracked on confirmation, the rest are cleared by whichever instance rebroadcasts them
+var localRebroadcasts = struct {
+	sync.Mutex
+	published map[string]time.Time
+}{
+	published: make(map[string]time.Time),
+}
+
+func (w *NanoWallet) saveRebroadcastEntry(hash string, entry *models.RebroadcastEntry) {
+	serialized, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := database.GetRedisDB().Hset(rebroadcastKey, hash, string(serialized)); err != nil {
+		log.Errorf("Error tracking block %s for rebroadcast %v", hash, err)
+	}
+}
+
+func (w *NanoWallet) trackPublishedBlock(hash string, sb *models.StateBlock, subtype string) {
+	now := time.Now()
+	hash = strings.ToUpper(hash)
+	w.saveRebroadcastEntry(hash, &models.RebroadcastEntry{
+		Block:       *sb,
+		Subtype:     subtype,
+		Published:   now.Unix(),
+		NextAttempt: now.Add(rebroadcastDelay(0)).Unix(),
+	})
+	localRebroadcasts.Lock()
+	localRebroadcasts.published[hash] = now
+	localRebroadcasts.Unlock()
+}
+
+func (w *NanoWallet) untrackBlock(hash string) {
+	hash = strings.ToUpper(hash)
+	database.GetRedisDB().Hdel(rebroadcastKey, hash)
+	localRebroadcasts.Lock()
+	delete(localRebroadcasts.published, hash)
+	localRebroadcasts.Unlock()
+}
+
+// Untrack a confirmed block if this instance published it
+func (w *NanoWallet) untrackConfirmedBlock(hash string) {
+	localRebroadcasts.Lock()
+	_, ok := localRebroadcasts.published[hash]
+	localRebroadcasts.Unlock()
+	if ok {
+		w.untrackBlock(hash)
+	}
+}
+
+// Blocks we've published that haven't been confirmed yet, keyed by hash
+func (w *NanoWallet) GetUnconfirmedBlocks() (map[string]*models.RebroadcastEntry, error) {
+	raw, err := database.GetRedisDB().Hgetall(rebroadcastKey)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]*models.RebroadcastEntry, len(raw))
+	for hash, serialized := range raw {
+		var entry models.RebroadcastEntry
+		if err := json.Unmarshal([]byte(serialized), &entry); err != nil {
+			continue
+		}
+		ret[hash] = &entry
+	}
+	return ret, nil
+}
+
+// Check on every tracked block that is due and republish the ones that aren't confirmed
+func (w *NanoWallet) RebroadcastUnconfirmed(ctx context.Context) {
+	w.rebroadcastUnconfirmed(ctx, time.Now())
+}
+
+func (w *NanoWallet) rebroadcastUnconfirmed(ctx context.Context, now time.Time) {
+	// Blocks another instance stopped tracking are given up on by now
+	localRebroadcasts.Lock()
+	for hash, published := range localRebroadcasts.published {
+		if now.Sub(published) > rebroadcastMaxAge {
+			delete(localRebroadcasts.published, hash)
+		}
+	}
+	localRebroadcasts.Unlock()
+
+	entries, err := w.GetUnconfirmedBlocks()
+	if err != nil {
+		log.Errorf("Error retrieving blocks to rebroadcast %v", err)
+		return
+	}
+	for hash, entry := range entries {
+		if entry.NextAttempt > now.Unix() {
+			continue
+		}
+		w.rebroadcastBlock(ctx, hash, entry, now)
+	}
+}
+
+func (w *NanoWallet) rebroadcastBlock(ctx context.Context, hash string, entry *models.RebroadcastEntry, now time.Time) {
+	// Another instance may be handling this one
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("rebroadcast:%s", hash), time.Second*30, nil)
+	if err != nil {
+		return
+	}
+	defer lock.Release(context.WithoutCancel(ctx))
+
+	if status, _ := w.pollConfirmation(ctx, hash); status == models.ConfirmationConfirmed {
+		w.untrackBlock(hash)
+		return
+	}
+	if now.Sub(time.Unix(entry.Published, 0)) > rebroadcastMaxAge {
+		log.Errorf("Giving up on rebroadcasting %s for %s after %d attempts", hash, entry.Block.Account, entry.Attempts)
+		w.untrackBlock(hash)
+		return
+	}
+
+	_, err = w.RpcClient.MakeProcessRequest(ctx, requests.ProcessRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "process",
+		},
+		Subtype:   &entry.Subtype,
+		JsonBlock: true,
+		Block:     entry.Block,
+	})
+	if err != nil {
+		errStr := strings.ToLower(err.Error())
+		if strings.Contains(errStr, "fork") {
+			// Something else took this block's place, republishing it won't help
+			log.Errorf("Block %s for %s lost to a fork, no longer rebroadcasting", hash, entry.Block.Account)
+			w.untrackBlock(hash)
+			return
+		} else if !strings.Contains(errStr, "old") {
+			log.Warnf("Error rebroadcasting %s %v", hash, err)
+		}
+	}
+
+	entry.Attempts++
+	entry.NextAttempt = now.Add(rebroadcastDelay(entry.Attempts)).Unix()
+	if entry.Attempts == w.Config.Wallet.RebroadcastAlertAttempts {
+		log.Errorf("ALERT: block %s for %s still unconfirmed after %d rebroadcasts", hash, entry.Block.Account, entry.Attempts)
+		w.emitEvent(ctx, models.WalletEvent{
+			Type:    models.EventBlockUnconfirmed,
+			Account: entry.Block.Account,
+			Hash:    hash,
+			Detail:  strconv.Itoa(entry.Attempts),
+		})
+	}
+	w.saveRebroadcastEntry(hash, entry)
+}
+
+// Run the rebroadcast tracker until ctx is done
+func (w *NanoWallet) StartRebroadcastTracker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.RebroadcastUnconfirmed(ctx)
+		}
+	}
+}
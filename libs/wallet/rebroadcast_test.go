@@ -0,0 +1,185 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRebroadcastUnconfirmed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	confirmed := "false"
+	processCalls := 0
+	processError := ""
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			} else if pr.Action == "block_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				js["confirmed"] = confirmed
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			} else if pr.Action == "process" {
+				processCalls++
+				if processError != "" {
+					resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+						"error": processError,
+					})
+					return resp, err
+				}
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+			return resp, err
+		},
+	)
+
+	database.GetRedisDB().Del(rebroadcastKey)
+
+	seed, err := utils.GenerateSeed(strings.NewReader("3B7D1F5A9C2E4B6D8F0A1C3E5B7D9F2A4C6E8B0D1F3A5C7E9B2D4F6A8C0E1B3D"))
+	assert.Nil(t, err)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	wallet.Representative = utils.ToPtr("nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs")
+	acc, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+
+	work := "0000000000000000"
+	hash, err := MockWallet.CreateAndPublishSendBlock(context.Background(), wallet, "1", acc.Address, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", nil, &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, processCalls)
+
+	tracked, err := MockWallet.GetUnconfirmedBlocks()
+	assert.Nil(t, err)
+	assert.Len(t, tracked, 1)
+	assert.Equal(t, acc.Address, tracked[hash].Block.Account)
+	assert.Equal(t, "send", tracked[hash].Subtype)
+	assert.Equal(t, 0, tracked[hash].Attempts)
+
+	// Not due yet
+	MockWallet.RebroadcastUnconfirmed(context.Background())
+	assert.Equal(t, 1, processCalls)
+
+	// Due and unconfirmed, republished with backoff
+	now := time.Now().Add(time.Minute)
+	MockWallet.rebroadcastUnconfirmed(context.Background(), now)
+	assert.Equal(t, 2, processCalls)
+	tracked, _ = MockWallet.GetUnconfirmedBlocks()
+	assert.Equal(t, 1, tracked[hash].Attempts)
+	assert.Equal(t, now.Add(time.Minute).Unix(), tracked[hash].NextAttempt)
+
+	// Node already has it, still counts as an attempt
+	processError = "Old block"
+	now = now.Add(time.Minute * 2)
+	MockWallet.rebroadcastUnconfirmed(context.Background(), now)
+	assert.Equal(t, 3, processCalls)
+	tracked, _ = MockWallet.GetUnconfirmedBlocks()
+	assert.Equal(t, 2, tracked[hash].Attempts)
+
+	// Confirmed, no longer tracked
+	confirmed = "true"
+	MockWallet.rebroadcastUnconfirmed(context.Background(), now.Add(time.Hour))
+	assert.Equal(t, 3, processCalls)
+	tracked, _ = MockWallet.GetUnconfirmedBlocks()
+	assert.Len(t, tracked, 0)
+
+	// Lost to a fork, no longer tracked
+	confirmed = "false"
+	processError = ""
+	_, err = MockWallet.CreateAndPublishSendBlock(context.Background(), wallet, "1", acc.Address, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", nil, &work, nil)
+	assert.Nil(t, err)
+	processError = "Fork"
+	MockWallet.rebroadcastUnconfirmed(context.Background(), time.Now().Add(time.Minute))
+	tracked, _ = MockWallet.GetUnconfirmedBlocks()
+	assert.Len(t, tracked, 0)
+
+	// Websocket confirmation stops tracking
+	processError = ""
+	_, err = MockWallet.CreateAndPublishSendBlock(context.Background(), wallet, "1", acc.Address, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", nil, &work, nil)
+	assert.Nil(t, err)
+	tracked, _ = MockWallet.GetUnconfirmedBlocks()
+	assert.Len(t, tracked, 1)
+	MockWallet.NotifyConfirmation(strings.ToLower(hash))
+	tracked, _ = MockWallet.GetUnconfirmedBlocks()
+	assert.Len(t, tracked, 0)
+
+	// Blocks published by another instance are left to the tracker
+	MockWallet.saveRebroadcastEntry(hash, &models.RebroadcastEntry{Block: models.StateBlock{Account: acc.Address}, Published: time.Now().Unix()})
+	MockWallet.NotifyConfirmation(hash)
+	tracked, _ = MockWallet.GetUnconfirmedBlocks()
+	assert.Len(t, tracked, 1)
+	MockWallet.untrackBlock(hash)
+}
+
+func TestRebroadcastAlert(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "block_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				js["confirmed"] = "false"
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "Old block",
+			})
+		},
+	)
+
+	var events []models.WalletEvent
+	w := *MockWallet
+	w.EventHandler = func(event models.WalletEvent) {
+		events = append(events, event)
+	}
+	hash := "9A1C4D7E2B5F8A3C6D9E1F4A7B2C5D8E3F6A9B1C4D7E2F5A8B3C6D9E1F4A7B2C"
+	account := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	entry := &models.RebroadcastEntry{
+		Block:     models.StateBlock{Account: account},
+		Subtype:   "send",
+		Published: time.Now().Unix(),
+		Attempts:  w.Config.Wallet.RebroadcastAlertAttempts - 2,
+	}
+
+	// Once it's been rebroadcast rebroadcast_alert_attempts times, and only then
+	w.rebroadcastBlock(context.Background(), hash, entry, time.Now())
+	assert.Len(t, events, 0)
+	w.rebroadcastBlock(context.Background(), hash, entry, time.Now())
+	w.rebroadcastBlock(context.Background(), hash, entry, time.Now())
+	assert.Len(t, events, 1)
+	assert.Equal(t, models.EventBlockUnconfirmed, events[0].Type)
+	assert.Equal(t, account, events[0].Account)
+	assert.Equal(t, hash, events[0].Hash)
+	assert.Equal(t, strconv.Itoa(w.Config.Wallet.RebroadcastAlertAttempts), events[0].Detail)
+	w.untrackBlock(hash)
+}
@@ -0,0 +1,238 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/receivepolicy"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+)
+
+// Which confirmed sends are received automatically, an account's own policy overrides its wallet's
+// Without either everything above receive_minimum is received
+const (
+	ReceivePolicyEnabled   = "enabled"
+	ReceivePolicyDisabled  = "disabled"
+	ReceivePolicyAllowlist = "allowlist"
+	ReceivePolicyDeferred  = "deferred"
+)
+
+var ErrInvalidReceivePolicy = errors.New("invalid receive policy")
+var ErrInvalidAllowlist = errors.New("invalid allowlist")
+var ErrInvalidBatchWindow = errors.New("invalid batch window")
+var ErrReceivePolicyNotFound = errors.New("receive policy not found")
+
+// Creates or replaces the policy of a wallet, or of one of its accounts when address isn't empty
+// batchWindow is in seconds and only used by the deferred policy, 0 means the default of an hour
+func (w *NanoWallet) SetReceivePolicy(ctx context.Context, wallet *ent.Wallet, address string, policy string, allowlist []string, batchWindow int) (*ent.ReceivePolicy, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if !slices.Contains([]string{ReceivePolicyEnabled, ReceivePolicyDisabled, ReceivePolicyAllowlist, ReceivePolicyDeferred}, policy) {
+		return nil, ErrInvalidReceivePolicy
+	} else if policy == ReceivePolicyAllowlist && len(allowlist) == 0 {
+		return nil, ErrInvalidAllowlist
+	} else if batchWindow < 0 {
+		return nil, ErrInvalidBatchWindow
+	}
+	// Stored with the current prefix so they match the senders the node reports, whichever was given
+	normalized := make([]string, len(allowlist))
+	for i, sender := range allowlist {
+		pub, err := utils.AddressToPub(sender, w.Config.Wallet.Banano)
+		if err != nil {
+			return nil, ErrInvalidAllowlist
+		}
+		normalized[i] = utils.PubKeyToAddress(pub, w.Config.Wallet.Banano)
+	}
+	allowlist = normalized
+	if batchWindow == 0 {
+		batchWindow = 3600
+	}
+
+	existing, err := w.GetReceivePolicy(ctx, wallet, address)
+	if errors.Is(err, ErrReceivePolicyNotFound) {
+		create := w.DB.ReceivePolicy.Create().SetWalletID(wallet.ID).SetPolicy(policy).SetAllowlist(allowlist).SetBatchWindow(batchWindow)
+		if address != "" {
+			acc, err := w.GetAccount(ctx, wallet, address)
+			if err != nil {
+				return nil, err
+			}
+			create.SetAccountID(acc.ID)
+		}
+		return create.Save(ctx)
+	} else if err != nil {
+		return nil, err
+	}
+	return existing.Update().SetPolicy(policy).SetAllowlist(allowlist).SetBatchWindow(batchWindow).ClearLastBatchAt().Save(ctx)
+}
+
+// The policy set on a wallet, or on one of its accounts when address isn't empty
+func (w *NanoWallet) GetReceivePolicy(ctx context.Context, wallet *ent.Wallet, address string) (*ent.ReceivePolicy, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	query := w.DB.ReceivePolicy.Query().Where(receivepolicy.WalletID(wallet.ID))
+	if address == "" {
+		query = query.Where(receivepolicy.AccountIDIsNil())
+	} else {
+		acc, err := w.GetAccount(ctx, wallet, address)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(receivepolicy.AccountID(acc.ID))
+	}
+	policy, err := query.First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrReceivePolicyNotFound
+	}
+	return policy, err
+}
+
+// Removes a policy, the account falls back to its wallet's and the wallet to receiving everything
+func (w *NanoWallet) RemoveReceivePolicy(ctx context.Context, wallet *ent.Wallet, address string) error {
+	policy, err := w.GetReceivePolicy(ctx, wallet, address)
+	if err != nil {
+		return err
+	}
+	return w.DB.ReceivePolicy.DeleteOne(policy).Exec(ctx)
+}
+
+// The account's policy, its wallet's, or nil when neither has one
+func (w *NanoWallet) receivePolicyFor(ctx context.Context, acc *ent.Account) *ent.ReceivePolicy {
+	policies, err := w.DB.ReceivePolicy.Query().
+		Where(
+			receivepolicy.WalletID(acc.WalletID),
+			receivepolicy.Or(receivepolicy.AccountID(acc.ID), receivepolicy.AccountIDIsNil()),
+		).
+		All(ctx)
+	if err != nil {
+		log.Errorf("Error getting receive policy for %s %v", acc.Address, err)
+		return nil
+	}
+	var walletPolicy *ent.ReceivePolicy
+	for _, policy := range policies {
+		if policy.AccountID != nil {
+			return policy
+		}
+		walletPolicy = policy
+	}
+	return walletPolicy
+}
+
+// Whether a send from sender may be received now, deferred ones are only received when batching
+func receivePolicyAllows(policy *ent.ReceivePolicy, sender string, batching bool) bool {
+	if policy == nil {
+		return true
+	}
+	switch policy.Policy {
+	case ReceivePolicyDisabled:
+		return false
+	case ReceivePolicyAllowlist:
+		return slices.Contains(policy.Allowlist, sender)
+	case ReceivePolicyDeferred:
+		return batching
+	}
+	return true
+}
+
+// Checks a receivable hash against the account's policy, looking up the sender only when it matters
+func (w *NanoWallet) receiveFilter(ctx context.Context, acc *ent.Account, batching bool) func(hash string) bool {
+	policy := w.receivePolicyFor(ctx, acc)
+	return func(hash string) bool {
+		if policy == nil || policy.Policy != ReceivePolicyAllowlist {
+			return receivePolicyAllows(policy, "", batching)
+		}
+		blockInfo, err := w.RpcClient.MakeBlockInfoRequest(ctx, hash)
+		if err != nil || blockInfo == nil {
+			return false
+		}
+		return receivePolicyAllows(policy, blockInfo.BlockAccount, batching)
+	}
+}
+
+// Receives everything receivable on accounts with a deferred policy whose batch window passed
+// Returns how many blocks were received
+func (w *NanoWallet) ReceiveDeferred(ctx context.Context) (int, error) {
+	return w.receiveDeferred(ctx, time.Now())
+}
+
+func (w *NanoWallet) receiveDeferred(ctx context.Context, now time.Time) (int, error) {
+	policies, err := w.DB.ReceivePolicy.Query().Where(receivepolicy.PolicyEQ(ReceivePolicyDeferred)).WithWallet().All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	received := 0
+	for _, policy := range policies {
+		if policy.LastBatchAt != nil && now.Before(policy.LastBatchAt.Add(time.Duration(policy.BatchWindow)*time.Second)) {
+			continue
+		}
+		count, err := w.receiveBatch(ctx, policy, now)
+		received += count
+		if errors.Is(err, database.ErrLockNotObtained) {
+			continue
+		} else if err != nil {
+			log.Errorf("Error receiving deferred batch for wallet %s %v", policy.WalletID, err)
+		}
+	}
+	return received, nil
+}
+
+// One batch of a deferred policy, a wallet's covers the accounts without a policy of their own
+func (w *NanoWallet) receiveBatch(ctx context.Context, policy *ent.ReceivePolicy, now time.Time) (int, error) {
+	// Another instance may be handling this one
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("receivebatch:%s", policy.ID), time.Minute*5, nil)
+	if err != nil {
+		return 0, database.ErrLockNotObtained
+	}
+	defer lock.Release(context.WithoutCancel(ctx))
+
+	var accounts []*ent.Account
+	if policy.AccountID != nil {
+		accounts, err = policy.QueryAccount().All(ctx)
+	} else {
+		accounts, err = policy.QueryWallet().QueryAccounts().All(ctx)
+	}
+	if err != nil {
+		return 0, err
+	}
+	// Set first so a batch that fails waits for the next window instead of retrying every tick
+	if err := policy.Update().SetLastBatchAt(now).Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	received := 0
+	for _, acc := range accounts {
+		if own := w.receivePolicyFor(ctx, acc); own == nil || own.ID != policy.ID {
+			continue
+		}
+		count, err := w.ReceiveAllBlocks(ctx, policy.Edges.Wallet, acc.Address, nil)
+		received += count
+		if err != nil {
+			return received, err
+		}
+	}
+	return received, nil
+}
+
+// Receives deferred batches every interval until ctx is done
+func (w *NanoWallet) StartDeferredReceives(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if received, err := w.ReceiveDeferred(ctx); err != nil {
+				log.Errorf("Error receiving deferred batches %v", err)
+			} else if received > 0 {
+				log.Infof("Received %d deferred blocks", received)
+			}
+		}
+	}
+}
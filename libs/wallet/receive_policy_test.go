@@ -0,0 +1,122 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const allowedSender = "nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs"
+
+func TestSetReceivePolicy(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4"))
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+	other, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+
+	_, err = MockWallet.SetReceivePolicy(context.Background(), wallet, "", "sometimes", nil, 0)
+	assert.ErrorIs(t, err, ErrInvalidReceivePolicy)
+	_, err = MockWallet.SetReceivePolicy(context.Background(), wallet, "", ReceivePolicyAllowlist, nil, 0)
+	assert.ErrorIs(t, err, ErrInvalidAllowlist)
+	_, err = MockWallet.SetReceivePolicy(context.Background(), wallet, "", ReceivePolicyAllowlist, []string{"nano_nope"}, 0)
+	assert.ErrorIs(t, err, ErrInvalidAllowlist)
+	_, err = MockWallet.SetReceivePolicy(context.Background(), wallet, "", ReceivePolicyDeferred, nil, -1)
+	assert.ErrorIs(t, err, ErrInvalidBatchWindow)
+	_, err = MockWallet.GetReceivePolicy(context.Background(), wallet, "")
+	assert.ErrorIs(t, err, ErrReceivePolicyNotFound)
+	assert.Nil(t, MockWallet.receivePolicyFor(context.Background(), acc))
+
+	// The wallet's applies to every account, until one has its own
+	walletPolicy, err := MockWallet.SetReceivePolicy(context.Background(), wallet, "", ReceivePolicyDisabled, nil, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 3600, walletPolicy.BatchWindow)
+	accountPolicy, err := MockWallet.SetReceivePolicy(context.Background(), wallet, acc.Address, ReceivePolicyAllowlist, []string{"xrb_" + strings.TrimPrefix(allowedSender, "nano_")}, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{allowedSender}, accountPolicy.Allowlist)
+	assert.Equal(t, accountPolicy.ID, MockWallet.receivePolicyFor(context.Background(), acc).ID)
+	assert.Equal(t, walletPolicy.ID, MockWallet.receivePolicyFor(context.Background(), other).ID)
+
+	// Setting it again replaces it
+	walletPolicy, err = MockWallet.SetReceivePolicy(context.Background(), wallet, "", ReceivePolicyDeferred, nil, 60)
+	assert.Nil(t, err)
+	got, err := MockWallet.GetReceivePolicy(context.Background(), wallet, "")
+	assert.Nil(t, err)
+	assert.Equal(t, walletPolicy.ID, got.ID)
+	assert.Equal(t, ReceivePolicyDeferred, got.Policy)
+	assert.Equal(t, 60, got.BatchWindow)
+
+	// Removing the account's falls back to the wallet's
+	assert.Nil(t, MockWallet.RemoveReceivePolicy(context.Background(), wallet, acc.Address))
+	assert.ErrorIs(t, MockWallet.RemoveReceivePolicy(context.Background(), wallet, acc.Address), ErrReceivePolicyNotFound)
+	assert.Equal(t, walletPolicy.ID, MockWallet.receivePolicyFor(context.Background(), acc).ID)
+	assert.Nil(t, MockWallet.RemoveReceivePolicy(context.Background(), wallet, ""))
+	assert.Nil(t, MockWallet.receivePolicyFor(context.Background(), acc))
+}
+
+func TestReceivePolicyAllows(t *testing.T) {
+	assert.True(t, receivePolicyAllows(nil, "", false))
+	assert.True(t, receivePolicyAllows(&ent.ReceivePolicy{Policy: ReceivePolicyEnabled}, "", false))
+	assert.False(t, receivePolicyAllows(&ent.ReceivePolicy{Policy: ReceivePolicyDisabled}, "", true))
+	assert.True(t, receivePolicyAllows(&ent.ReceivePolicy{Policy: ReceivePolicyAllowlist, Allowlist: []string{allowedSender}}, allowedSender, false))
+	assert.False(t, receivePolicyAllows(&ent.ReceivePolicy{Policy: ReceivePolicyAllowlist, Allowlist: []string{allowedSender}}, "nano_other", false))
+	assert.False(t, receivePolicyAllows(&ent.ReceivePolicy{Policy: ReceivePolicyDeferred}, "", false))
+	assert.True(t, receivePolicyAllows(&ent.ReceivePolicy{Policy: ReceivePolicyDeferred}, "", true))
+}
+
+func TestReceiveDeferred(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5"))
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+	other, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+	policy, err := MockWallet.SetReceivePolicy(context.Background(), wallet, "", ReceivePolicyDeferred, nil, 60)
+	assert.Nil(t, err)
+	// Has its own policy, so isn't part of the wallet's batch
+	_, err = MockWallet.SetReceivePolicy(context.Background(), wallet, other.Address, ReceivePolicyEnabled, nil, 0)
+	assert.Nil(t, err)
+
+	var requested []string
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var raw map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&raw)
+			if raw["action"] == "receivable" {
+				requested = append(requested, raw["account"].(string))
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": ""})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+		},
+	)
+
+	// Due right away, then once per window
+	now := time.Now()
+	_, err = MockWallet.receiveDeferred(context.Background(), now)
+	assert.Nil(t, err)
+	assert.Contains(t, requested, acc.Address)
+	assert.NotContains(t, requested, other.Address)
+	batch := len(requested)
+	policy = MockWallet.DB.ReceivePolicy.GetX(context.Background(), policy.ID)
+	assert.WithinDuration(t, now, *policy.LastBatchAt, time.Second)
+	_, err = MockWallet.receiveDeferred(context.Background(), now.Add(time.Second*30))
+	assert.Nil(t, err)
+	assert.Len(t, requested, batch)
+	_, err = MockWallet.receiveDeferred(context.Background(), now.Add(time.Second*61))
+	assert.Nil(t, err)
+	assert.Len(t, requested, batch*2)
+}
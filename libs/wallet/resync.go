@@ -0,0 +1,102 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	nanorpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+var ErrAccountNeedsResync = errors.New("account out of sync with the network, use account_resync")
+
+// Node errors that mean the previous of a block isn't the account's frontier on the node
+func isPreviousMismatch(err error) bool {
+	errStr := strings.ToLower(err.Error())
+	return strings.Contains(errStr, "fork") || strings.Contains(errStr, "gap previous")
+}
+
+func (w *NanoWallet) needsResync(ctx context.Context, address string) (bool, error) {
+	return w.DB.Account.Query().Where(account.Address(address), account.NeedsResync(true)).Exist(ctx)
+}
+
+// The node rejected a block because of its previous, check the node's frontier and flag the account if we diverged
+// A frontier matching our previous means the rejection was something transient and we leave the account alone
+func (w *NanoWallet) checkFork(ctx context.Context, sb *models.StateBlock, processErr error) error {
+	var frontier string
+	accountInfo, err := w.RpcClient.MakeAccountInfoRequest(ctx, sb.Account)
+	if err == nil {
+		frontier = strings.ToUpper(accountInfo.Frontier)
+	} else if !errors.Is(err, nanorpc.ErrAccountNotFound) {
+		return processErr
+	}
+	if frontier == strings.ToUpper(sb.Previous) {
+		return processErr
+	}
+
+	if _, err := w.DB.Account.Update().Where(account.Address(sb.Account)).SetNeedsResync(true).Save(ctx); err != nil {
+		return err
+	}
+	w.setAccountState(sb.Account, &models.AccountState{NeedsResync: true})
+	w.emitEvent(ctx, models.WalletEvent{
+		Type:    models.EventAccountOutOfSync,
+		Account: sb.Account,
+		Hash:    strings.ToUpper(sb.Hash),
+		Detail:  fmt.Sprintf("%s, previous %s, node frontier %s", processErr.Error(), strings.ToUpper(sb.Previous), frontier),
+	})
+	return fmt.Errorf("%w: %v", ErrAccountNeedsResync, processErr)
+}
+
+// Throw away everything we know locally about an account and rebuild it from the node
+// Pending rebroadcasts are dropped since they were built on a chain the network didn't keep
+func (w *NanoWallet) AccountResync(ctx context.Context, wallet *ent.Wallet, address string) (*models.AccountState, error) {
+	acc, err := w.GetAccount(ctx, wallet, address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Obtain lock
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*30, &database.LockRetryStrategy)
+	if err != nil {
+		return nil, database.ErrLockNotObtained
+	}
+	defer lock.Release(context.WithoutCancel(ctx))
+
+	w.ClearAccountState(acc.Address)
+	if tracked, err := w.GetUnconfirmedBlocks(); err == nil {
+		for hash, entry := range tracked {
+			if entry.Block.Account == acc.Address {
+				w.untrackBlock(hash)
+			}
+		}
+	}
+
+	state := &models.AccountState{}
+	accountInfo, err := w.RpcClient.MakeAccountInfoRequest(ctx, acc.Address)
+	if err == nil {
+		state.Frontier = strings.ToUpper(accountInfo.Frontier)
+		state.Balance = accountInfo.Balance
+		state.Representative = accountInfo.Representative
+		state.Version = accountInfo.AccountVersion
+		w.setAccountState(acc.Address, state)
+	} else if !errors.Is(err, nanorpc.ErrAccountNotFound) {
+		return nil, err
+	}
+
+	if _, err := w.DB.Account.Update().Where(account.Address(acc.Address)).SetNeedsResync(false).Save(ctx); err != nil {
+		return nil, err
+	}
+	w.emitEvent(ctx, models.WalletEvent{
+		Type:    models.EventAccountResynced,
+		Account: acc.Address,
+		Hash:    state.Frontier,
+	})
+
+	return state, nil
+}
@@ -0,0 +1,112 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForkDetectionAndResync(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	processError := ""
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			} else if pr.Action == "process" && processError == "" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": processError,
+			})
+			return resp, err
+		},
+	)
+
+	var events []models.WalletEvent
+	MockWallet.EventHandler = func(event models.WalletEvent) {
+		events = append(events, event)
+	}
+	defer func() {
+		MockWallet.EventHandler = nil
+	}()
+
+	seed, err := utils.GenerateSeed(strings.NewReader("9E2A4C6B8D0F1E3A5C7B9D2F4E6A8C0B1D3F5E7A9C2B4D6F8E0A1C3B5D7F9E2A"))
+	assert.Nil(t, err)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	wallet.Representative = utils.ToPtr("nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs")
+	acc, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+
+	work := "0000000000000000"
+	destination := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+
+	// Rejected on top of the node's own frontier, not a divergence
+	processError = "Fork"
+	_, err = MockWallet.CreateAndPublishSendBlock(context.Background(), wallet, "1", acc.Address, destination, nil, &work, nil)
+	assert.NotNil(t, err)
+	assert.NotErrorIs(t, err, ErrAccountNeedsResync)
+	assert.Len(t, events, 0)
+
+	// Built on a frontier the node doesn't have
+	MockWallet.setAccountState(acc.Address, &models.AccountState{
+		Frontier:       "CE898C131AAEE25E05362F247760F8A3ACF34A9796A5AE0D9204E86B0637965E",
+		Balance:        "100",
+		Representative: destination,
+	})
+	processError = "Gap previous block"
+	_, err = MockWallet.CreateAndPublishSendBlock(context.Background(), wallet, "1", acc.Address, destination, nil, &work, nil)
+	assert.ErrorIs(t, err, ErrAccountNeedsResync)
+	assert.Len(t, events, 1)
+	assert.Equal(t, models.EventAccountOutOfSync, events[0].Type)
+	assert.Equal(t, acc.Address, events[0].Account)
+	acc, _ = MockWallet.GetAccount(context.Background(), wallet, acc.Address)
+	assert.True(t, acc.NeedsResync)
+	assert.True(t, MockWallet.GetAccountState(acc.Address).NeedsResync)
+
+	// Nothing gets built until it's resynced
+	processError = ""
+	_, err = MockWallet.CreateAndPublishSendBlock(context.Background(), wallet, "1", acc.Address, destination, nil, &work, nil)
+	assert.ErrorIs(t, err, ErrAccountNeedsResync)
+	// Also once the cached state is gone
+	MockWallet.ClearAccountState(acc.Address)
+	_, err = MockWallet.CreateAndPublishSendBlock(context.Background(), wallet, "1", acc.Address, destination, nil, &work, nil)
+	assert.ErrorIs(t, err, ErrAccountNeedsResync)
+	assert.True(t, MockWallet.GetAccountState(acc.Address).NeedsResync)
+
+	state, err := MockWallet.AccountResync(context.Background(), wallet, acc.Address)
+	assert.Nil(t, err)
+	assert.Equal(t, "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F", state.Frontier)
+	assert.Equal(t, "11999999999999999918751838129509869131", state.Balance)
+	assert.Len(t, events, 2)
+	assert.Equal(t, models.EventAccountResynced, events[1].Type)
+	acc, _ = MockWallet.GetAccount(context.Background(), wallet, acc.Address)
+	assert.False(t, acc.NeedsResync)
+
+	sb, err := MockWallet.createSendBlock(context.Background(), wallet, acc, "1", destination, &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F", sb.Previous)
+
+	_, err = MockWallet.AccountResync(context.Background(), wallet, "nano_1111111111111111111111111111111111111111111111111117353trpda")
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+}
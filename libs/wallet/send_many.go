@@ -0,0 +1,242 @@
+package wallet
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	nanorpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+var ErrNoSends = errors.New("no sends provided")
+var ErrDuplicateSendID = errors.New("duplicate id in batch")
+var ErrPreviousSendFailed = errors.New("a previous send in the batch failed")
+
+// How many work requests a batch will have in flight at the same time
+const sendManyWorkConcurrency = 4
+
+// A send in the chain that's being built, along with the work being computed for it
+type chainedSend struct {
+	index int
+	block *models.StateBlock
+	work  chan sendManyWork
+}
+
+type sendManyWork struct {
+	work string
+	err  error
+}
+
+// Create and publish a batch of sends from a single account
+// The account is locked once for the whole batch and blocks are chained off the locally tracked frontier and balance,
+// so at most one account_info round trip is needed. Work for every block is computed in a pipeline while we publish in order.
+// Sends with an ID that has already been published are republished, same as CreateAndPublishSendBlock
+func (w *NanoWallet) CreateAndPublishSendBlocks(ctx context.Context, wallet *ent.Wallet, source string, sends []models.SendManyItem, bpowKey *string) ([]models.SendManyResult, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if len(sends) == 0 {
+		return nil, ErrNoSends
+	}
+	acc, err := w.GetAccount(ctx, wallet, source)
+	if err != nil {
+		return nil, err
+	}
+
+	// Obtain lock
+	// Longer lock since this could be long running
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*300, &database.LockRetryStrategy)
+	if err != nil {
+		return nil, database.ErrLockNotObtained
+	}
+	defer lock.Release(context.WithoutCancel(ctx))
+
+	results := make([]models.SendManyResult, len(sends))
+
+	// Figure out which sends we still need to make
+	var pending []int
+	amounts := make([]*big.Int, len(sends))
+	total := big.NewInt(0)
+	seenIDs := make(map[string]bool)
+	for i, send := range sends {
+		if send.ID != nil {
+			if seenIDs[*send.ID] {
+				results[i].Error = ErrDuplicateSendID
+				continue
+			}
+			seenIDs[*send.ID] = true
+			// Idempotency, republish sends we've already made with this ID
+			block, err := w.GetBlockFromDatabase(ctx, wallet, source, *send.ID)
+			if !errors.Is(err, ErrBlockNotFound) && err != nil {
+				results[i].Error = err
+				continue
+			} else if block != nil {
+				results[i].Hash, results[i].Error = w.republishSavedBlock(ctx, block)
+				continue
+			}
+		}
+		amount, ok := big.NewInt(0).SetString(send.Amount, 10)
+		if !ok || amount.Sign() < 0 {
+			results[i].Error = errors.New("Unable to parse send amount")
+			continue
+		}
+		amounts[i] = amount
+		total.Add(total, amount)
+		pending = append(pending, i)
+	}
+
+	if len(pending) == 0 {
+		return results, nil
+	}
+
+	// Get account info for the whole batch, if we can't cover all of it we'll send what we can in order
+	accountInfo, balance, err := w.getSendAccountInfo(ctx, wallet, acc, total, bpowKey)
+	if errors.Is(err, ErrInsufficientBalance) {
+		accountInfo, err = w.getAccountInfo(ctx, acc.Address)
+		if errors.Is(err, nanorpc.ErrAccountNotFound) {
+			for _, i := range pending {
+				results[i].Error = ErrInsufficientBalance
+			}
+			return results, nil
+		} else if err != nil {
+			return nil, err
+		}
+		var ok bool
+		balance, ok = big.NewInt(0).SetString(accountInfo.Balance, 10)
+		if !ok {
+			return nil, errors.New("Unable to parse balance")
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	representative, err := w.getDefaultRepresentative(wallet)
+	if err != nil {
+		return nil, err
+	}
+	priv, err := w.getPrivateKey(wallet, acc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the chain of blocks, the hash doesn't depend on the work so we can sign everything up front
+	previous := accountInfo.Frontier
+	var chain []*chainedSend
+	for _, i := range pending {
+		if amounts[i].Cmp(balance) > 0 {
+			results[i].Error = ErrInsufficientBalance
+			continue
+		}
+		link, err := utils.AddressToPub(sends[i].Destination, w.Config.Wallet.Banano)
+		if err != nil {
+			results[i].Error = errors.New("Invalid destination address")
+			continue
+		}
+		newBalance := big.NewInt(0).Sub(balance, amounts[i])
+		stateBlock := &models.StateBlock{
+			Type:           "state",
+			Account:        acc.Address,
+			Previous:       previous,
+			Representative: representative,
+			Balance:        newBalance.String(),
+			Link:           hex.EncodeToString(link),
+			Banano:         w.Config.Wallet.Banano,
+		}
+		if err := stateBlock.Sign(priv); err != nil {
+			results[i].Error = err
+			continue
+		}
+		balance = newBalance
+		previous = strings.ToUpper(stateBlock.Hash)
+		chain = append(chain, &chainedSend{
+			index: i,
+			block: stateBlock,
+			work:  make(chan sendManyWork, 1),
+		})
+	}
+
+	// Compute work for the whole chain in the background, it's stopped as soon as a send fails
+	pipelineCtx, cancelPipeline := context.WithCancel(ctx)
+	defer cancelPipeline()
+	go w.sendManyWorkPipeline(pipelineCtx, acc, chain, sends, bpowKey)
+
+	// Publish in order, once one fails everything after it is invalid
+	var chainErr error
+	for _, cs := range chain {
+		if chainErr != nil {
+			results[cs.index].Error = ErrPreviousSendFailed
+			continue
+		}
+		work := <-cs.work
+		if work.err != nil {
+			results[cs.index].Error = work.err
+			chainErr = work.err
+			cancelPipeline()
+			continue
+		}
+		cs.block.Work = work.work
+
+		hash, err := w.publishBlock(ctx, cs.block, "send")
+		if err != nil {
+			results[cs.index].Error = err
+			chainErr = err
+			cancelPipeline()
+			continue
+		}
+		results[cs.index].Hash = hash
+
+		// If the ID is set save it in database for indempotency, even if the request was cancelled since it went out
+		if sends[cs.index].ID != nil {
+			var asInterface map[string]interface{}
+			inrec, _ := json.Marshal(cs.block)
+			json.Unmarshal(inrec, &asInterface)
+			_, err := w.DB.Block.Create().SetAccount(acc).SetBlock(asInterface).SetBlockHash(strings.ToUpper(cs.block.Hash)).SetSubtype("send").SetSendID(*sends[cs.index].ID).Save(context.WithoutCancel(ctx))
+			if err != nil {
+				results[cs.index].Error = err
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// Generates work for every block in the chain, bounded by sendManyWorkConcurrency
+// Results are written to each block's work channel as they finish, once ctx is done the rest get its error
+func (w *NanoWallet) sendManyWorkPipeline(ctx context.Context, acc *ent.Account, chain []*chainedSend, sends []models.SendManyItem, bpowKey *string) {
+	key := ""
+	if bpowKey != nil {
+		key = *bpowKey
+	}
+	difficulty := w.workMultiplier("send", nil)
+	sem := make(chan struct{}, sendManyWorkConcurrency)
+	for idx, cs := range chain {
+		if sends[cs.index].Work != nil {
+			cs.work <- sendManyWork{work: *sends[cs.index].Work}
+			continue
+		}
+		// The work base is the previous block of the chain
+		workbase := cs.block.Previous
+		if idx > 0 {
+			workbase = chain[idx-1].block.Hash
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			cs.work <- sendManyWork{err: ctx.Err()}
+			continue
+		}
+		go func(cs *chainedSend, workbase string) {
+			defer func() { <-sem }()
+			work, err := w.generateBlockWork(ctx, acc, strings.ToUpper(workbase), difficulty, key)
+			cs.work <- sendManyWork{work: work, err: err}
+		}(cs, workbase)
+	}
+}
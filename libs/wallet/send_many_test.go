@@ -0,0 +1,173 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAndPublishSendBlocks(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var published []models.StateBlock
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.ProcessRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			} else if pr.Action == "process" {
+				published = append(published, pr.Block)
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+			return resp, err
+		},
+	)
+
+	_, err := MockWallet.CreateAndPublishSendBlocks(context.Background(), nil, "", nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	seed, err := utils.GenerateSeed(strings.NewReader("B3D0F0A3A5F1A3E0BEE0A1A4F6B7B7C1D2E3F4A5B6C7D8E9F0A1B2C3D4E5F6A7"))
+	assert.Nil(t, err)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	wallet.Representative = utils.ToPtr("nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs")
+	acc, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+
+	_, err = MockWallet.CreateAndPublishSendBlocks(context.Background(), wallet, acc.Address, nil, nil)
+	assert.ErrorIs(t, err, ErrNoSends)
+
+	work := "0000000000000000"
+	destination := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	sends := []models.SendManyItem{
+		{Destination: destination, Amount: "1", ID: utils.ToPtr("batch-1"), Work: &work},
+		{Destination: destination, Amount: "2", Work: &work},
+		{Destination: destination, Amount: "3", ID: utils.ToPtr("batch-1"), Work: &work},
+		{Destination: destination, Amount: "100000000000000000000000000000000000000", Work: &work},
+		{Destination: "nano_invalid", Amount: "1", Work: &work},
+	}
+	results, err := MockWallet.CreateAndPublishSendBlocks(context.Background(), wallet, acc.Address, sends, nil)
+	assert.Nil(t, err)
+	assert.Len(t, results, 5)
+	assert.Nil(t, results[0].Error)
+	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", results[0].Hash)
+	assert.Nil(t, results[1].Error)
+	assert.ErrorIs(t, results[2].Error, ErrDuplicateSendID)
+	assert.ErrorIs(t, results[3].Error, ErrInsufficientBalance)
+	assert.NotNil(t, results[4].Error)
+
+	// Blocks are chained off each other locally
+	assert.Len(t, published, 2)
+	assert.Equal(t, "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F", published[0].Previous)
+	assert.Equal(t, "11999999999999999918751838129509869130", published[0].Balance)
+	assert.Equal(t, strings.ToUpper(published[0].Hash), published[1].Previous)
+	assert.Equal(t, "11999999999999999918751838129509869128", published[1].Balance)
+
+	// Sending the same ID again republishes the saved block
+	results, err = MockWallet.CreateAndPublishSendBlocks(context.Background(), wallet, acc.Address, sends[:1], nil)
+	assert.Nil(t, err)
+	assert.Nil(t, results[0].Error)
+	assert.Equal(t, strings.ToUpper(published[0].Hash), results[0].Hash)
+	assert.Len(t, published, 3)
+}
+
+// Blocks until the request is cancelled
+type blockingWorkProvider struct {
+	started   chan struct{}
+	cancelled chan struct{}
+}
+
+func (bp *blockingWorkProvider) Name() string {
+	return "blocking"
+}
+
+func (bp *blockingWorkProvider) Available(req *pow.WorkRequest) bool {
+	return true
+}
+
+func (bp *blockingWorkProvider) GenerateWork(ctx context.Context, req *pow.WorkRequest) (string, error) {
+	bp.started <- struct{}{}
+	<-ctx.Done()
+	bp.cancelled <- struct{}{}
+	return "", ctx.Err()
+}
+
+func TestCreateAndPublishSendBlocksStopsWork(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	provider := &blockingWorkProvider{started: make(chan struct{}, 4), cancelled: make(chan struct{}, 4)}
+	workClient := pow.NewPippinPow([]string{}, "", "", 30, 1)
+	workClient.RegisterProvider(provider)
+	assert.Nil(t, workClient.UseProviders([]string{provider.Name()}))
+	w := *MockWallet
+	w.WorkClient = workClient
+
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr.Action == "process" {
+				// Fail the first send once work for the next one is underway
+				<-provider.started
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"error": "Bad signature",
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	seed, err := utils.GenerateSeed(strings.NewReader("C4E1A0B3A6F2A4E1BFF1A2A5F7B8B8C2D3E4F5A6B7C8D9E0F1A2B3C4D5E6F7A8"))
+	assert.Nil(t, err)
+	wallet, err := w.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	wallet.Representative = utils.ToPtr("nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs")
+	acc, err := w.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+
+	work := "0000000000000000"
+	destination := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	results, err := w.CreateAndPublishSendBlocks(context.Background(), wallet, acc.Address, []models.SendManyItem{
+		{Destination: destination, Amount: "1", Work: &work},
+		{Destination: destination, Amount: "2"},
+	}, nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, results[0].Error)
+	assert.ErrorIs(t, results[1].Error, ErrPreviousSendFailed)
+
+	// Work for the send that won't be published is cancelled
+	select {
+	case <-provider.cancelled:
+	case <-time.After(time.Second * 5):
+		t.Fatal("work generation wasn't cancelled")
+	}
+}
@@ -0,0 +1,116 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	nanorpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+var ErrInvalidDestination = errors.New("invalid destination")
+
+// Receive everything pending on an account and send the entire resulting balance to destination
+// What's received follows the account's receive policy, deferred sends are received right away
+// Unopened accounts without anything to receive, and empty accounts, are not an error, they just have nothing to move
+func (w *NanoWallet) AccountSweep(ctx context.Context, wallet *ent.Wallet, address string, destination string, bpowKey *string) (*models.SweepResult, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if _, err := utils.AddressToPub(destination, w.Config.Wallet.Banano); err != nil {
+		return nil, ErrInvalidDestination
+	}
+
+	acc, err := w.GetAccount(ctx, wallet, address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Obtain lock
+	// Longer lock since this could be long running
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*300, &database.LockRetryStrategy)
+	if err != nil {
+		return nil, database.ErrLockNotObtained
+	}
+	defer lock.Release(context.WithoutCancel(ctx))
+
+	result := &models.SweepResult{
+		Account: acc.Address,
+		Amount:  "0",
+	}
+
+	received, err := w.receiveAll(ctx, wallet, acc, bpowKey, w.receiveFilter(ctx, acc, true))
+	result.Received = received
+	if err != nil {
+		return result, err
+	}
+
+	accountInfo, err := w.getAccountInfo(ctx, acc.Address)
+	if errors.Is(err, nanorpc.ErrAccountNotFound) {
+		return result, nil
+	} else if err != nil {
+		return result, err
+	}
+	balance, ok := big.NewInt(0).SetString(accountInfo.Balance, 10)
+	if !ok {
+		return result, errors.New("Unable to parse balance")
+	}
+	if balance.Sign() == 0 {
+		return result, nil
+	}
+
+	sb, err := w.createSendBlock(ctx, wallet, acc, balance.String(), destination, nil, bpowKey)
+	if err != nil {
+		return result, err
+	}
+	hash, err := w.publishBlock(ctx, sb, "send")
+	if err != nil {
+		return result, err
+	}
+	result.Amount = balance.String()
+	result.Hash = hash
+
+	return result, nil
+}
+
+// Sweep every account on the wallet to destination, a failure on one account doesn't stop the others
+// The destination itself is skipped if it belongs to the wallet
+func (w *NanoWallet) WalletSweep(ctx context.Context, wallet *ent.Wallet, destination string, bpowKey *string) ([]*models.SweepResult, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	destinationPub, err := utils.AddressToPub(destination, w.Config.Wallet.Banano)
+	if err != nil {
+		return nil, ErrInvalidDestination
+	}
+
+	_, addresses, err := w.AccountsList(ctx, wallet, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*models.SweepResult
+	for _, address := range addresses {
+		// Whatever prefix the destination was given with
+		if pub, err := utils.AddressToPub(address, w.Config.Wallet.Banano); err == nil && bytes.Equal(pub, destinationPub) {
+			continue
+		}
+		result, err := w.AccountSweep(ctx, wallet, address, destination, bpowKey)
+		if result == nil {
+			result = &models.SweepResult{
+				Account: address,
+				Amount:  "0",
+			}
+		}
+		result.Error = err
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,94 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSweep(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var published []models.StateBlock
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.ProcessRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "receivable" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ReceivableResponseEmptyStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			} else if pr.Action == "process" {
+				published = append(published, pr.Block)
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "Account not found",
+			})
+			return resp, err
+		},
+	)
+
+	seed, err := utils.GenerateSeed(strings.NewReader("C4E6A8B0D2F4A6C8E0B2D4F6A8C0E2B4D6F8A0C2E4B6D8F0A2C4E6B8D0F2A4C6"))
+	assert.Nil(t, err)
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	wallet.Representative = utils.ToPtr("nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs")
+	funded, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+	destination := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+
+	_, err = MockWallet.AccountSweep(context.Background(), nil, funded.Address, destination, nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+	_, err = MockWallet.AccountSweep(context.Background(), wallet, funded.Address, "nano_1234", nil)
+	assert.ErrorIs(t, err, ErrInvalidDestination)
+
+	MockWallet.setAccountState(funded.Address, &models.AccountState{
+		Frontier:       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+		Balance:        "133248290000000000000000000000000000001",
+		Representative: destination,
+	})
+
+	results, err := MockWallet.WalletSweep(context.Background(), wallet, destination, nil)
+	assert.Nil(t, err)
+	// The first account on the wallet is unopened
+	assert.Len(t, results, 2)
+	for _, result := range results {
+		assert.Nil(t, result.Error)
+		if result.Account == funded.Address {
+			assert.Equal(t, "133248290000000000000000000000000000001", result.Amount)
+			assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", result.Hash)
+		} else {
+			assert.Equal(t, "0", result.Amount)
+			assert.Equal(t, "", result.Hash)
+		}
+	}
+	assert.Len(t, published, 1)
+	assert.Equal(t, "0", published[0].Balance)
+
+	// Nothing left to move
+	result, err := MockWallet.AccountSweep(context.Background(), wallet, funded.Address, destination, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "0", result.Amount)
+	assert.Len(t, published, 1)
+
+	// The wallet's own destination is skipped whatever its prefix
+	results, err = MockWallet.WalletSweep(context.Background(), wallet, "xrb_"+strings.TrimPrefix(funded.Address, "nano_"), nil)
+	assert.Nil(t, err)
+	assert.Len(t, results, 1)
+	assert.NotEqual(t, funded.Address, results[0].Account)
+}
@@ -19,11 +19,16 @@ import (
 
 type NanoWallet struct {
 	DB         *ent.Client
-	Ctx        context.Context
 	RpcClient  *nanorpc.RPCClient
 	WorkClient *pow.PippinPow
 	Config     *config.PippinConfig
 	Banano     bool
+	// Optional, receives events like accounts falling out of sync
+	EventHandler func(event models.WalletEvent)
+	// Optional, set by UseEventBus
+	Events *EventBus
+	// Optional, told about addresses that were added to or removed from any wallet
+	AccountsHandler func(added []string, removed []string)
 }
 
 var ErrInvalidSeed = errors.New("invalid seed")
@@ -34,12 +39,12 @@ var ErrInvalidAccountCount = errors.New("invalid count")
 var ErrWalletNotFound = errors.New("wallet not found")
 
 // Retrieves wallet
-func (w *NanoWallet) GetWallet(walletID string) (*ent.Wallet, error) {
+func (w *NanoWallet) GetWallet(ctx context.Context, walletID string) (*ent.Wallet, error) {
 	parsedUuid, err := uuid.Parse(walletID)
 	if err != nil {
 		return nil, ErrWalletNotFound
 	}
-	wallet, err := w.DB.Wallet.Get(w.Ctx, parsedUuid)
+	wallet, err := w.DB.Wallet.Get(ctx, parsedUuid)
 	if ent.IsNotFound(err) {
 		return nil, ErrWalletNotFound
 	} else if err != nil {
@@ -49,8 +54,8 @@ func (w *NanoWallet) GetWallet(walletID string) (*ent.Wallet, error) {
 	return wallet, nil
 }
 
-func (w *NanoWallet) GetWallets() ([]*ent.Wallet, error) {
-	wallets, err := w.DB.Wallet.Query().All(w.Ctx)
+func (w *NanoWallet) GetWallets(ctx context.Context) ([]*ent.Wallet, error) {
+	wallets, err := w.DB.Wallet.Query().All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -59,17 +64,17 @@ func (w *NanoWallet) GetWallets() ([]*ent.Wallet, error) {
 }
 
 // Creates a new wallet with provided seed
-func (w *NanoWallet) WalletCreate(seed string) (*ent.Wallet, error) {
+func (w *NanoWallet) WalletCreate(ctx context.Context, seed string) (*ent.Wallet, error) {
 	if !utils.Validate64HexHash(seed) {
 		return nil, ErrInvalidSeed
 	}
 
 	// Create wallet and first account
-	tx, err := w.DB.Tx(w.Ctx)
+	tx, err := w.DB.Tx(ctx)
 	if err != nil {
 		return nil, err
 	}
-	wallet, err := tx.Wallet.Create().SetSeed(seed).Save(w.Ctx)
+	wallet, err := tx.Wallet.Create().SetSeed(seed).Save(ctx)
 	if err != nil {
 		tx.Rollback()
 		return nil, err
@@ -84,7 +89,7 @@ func (w *NanoWallet) WalletCreate(seed string) (*ent.Wallet, error) {
 	address := utils.PubKeyToAddress(pub, w.Banano)
 
 	// Create first account
-	_, err = tx.Account.Create().SetWallet(wallet).SetAccountIndex(0).SetAddress(address).Save(w.Ctx)
+	_, err = tx.Account.Create().SetWallet(wallet).SetAccountIndex(0).SetAddress(address).Save(ctx)
 	if err != nil {
 		tx.Rollback()
 		return nil, err
@@ -93,11 +98,12 @@ func (w *NanoWallet) WalletCreate(seed string) (*ent.Wallet, error) {
 	if err != nil {
 		return nil, err
 	}
+	w.accountsChanged([]string{address}, nil)
 
 	return wallet, nil
 }
 
-func (w *NanoWallet) WalletDestroy(wallet *ent.Wallet) error {
+func (w *NanoWallet) WalletDestroy(ctx context.Context, wallet *ent.Wallet) error {
 	if wallet == nil {
 		return ErrInvalidWallet
 	}
@@ -108,26 +114,32 @@ func (w *NanoWallet) WalletDestroy(wallet *ent.Wallet) error {
 		return err
 	}
 
+	addresses, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID)).Select(account.FieldAddress).Strings(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Delete wallet
-	err = w.DB.Wallet.DeleteOne(wallet).Exec(w.Ctx)
+	err = w.DB.Wallet.DeleteOne(wallet).Exec(ctx)
 	if err != nil {
 		return err
 	}
+	w.accountsChanged(nil, addresses)
 
 	return nil
 }
 
-func (w *NanoWallet) WalletInfo(wallet *ent.Wallet) (*models.WalletInfo, error) {
+func (w *NanoWallet) WalletInfo(ctx context.Context, wallet *ent.Wallet) (*models.WalletInfo, error) {
 	if wallet == nil {
 		return nil, ErrInvalidWallet
 	}
 
 	// Obtain a lock, prevent concurrent calls
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
 	if err != nil {
 		return nil, database.ErrLockNotObtained
 	}
-	defer lock.Release(w.Ctx)
+	defer lock.Release(context.WithoutCancel(ctx))
 
 	// Get seed
 	_, err = GetDecryptedKeyFromStorage(wallet, "seed")
@@ -135,7 +147,7 @@ func (w *NanoWallet) WalletInfo(wallet *ent.Wallet) (*models.WalletInfo, error)
 		return nil, err
 	}
 
-	curAccount, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Order(ent.Desc(account.FieldAccountIndex)).First(w.Ctx)
+	curAccount, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Order(ent.Desc(account.FieldAccountIndex)).First(ctx)
 
 	if err != nil {
 		return nil, err
@@ -144,13 +156,13 @@ func (w *NanoWallet) WalletInfo(wallet *ent.Wallet) (*models.WalletInfo, error)
 	currentIndex := *curAccount.AccountIndex
 
 	// Get all accounts on wallet
-	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Count(w.Ctx)
+	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Count(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get all adhoc accounts on wallet
-	adhocAccounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.PrivateKeyNotNil()).Count(w.Ctx)
+	adhocAccounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.PrivateKeyNotNil()).Count(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -163,13 +175,13 @@ func (w *NanoWallet) WalletInfo(wallet *ent.Wallet) (*models.WalletInfo, error)
 	}, nil
 }
 
-func (w *NanoWallet) WalletRepresentativeSet(wallet *ent.Wallet, representative string, changeExisting bool, bpowKey *string) error {
+func (w *NanoWallet) WalletRepresentativeSet(ctx context.Context, wallet *ent.Wallet, representative string, changeExisting bool, bpowKey *string) error {
 	if wallet == nil {
 		return ErrInvalidWallet
 	}
 
 	// Update wallet with representative
-	wallet, err := wallet.Update().SetRepresentative(representative).Save(w.Ctx)
+	wallet, err := wallet.Update().SetRepresentative(representative).Save(ctx)
 	if err != nil {
 		return err
 	}
@@ -179,12 +191,12 @@ func (w *NanoWallet) WalletRepresentativeSet(wallet *ent.Wallet, representative
 	}
 
 	// Create and publish change blocks for every account on the wallet.
-	_, addresses, err := w.AccountsList(wallet, 0)
+	_, addresses, err := w.AccountsList(ctx, wallet, 0)
 	if err != nil {
 		return err
 	}
 	for _, address := range addresses {
-		_, err := w.CreateAndPublishChangeBlock(wallet, address, representative, nil, bpowKey, true)
+		_, err := w.CreateAndPublishChangeBlock(ctx, wallet, address, representative, nil, bpowKey, true)
 		if err != nil && !errors.Is(err, ErrSameRepresentative) && !errors.Is(err, nanorpc.ErrAccountNotFound) {
 			return err
 		}
@@ -194,7 +206,7 @@ func (w *NanoWallet) WalletRepresentativeSet(wallet *ent.Wallet, representative
 
 // Change the seed of the wallet, will decrypt it if encrypted
 // Will return the newest account of the changed wallet (the one with the highest index)
-func (w *NanoWallet) WalletChangeSeed(wallet *ent.Wallet, newSeed string) (*ent.Account, error) {
+func (w *NanoWallet) WalletChangeSeed(ctx context.Context, wallet *ent.Wallet, newSeed string) (*ent.Account, error) {
 	if wallet == nil {
 		return nil, ErrInvalidWallet
 	} else if !utils.Validate64HexHash(newSeed) {
@@ -209,30 +221,36 @@ func (w *NanoWallet) WalletChangeSeed(wallet *ent.Wallet, newSeed string) (*ent.
 
 	if wallet.Encrypted {
 		// Decrypt wallet
-		_, err = w.EncryptWallet(wallet, "")
+		_, err = w.EncryptWallet(ctx, wallet, "")
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	// Loop all accounts, update their address with new derived address
-	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).All(w.Ctx)
+	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).All(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var added, removed []string
+	defer func() { w.accountsChanged(added, removed) }()
 	for _, account := range accounts {
 		pub, _, err := utils.KeypairFromSeed(newSeed, uint32(*account.AccountIndex))
 		if err != nil {
 			return nil, err
 		}
 		address := utils.PubKeyToAddress(pub, w.Banano)
-		_, err = account.Update().SetAddress(address).Save(w.Ctx)
+		_, err = account.Update().SetAddress(address).Save(ctx)
 		if err != nil {
 			return nil, err
 		}
+		if address != account.Address {
+			added = append(added, address)
+			removed = append(removed, account.Address)
+		}
 	}
 
-	newest, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Order(ent.Desc(account.FieldAccountIndex)).First(w.Ctx)
+	newest, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Order(ent.Desc(account.FieldAccountIndex)).First(ctx)
 	if err != nil {
 		return nil, err
 	}
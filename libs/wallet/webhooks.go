@@ -0,0 +1,314 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhook"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdeadletter"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdelivery"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/google/uuid"
+)
+
+// Events of a wallet are stored as deliveries, posted until the webhook answers with a 2xx
+// Deliveries that keep failing are moved to the dead letter table
+
+var ErrInvalidWebhookUrl = errors.New("invalid webhook url")
+var ErrInvalidWebhookEvent = errors.New("invalid webhook event")
+var ErrWebhookNotFound = errors.New("webhook not found")
+
+// Retries start this long after the first failure, doubling each attempt up to the max
+const webhookBackoff = time.Second * 30
+const webhookMaxBackoff = time.Hour
+
+// Attempts before a delivery becomes a dead letter
+const webhookMaxAttempts = 10
+
+// Deliveries handled per pass
+const webhookBatchSize = 100
+
+// Webhooks posted to at once
+const webhookWorkers = 8
+
+var webhookClient = &http.Client{
+	Timeout: time.Second * 10,
+}
+
+func webhookDelay(attempts int) time.Duration {
+	delay := webhookBackoff
+	for i := 1; i < attempts && delay < webhookMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > webhookMaxBackoff {
+		delay = webhookMaxBackoff
+	}
+	return delay
+}
+
+// Hex HMAC-SHA256 of the payload, sent in the X-Pippin-Signature header as sha256=<signature>
+func WebhookSignature(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Creates or replaces the webhook of a wallet, a secret is generated if none is given
+// No events means all of them
+func (w *NanoWallet) SetWebhook(ctx context.Context, wallet *ent.Wallet, webhookUrl string, secret string, events []string) (*ent.Webhook, error) {
+	u, err := url.Parse(webhookUrl)
+	if err != nil || !slices.Contains([]string{"http", "https"}, u.Scheme) || u.Host == "" {
+		return nil, ErrInvalidWebhookUrl
+	}
+	for _, event := range events {
+		if !slices.Contains(models.WebhookEvents, event) {
+			return nil, ErrInvalidWebhookEvent
+		}
+	}
+	if secret == "" {
+		random := make([]byte, 32)
+		if _, err := rand.Read(random); err != nil {
+			return nil, err
+		}
+		secret = hex.EncodeToString(random)
+	}
+
+	existing, err := w.GetWebhook(ctx, wallet)
+	if errors.Is(err, ErrWebhookNotFound) {
+		return w.DB.Webhook.Create().SetWalletID(wallet.ID).SetURL(webhookUrl).SetSecret(secret).SetEvents(events).Save(ctx)
+	} else if err != nil {
+		return nil, err
+	}
+	return existing.Update().SetURL(webhookUrl).SetSecret(secret).SetEvents(events).Save(ctx)
+}
+
+func (w *NanoWallet) GetWebhook(ctx context.Context, wallet *ent.Wallet) (*ent.Webhook, error) {
+	hook, err := w.DB.Webhook.Query().Where(webhook.WalletID(wallet.ID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrWebhookNotFound
+	}
+	return hook, err
+}
+
+// Removes the webhook of a wallet, with its pending deliveries and dead letters
+func (w *NanoWallet) RemoveWebhook(ctx context.Context, wallet *ent.Wallet) error {
+	deleted, err := w.DB.Webhook.Delete().Where(webhook.WalletID(wallet.ID)).Exec(ctx)
+	if err != nil {
+		return err
+	} else if deleted < 1 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+// Most recent dead letters of a wallet's webhook
+func (w *NanoWallet) GetWebhookDeadLetters(ctx context.Context, wallet *ent.Wallet, count int) ([]*ent.WebhookDeadLetter, error) {
+	hook, err := w.GetWebhook(ctx, wallet)
+	if err != nil {
+		return nil, err
+	}
+	return hook.QueryDeadLetters().Order(ent.Desc(webhookdeadletter.FieldCreatedAt)).Limit(count).All(ctx)
+}
+
+// Stores a delivery for the webhook of the event's wallet
+func (w *NanoWallet) enqueueWebhooks(ctx context.Context, event models.WalletEvent) {
+	walletID, err := uuid.Parse(event.Wallet)
+	if err != nil || !slices.Contains(models.WebhookEvents, event.Type) {
+		return
+	}
+	hook, err := w.DB.Webhook.Query().Where(webhook.WalletID(walletID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return
+	} else if err != nil {
+		log.Errorf("Error getting webhook for %s %v", event.Wallet, err)
+		return
+	}
+	if len(hook.Events) > 0 && !slices.Contains(hook.Events, event.Type) {
+		return
+	}
+	id := uuid.New()
+	payload, err := json.Marshal(models.EventPayload{
+		Id:          id.String(),
+		Timestamp:   time.Now().Unix(),
+		WalletEvent: event,
+	})
+	if err != nil {
+		return
+	}
+	if _, err := w.DB.WebhookDelivery.Create().SetID(id).SetWebhookID(hook.ID).SetEvent(event.Type).SetPayload(string(payload)).Save(ctx); err != nil {
+		log.Errorf("Error queueing webhook %s for %s %v", event.Type, event.Account, err)
+	}
+}
+
+// Posts the deliveries that are due, returns how many went through
+func (w *NanoWallet) DeliverWebhooks(ctx context.Context) int {
+	return w.deliverWebhooks(ctx, time.Now())
+}
+
+func (w *NanoWallet) deliverWebhooks(ctx context.Context, now time.Time) int {
+	due, err := w.DB.WebhookDelivery.Query().
+		Where(webhookdelivery.NextAttemptAtLTE(now)).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(webhookBatchSize).
+		All(ctx)
+	if err != nil {
+		log.Errorf("Error retrieving webhook deliveries %v", err)
+		return 0
+	}
+	// Each webhook's deliveries go out in order on their own, so a slow or dead endpoint only holds up itself
+	// and the rest of its batch waits for the next pass after its first failure
+	var hooks []uuid.UUID
+	byHook := make(map[uuid.UUID][]uuid.UUID)
+	for _, delivery := range due {
+		if _, ok := byHook[delivery.WebhookID]; !ok {
+			hooks = append(hooks, delivery.WebhookID)
+		}
+		byHook[delivery.WebhookID] = append(byHook[delivery.WebhookID], delivery.ID)
+	}
+	var delivered atomic.Int64
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, webhookWorkers)
+	for _, hook := range hooks {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(ids []uuid.UUID) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			for _, id := range ids {
+				ok, err := w.deliverWebhook(ctx, id, now)
+				if err != nil {
+					return
+				} else if ok {
+					delivered.Add(1)
+				}
+			}
+		}(byHook[hook])
+	}
+	wg.Wait()
+	return int(delivered.Load())
+}
+
+// Returns the error if posting failed, false without one if the delivery was skipped
+func (w *NanoWallet) deliverWebhook(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	// Another instance may be handling this one
+	lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("webhook:%s", id), time.Second*30, nil)
+	if err != nil {
+		return false, nil
+	}
+	defer lock.Release(context.WithoutCancel(ctx))
+
+	// Could have been delivered while we waited
+	delivery, err := w.DB.WebhookDelivery.Query().Where(webhookdelivery.ID(id)).WithWebhook().Only(ctx)
+	if err != nil || delivery.NextAttemptAt.After(now) {
+		return false, nil
+	}
+
+	postErr := postWebhook(ctx, delivery.Edges.Webhook, delivery)
+	// Record the outcome even when shutting down, otherwise it's delivered again or retried without backoff
+	ctx = context.WithoutCancel(ctx)
+	if postErr == nil {
+		if err := w.DB.WebhookDelivery.DeleteOneID(id).Exec(ctx); err != nil {
+			log.Errorf("Error removing delivered webhook %s %v", id, err)
+		}
+		return true, nil
+	}
+
+	attempts := delivery.Attempts + 1
+	lastError := truncate(postErr.Error(), 1024)
+	if attempts < webhookMaxAttempts {
+		log.Warnf("Error delivering webhook %s, attempt %d %v", id, attempts, postErr)
+		if err := w.DB.WebhookDelivery.UpdateOneID(id).
+			SetAttempts(attempts).
+			SetNextAttemptAt(now.Add(webhookDelay(attempts))).
+			SetLastError(lastError).
+			Exec(ctx); err != nil {
+			log.Errorf("Error saving attempt of webhook %s %v", id, err)
+		}
+		return false, postErr
+	}
+
+	log.Errorf("Giving up on webhook %s after %d attempts %v", id, attempts, postErr)
+	tx, err := w.DB.Tx(ctx)
+	if err != nil {
+		log.Errorf("Error moving webhook %s to dead letters %v", id, err)
+		return false, postErr
+	}
+	if _, err := tx.WebhookDeadLetter.Create().
+		SetID(id).
+		SetWebhookID(delivery.WebhookID).
+		SetEvent(delivery.Event).
+		SetPayload(delivery.Payload).
+		SetAttempts(attempts).
+		SetLastError(lastError).
+		Save(ctx); err != nil {
+		log.Errorf("Error moving webhook %s to dead letters %v", id, err)
+		tx.Rollback()
+		return false, postErr
+	}
+	if err := tx.WebhookDelivery.DeleteOneID(id).Exec(ctx); err != nil {
+		log.Errorf("Error moving webhook %s to dead letters %v", id, err)
+		tx.Rollback()
+		return false, postErr
+	}
+	if err := tx.Commit(); err != nil {
+		log.Errorf("Error moving webhook %s to dead letters %v", id, err)
+	}
+	return false, postErr
+}
+
+func truncate(str string, length int) string {
+	if len(str) > length {
+		return str[:length]
+	}
+	return str
+}
+
+func postWebhook(ctx context.Context, hook *ent.Webhook, delivery *ent.WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewBufferString(delivery.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Pippin-Event", delivery.Event)
+	req.Header.Set("X-Pippin-Delivery", delivery.ID.String())
+	req.Header.Set("X-Pippin-Signature", "sha256="+WebhookSignature(hook.Secret, []byte(delivery.Payload)))
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Delivers webhooks every interval until ctx is done
+func (w *NanoWallet) StartWebhookDelivery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.DeliverWebhooks(ctx)
+		}
+	}
+}
@@ -0,0 +1,292 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/webhookdelivery"
+	"github.com/appditto/pippin_nano_wallet/libs/pow/powtest"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetWebhook(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("4f0e2c1a3b5d7f9e8c6a4b2d0f1e3c5a7b9d8f6e4c2a0b1d3f5e7c9a8b6d4f2e"))
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+
+	_, err = MockWallet.GetWebhook(context.Background(), wallet)
+	assert.ErrorIs(t, err, ErrWebhookNotFound)
+	_, err = MockWallet.SetWebhook(context.Background(), wallet, "ftp://example.com", "", nil)
+	assert.ErrorIs(t, err, ErrInvalidWebhookUrl)
+	_, err = MockWallet.SetWebhook(context.Background(), wallet, "https://example.com/hook", "", []string{"something"})
+	assert.ErrorIs(t, err, ErrInvalidWebhookEvent)
+
+	// A secret is generated when none is given
+	hook, err := MockWallet.SetWebhook(context.Background(), wallet, "https://example.com/hook", "", nil)
+	assert.Nil(t, err)
+	assert.Len(t, hook.Secret, 64)
+
+	// Setting it again replaces it
+	hook, err = MockWallet.SetWebhook(context.Background(), wallet, "https://example.com/other", "secret", []string{models.EventSendPublished})
+	assert.Nil(t, err)
+	got, err := MockWallet.GetWebhook(context.Background(), wallet)
+	assert.Nil(t, err)
+	assert.Equal(t, hook.ID, got.ID)
+	assert.Equal(t, "https://example.com/other", got.URL)
+	assert.Equal(t, "secret", got.Secret)
+	assert.Equal(t, []string{models.EventSendPublished}, got.Events)
+
+	assert.Nil(t, MockWallet.RemoveWebhook(context.Background(), wallet))
+	assert.ErrorIs(t, MockWallet.RemoveWebhook(context.Background(), wallet), ErrWebhookNotFound)
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90"))
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+	hook, err := MockWallet.SetWebhook(context.Background(), wallet, "https://example.com/deliveries", "secret", []string{models.EventIncomingSendConfirmed})
+	assert.Nil(t, err)
+
+	var received []*http.Request
+	var bodies [][]byte
+	status := 200
+	httpmock.RegisterResponder("POST", "https://example.com/deliveries", func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		received = append(received, req)
+		bodies = append(bodies, body)
+		return httpmock.NewStringResponse(status, ""), nil
+	})
+
+	// Only the events it asked for
+	MockWallet.EmitConfirmationEvents(context.Background(), "nano_1sender1111111111111111111111111111111111111111111111hifc8npp", "ab12", "send", acc.Address, "1000")
+	MockWallet.emitEvent(context.Background(), models.WalletEvent{Type: models.EventRepresentativeChanged, Account: acc.Address})
+	assert.Equal(t, 1, hook.QueryDeliveries().CountX(context.Background()))
+
+	assert.Equal(t, 1, MockWallet.DeliverWebhooks(context.Background()))
+	assert.Len(t, received, 1)
+	assert.Equal(t, models.EventIncomingSendConfirmed, received[0].Header.Get("X-Pippin-Event"))
+	assert.Equal(t, "sha256="+WebhookSignature("secret", bodies[0]), received[0].Header.Get("X-Pippin-Signature"))
+	var payload models.EventPayload
+	assert.Nil(t, json.Unmarshal(bodies[0], &payload))
+	assert.Equal(t, received[0].Header.Get("X-Pippin-Delivery"), payload.Id)
+	assert.Equal(t, wallet.ID.String(), payload.Wallet)
+	assert.Equal(t, acc.Address, payload.Account)
+	assert.Equal(t, "AB12", payload.Hash)
+	assert.Equal(t, "1000", payload.Amount)
+	assert.Equal(t, 0, hook.QueryDeliveries().CountX(context.Background()))
+
+	// Failures are retried later
+	status = 500
+	MockWallet.EmitConfirmationEvents(context.Background(), "nano_1sender1111111111111111111111111111111111111111111111hifc8npp", "cd34", "send", acc.Address, "1000")
+	now := time.Now()
+	assert.Equal(t, 0, MockWallet.deliverWebhooks(context.Background(), now))
+	delivery := hook.QueryDeliveries().OnlyX(context.Background())
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, "webhook returned 500", *delivery.LastError)
+	assert.WithinDuration(t, now.Add(webhookBackoff), delivery.NextAttemptAt, time.Second)
+	assert.Equal(t, 0, MockWallet.deliverWebhooks(context.Background(), now))
+	assert.Len(t, received, 2)
+
+	// Until they run out of attempts
+	MockWallet.DB.WebhookDelivery.Update().Where(webhookdelivery.ID(delivery.ID)).SetAttempts(webhookMaxAttempts - 1).ExecX(context.Background())
+	assert.Equal(t, 0, MockWallet.deliverWebhooks(context.Background(), now.Add(time.Hour)))
+	assert.Equal(t, 0, hook.QueryDeliveries().CountX(context.Background()))
+	deadLetters, err := MockWallet.GetWebhookDeadLetters(context.Background(), wallet, 10)
+	assert.Nil(t, err)
+	assert.Len(t, deadLetters, 1)
+	assert.Equal(t, delivery.ID, deadLetters[0].ID)
+	assert.Equal(t, webhookMaxAttempts, deadLetters[0].Attempts)
+	assert.Equal(t, delivery.Payload, deadLetters[0].Payload)
+
+	// A failing endpoint gets one attempt per pass, its other deliveries wait
+	MockWallet.EmitConfirmationEvents(context.Background(), "nano_1sender1111111111111111111111111111111111111111111111hifc8npp", "ef56", "send", acc.Address, "1000")
+	MockWallet.EmitConfirmationEvents(context.Background(), "nano_1sender1111111111111111111111111111111111111111111111hifc8npp", "ab78", "send", acc.Address, "1000")
+	assert.Equal(t, 0, MockWallet.deliverWebhooks(context.Background(), time.Now().Add(time.Hour)))
+	assert.Len(t, received, 4)
+	deliveries := hook.QueryDeliveries().AllX(context.Background())
+	assert.Len(t, deliveries, 2)
+	assert.Equal(t, 1, deliveries[0].Attempts+deliveries[1].Attempts)
+
+	// Both go out once it's back
+	status = 200
+	assert.Equal(t, 2, MockWallet.deliverWebhooks(context.Background(), time.Now().Add(time.Hour*2)))
+	assert.Len(t, received, 6)
+}
+
+func TestWebhookDeliveryIsolated(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("1dead0000000000000000000000000000000000000000000000000000000000a"))
+	deadWallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	deadAcc, err := MockWallet.AccountCreate(context.Background(), deadWallet, nil)
+	assert.Nil(t, err)
+	deadHook, err := MockWallet.SetWebhook(context.Background(), deadWallet, "https://example.com/dead", "", nil)
+	assert.Nil(t, err)
+	seed, _ = utils.GenerateSeed(strings.NewReader("2a11fe000000000000000000000000000000000000000000000000000000000b"))
+	liveWallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	liveAcc, err := MockWallet.AccountCreate(context.Background(), liveWallet, nil)
+	assert.Nil(t, err)
+	liveHook, err := MockWallet.SetWebhook(context.Background(), liveWallet, "https://example.com/live", "", nil)
+	assert.Nil(t, err)
+
+	var deadPosts atomic.Int32
+	httpmock.RegisterResponder("POST", "https://example.com/dead", func(req *http.Request) (*http.Response, error) {
+		deadPosts.Add(1)
+		return httpmock.NewStringResponse(503, ""), nil
+	})
+	httpmock.RegisterResponder("POST", "https://example.com/live", httpmock.NewStringResponder(200, ""))
+
+	for _, hash := range []string{"dd01", "dd02", "dd03"} {
+		MockWallet.EmitConfirmationEvents(context.Background(), "nano_1sender1111111111111111111111111111111111111111111111hifc8npp", hash, "send", deadAcc.Address, "1000")
+		MockWallet.EmitConfirmationEvents(context.Background(), "nano_1sender1111111111111111111111111111111111111111111111hifc8npp", hash, "send", liveAcc.Address, "1000")
+	}
+
+	// The dead endpoint is tried once, the live one still gets everything
+	assert.Equal(t, 3, MockWallet.DeliverWebhooks(context.Background()))
+	assert.Equal(t, int32(1), deadPosts.Load())
+	assert.Equal(t, 0, liveHook.QueryDeliveries().CountX(context.Background()))
+	assert.Equal(t, 3, deadHook.QueryDeliveries().CountX(context.Background()))
+}
+
+func TestWebhookDeliveryDuringShutdown(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("3b0d0000000000000000000000000000000000000000000000000000000000c1"))
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+	hook, err := MockWallet.SetWebhook(context.Background(), wallet, "https://example.com/shutdown", "", nil)
+	assert.Nil(t, err)
+
+	// The server is shut down while the endpoint answers
+	ctx, cancel := context.WithCancel(context.Background())
+	status := 500
+	httpmock.RegisterResponder("POST", "https://example.com/shutdown", func(req *http.Request) (*http.Response, error) {
+		cancel()
+		return httpmock.NewStringResponse(status, ""), nil
+	})
+	MockWallet.EmitConfirmationEvents(context.Background(), "nano_1sender1111111111111111111111111111111111111111111111hifc8npp", "ee01", "send", acc.Address, "1000")
+	delivery := hook.QueryDeliveries().OnlyX(context.Background())
+
+	// A failure is still counted
+	_, err = MockWallet.deliverWebhook(ctx, delivery.ID, time.Now())
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, hook.QueryDeliveries().OnlyX(context.Background()).Attempts)
+
+	// A delivery is still removed
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	status = 200
+	delivered, err := MockWallet.deliverWebhook(ctx, delivery.ID, time.Now().Add(time.Hour))
+	assert.Nil(t, err)
+	assert.True(t, delivered)
+	assert.Equal(t, 0, hook.QueryDeliveries().CountX(context.Background()))
+}
+
+func TestWebhookDelay(t *testing.T) {
+	assert.Equal(t, webhookBackoff, webhookDelay(1))
+	assert.Equal(t, webhookBackoff*2, webhookDelay(2))
+	assert.Equal(t, webhookMaxBackoff, webhookDelay(20))
+}
+
+func TestEmitPublishedEvent(t *testing.T) {
+	var events []models.WalletEvent
+	w := *MockWallet
+	w.EventHandler = func(event models.WalletEvent) {
+		events = append(events, event)
+	}
+	sb := &models.StateBlock{
+		Account:        "nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs",
+		Representative: "nano_1thingspmippfngcrtk1ofd3uwftffnu4qu9xkauo9zkiuep6iknzci3jxa6",
+		Balance:        "700",
+		Link:           "9C0B4F1F1A1A4E0C11A4C2DE0D5B12E1A4C2DE0D5B12E1A4C2DE0D5B12E1A4C2",
+	}
+	w.emitPublishedEvent(context.Background(), sb, "send", "abcd", "1000")
+	w.emitPublishedEvent(context.Background(), sb, "receive", "abcd", "")
+	w.emitPublishedEvent(context.Background(), sb, "change", "abcd", "700")
+	w.emitPublishedEvent(context.Background(), sb, "epoch", "abcd", "700")
+	assert.Len(t, events, 3)
+	assert.Equal(t, models.EventSendPublished, events[0].Type)
+	assert.Equal(t, "300", events[0].Amount)
+	assert.True(t, strings.HasPrefix(events[0].Detail, "nano_"))
+	assert.Equal(t, "ABCD", events[0].Hash)
+	assert.Equal(t, models.EventReceivePublished, events[1].Type)
+	assert.Equal(t, "", events[1].Amount)
+	assert.Equal(t, sb.Link, events[1].Detail)
+	assert.Equal(t, models.EventRepresentativeChanged, events[2].Type)
+	assert.Equal(t, sb.Representative, events[2].Detail)
+}
+
+func TestEmitConfirmationEvents(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2"))
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+
+	var events []models.WalletEvent
+	w := *MockWallet
+	w.EventHandler = func(event models.WalletEvent) {
+		events = append(events, event)
+	}
+	// Events carry the wallet of their account
+	w.EmitConfirmationEvents(context.Background(), acc.Address, "ab12", "change", "", "0")
+	w.EmitConfirmationEvents(context.Background(), "nano_1sender1111111111111111111111111111111111111111111111hifc8npp", "cd34", "change", "", "0")
+	w.emitEvent(context.Background(), models.WalletEvent{Type: models.EventAccountResynced, Account: acc.Address})
+	assert.Len(t, events, 2)
+	assert.Equal(t, models.EventBlockConfirmed, events[0].Type)
+	assert.Equal(t, "change", events[0].Detail)
+	assert.Equal(t, wallet.ID.String(), events[0].Wallet)
+	assert.Equal(t, wallet.ID.String(), events[1].Wallet)
+
+	work, err := w.generateBlockWork(context.Background(), acc, powtest.KnownHash, 1, "")
+	assert.Nil(t, err)
+	assert.Len(t, events, 3)
+	assert.Equal(t, models.EventWorkGenerated, events[2].Type)
+	assert.Equal(t, strings.ToUpper(powtest.KnownHash), events[2].Hash)
+	assert.Equal(t, work, events[2].Detail)
+}
+
+func TestHandleConfirmationOnce(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("5b7d9f1a3c5e7092b4d6f8a1c3e5f7092b4d6f8a1c3e5f7092b4d6f8a1c3e5f7"))
+	wallet, err := MockWallet.WalletCreate(context.Background(), seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(context.Background(), wallet, nil)
+	assert.Nil(t, err)
+
+	var events []models.WalletEvent
+	w := *MockWallet
+	w.EventHandler = func(event models.WalletEvent) {
+		events = append(events, event)
+	}
+	confirmation := models.BlockConfirmation{
+		Account: acc.Address,
+		Hash:    "ef56",
+		Subtype: "change",
+		Amount:  "0",
+	}
+	// Another instance or node websocket delivering it later doesn't emit it again
+	w.handleConfirmation(context.Background(), confirmation)
+	w.handleConfirmation(context.Background(), confirmation)
+	assert.Len(t, events, 1)
+	assert.Equal(t, models.EventBlockConfirmed, events[0].Type)
+}
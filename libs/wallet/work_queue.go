@@ -0,0 +1,199 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/go-redis/redis/v9"
+)
+
+// With several instances behind a load balancer, work goes through a redis queue
+// Any instance running workers claims jobs from it, results are shared by hash and difficulty
+// Requests for a hash that is already queued wait on the same job instead of computing it again
+
+const workQueueKey = "workqueue"
+
+// Results are kept around a little for anybody else asking for the same work
+const workResultTTL = time.Minute * 10
+
+// Errors are kept shorter so the next request tries again
+const workErrorTTL = time.Second * 5
+
+// How often waiters check for the result
+var workQueuePollInterval = time.Millisecond * 100
+
+var ErrWorkQueueTimeout = errors.New("timed out waiting for work from the queue")
+
+type workJob struct {
+	Hash       string `json:"hash"`
+	Multiplier int    `json:"multiplier"`
+	BlockAward bool   `json:"block_award"`
+	BpowKey    string `json:"bpow_key,omitempty"`
+}
+
+type workResult struct {
+	Work  string `json:"work,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func workQueueSuffix(hash string, multiplier int) string {
+	return fmt.Sprintf("%s:%s", strings.ToUpper(hash), pow.DifficultyToString(pow.DifficultyFromMultiplier(multiplier)))
+}
+
+func workJobKey(hash string, multiplier int) string {
+	return fmt.Sprintf("workjob:%s", workQueueSuffix(hash, multiplier))
+}
+
+func workResultKey(hash string, multiplier int) string {
+	return fmt.Sprintf("workresult:%s", workQueueSuffix(hash, multiplier))
+}
+
+// A job can be worked on for at most this long before somebody else queues it again
+func (w *NanoWallet) workJobTimeout() time.Duration {
+	timeout := time.Duration(w.Config.Wallet.WorkTimeout) * time.Second * 2
+	if timeout < time.Minute {
+		timeout = time.Minute
+	}
+	return timeout
+}
+
+// Generates work for hash, through the shared queue when work_queue_workers is set
+func (w *NanoWallet) GenerateWork(ctx context.Context, hash string, multiplier int, blockAward bool, bpowKey string) (string, error) {
+	if w.Config == nil || w.Config.Wallet.WorkQueueWorkers < 1 {
+		return w.WorkClient.WorkGenerateMeta(ctx, hash, multiplier, true, blockAward, bpowKey)
+	}
+	return w.generateWorkQueued(ctx, &workJob{
+		Hash:       hash,
+		Multiplier: multiplier,
+		BlockAward: blockAward,
+		BpowKey:    bpowKey,
+	})
+}
+
+// Result of a job if there is one yet
+func getWorkResult(key string) (*workResult, error) {
+	raw, err := database.GetRedisDB().Get(key)
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var result workResult
+	if err := json.Unmarshal([]byte(raw), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (w *NanoWallet) generateWorkQueued(ctx context.Context, job *workJob) (string, error) {
+	jobKey := workJobKey(job.Hash, job.Multiplier)
+	resultKey := workResultKey(job.Hash, job.Multiplier)
+	deadline := time.Now().Add(w.workJobTimeout())
+	for time.Now().Before(deadline) {
+		result, err := getWorkResult(resultKey)
+		if err != nil {
+			return "", err
+		} else if result != nil {
+			if result.Error != "" {
+				return "", errors.New(result.Error)
+			}
+			return result.Work, nil
+		}
+		// Queue it, unless somebody else already did
+		claimed, err := database.GetRedisDB().SetNX(jobKey, "1", w.workJobTimeout())
+		if err != nil {
+			return "", err
+		}
+		if claimed {
+			serialized, err := json.Marshal(job)
+			if err != nil {
+				return "", err
+			}
+			if err := database.GetRedisDB().Rpush(workQueueKey, string(serialized)); err != nil {
+				database.GetRedisDB().Del(jobKey)
+				return "", err
+			}
+		}
+		// Wait for the result, or for the job to expire if whoever had it died
+		for time.Now().Before(deadline) {
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-time.After(workQueuePollInterval):
+			}
+			result, err := getWorkResult(resultKey)
+			if err != nil {
+				return "", err
+			} else if result != nil {
+				break
+			}
+			if _, err := database.GetRedisDB().Get(jobKey); err == redis.Nil {
+				break
+			}
+		}
+	}
+	return "", ErrWorkQueueTimeout
+}
+
+// Works on one job from the queue, waits up to timeout for one to show up
+func (w *NanoWallet) processWorkQueue(ctx context.Context, timeout time.Duration) {
+	serialized, err := database.GetRedisDB().Blpop(workQueueKey, timeout)
+	if err == redis.Nil {
+		return
+	} else if err != nil {
+		log.Errorf("Error reading work queue %v", err)
+		time.Sleep(timeout)
+		return
+	}
+	var job workJob
+	if err := json.Unmarshal([]byte(serialized), &job); err != nil {
+		log.Errorf("Invalid job in work queue %v", err)
+		return
+	}
+
+	result := workResult{}
+	ttl := workResultTTL
+	work, err := w.WorkClient.WorkGenerateMeta(ctx, job.Hash, job.Multiplier, true, job.BlockAward, job.BpowKey)
+	if ctx.Err() != nil {
+		// Stopping, the job is given up so waiters queue it again for another worker
+		database.GetRedisDB().Del(workJobKey(job.Hash, job.Multiplier))
+		return
+	} else if err != nil {
+		result.Error = err.Error()
+		ttl = workErrorTTL
+	} else {
+		result.Work = work
+	}
+	resultSerialized, err := json.Marshal(result)
+	if err != nil {
+		return
+	}
+	// Result first, waiters requeue once the job is gone
+	if err := database.GetRedisDB().Set(workResultKey(job.Hash, job.Multiplier), string(resultSerialized), ttl); err != nil {
+		log.Errorf("Error saving work result for %s %v", job.Hash, err)
+	}
+	database.GetRedisDB().Del(workJobKey(job.Hash, job.Multiplier))
+}
+
+// Runs work_queue_workers workers on the shared queue until ctx is done
+func (w *NanoWallet) StartWorkQueueWorkers(ctx context.Context) {
+	for i := 0; i < w.Config.Wallet.WorkQueueWorkers; i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+					w.processWorkQueue(ctx, time.Second)
+				}
+			}
+		}()
+	}
+}
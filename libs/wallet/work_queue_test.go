@@ -0,0 +1,101 @@
+package wallet
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/pow/powtest"
+	"github.com/go-redis/redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func queuedWallet() *NanoWallet {
+	config := *MockWallet.Config
+	config.Wallet.WorkQueueWorkers = 1
+	queued := *MockWallet
+	queued.Config = &config
+	return &queued
+}
+
+func TestGenerateWorkSharedResult(t *testing.T) {
+	w := queuedWallet()
+	hash := "09263B65752D05CE4DF5AEED849FFC2BE5BF47026ABB4FA5879359AE571BA9C8"
+	defer database.GetRedisDB().Del(workResultKey(hash, 1))
+
+	// Another instance already computed it
+	assert.Nil(t, database.GetRedisDB().Set(workResultKey(hash, 1), `{"work":"0123456789abcdef"}`, time.Minute))
+	work, err := w.GenerateWork(context.Background(), hash, 1, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "0123456789abcdef", work)
+
+	// Keys don't depend on case
+	work, err = w.GenerateWork(context.Background(), "09263b65752d05ce4df5aeed849ffc2be5bf47026abb4fa5879359ae571ba9c8", 1, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "0123456789abcdef", work)
+
+	// A different difficulty is a different job
+	_, err = database.GetRedisDB().Get(workResultKey(hash, 64))
+	assert.ErrorIs(t, err, redis.Nil)
+}
+
+func TestGenerateWorkQueued(t *testing.T) {
+	w := queuedWallet()
+	hash := powtest.KnownHash
+	defer database.GetRedisDB().Del(workResultKey(hash, 1))
+
+	// Both requests wait on the same job
+	var wg sync.WaitGroup
+	results := make([]string, 2)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			work, err := w.GenerateWork(context.Background(), hash, 1, false, "")
+			assert.Nil(t, err)
+			results[i] = work
+		}(i)
+	}
+	assert.Eventually(t, func() bool {
+		_, err := database.GetRedisDB().Get(workJobKey(hash, 1))
+		return err == nil
+	}, time.Second, time.Millisecond*10)
+
+	w.processWorkQueue(context.Background(), time.Second)
+	wg.Wait()
+	assert.Equal(t, []string{powtest.KnownWork, powtest.KnownWork}, results)
+
+	// Nothing else was queued and the job is done
+	_, err := database.GetRedisDB().Blpop(workQueueKey, time.Millisecond*100)
+	assert.ErrorIs(t, err, redis.Nil)
+	_, err = database.GetRedisDB().Get(workJobKey(hash, 1))
+	assert.ErrorIs(t, err, redis.Nil)
+}
+
+func TestWorkQueueWorkers(t *testing.T) {
+	w := queuedWallet()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w.StartWorkQueueWorkers(ctx)
+
+	hash := powtest.KnownHash
+	defer database.GetRedisDB().Del(workResultKey(hash, 1))
+	work, err := w.GenerateWork(context.Background(), hash, 1, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, powtest.KnownWork, work)
+}
+
+func TestGenerateWorkQueuedCancelled(t *testing.T) {
+	w := queuedWallet()
+	hash := "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3"
+	defer database.GetRedisDB().Del(workJobKey(hash, 1))
+	defer database.GetRedisDB().Del(workQueueKey)
+
+	// Nobody works on the queue, the caller stops waiting once its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*200)
+	defer cancel()
+	_, err := w.GenerateWork(ctx, hash, 1, false, "")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}